@@ -0,0 +1,37 @@
+package consumer
+
+import (
+	"encoding/json"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+
+	"gin-web/app/amqp/event"
+)
+
+// ModImageProcessor 截图处理器
+type ModImageProcessor interface {
+	Process(imageID uint) error
+}
+
+// ModImageConsumer 截图处理任务消费者，生成缩略图与 WebP
+type ModImageConsumer struct {
+	processor ModImageProcessor
+	log       *zap.Logger
+}
+
+// NewModImageConsumer 创建截图处理任务消费者实例
+func NewModImageConsumer(processor ModImageProcessor, log *zap.Logger) *ModImageConsumer {
+	return &ModImageConsumer{processor: processor, log: log}
+}
+
+// HandleMessage 处理消息
+func (c *ModImageConsumer) HandleMessage(msg amqp.Delivery) error {
+	var task event.ModImageTask
+	if err := json.Unmarshal(msg.Body, &task); err != nil {
+		// 解析失败的消息不重试
+		c.log.Error("decode mod image task failed", zap.Error(err), zap.ByteString("body", msg.Body))
+		return nil
+	}
+	return c.processor.Process(task.ImageID)
+}
@@ -0,0 +1,64 @@
+package consumer
+
+import (
+	"encoding/json"
+	"errors"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/amqp/event"
+	"gin-web/internal/repository"
+)
+
+// ModSearchIndexConsumer 根据 Mod 领域事件同步检索索引
+type ModSearchIndexConsumer struct {
+	repo    repository.ModRepository
+	indexer repository.ModSearchIndexer
+	log     *zap.Logger
+}
+
+// NewModSearchIndexConsumer 创建检索索引同步消费者实例
+func NewModSearchIndexConsumer(repo repository.ModRepository, indexer repository.ModSearchIndexer, log *zap.Logger) *ModSearchIndexConsumer {
+	return &ModSearchIndexConsumer{repo: repo, indexer: indexer, log: log}
+}
+
+// HandleMessage 处理消息
+func (c *ModSearchIndexConsumer) HandleMessage(msg amqp.Delivery) error {
+	var evt event.ModEvent
+	if err := json.Unmarshal(msg.Body, &evt); err != nil {
+		// 解析失败的消息不重试
+		c.log.Error("decode mod event failed", zap.Error(err), zap.ByteString("body", msg.Body))
+		return nil
+	}
+	return c.Apply(evt)
+}
+
+// Apply 将事件应用到检索索引
+// 创建/更新事件回查最新数据后重建该文档的索引，数据已不存在时视为删除
+func (c *ModSearchIndexConsumer) Apply(evt event.ModEvent) error {
+	switch evt.Type {
+	case event.ModCreated, event.ModUpdated:
+		mod, err := c.repo.FindByID(evt.ModID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.indexer.Remove(evt.ModID)
+		}
+		if err != nil {
+			return err
+		}
+		return c.indexer.Index(mod)
+	case event.ModDeleted:
+		return c.indexer.Remove(evt.ModID)
+	case event.ModReindex:
+		count, err := repository.ReindexMods(c.repo, c.indexer)
+		if err != nil {
+			return err
+		}
+		c.log.Info("search index rebuilt", zap.Int("mods", count))
+		return nil
+	default:
+		c.log.Warn("unknown mod event", zap.String("type", evt.Type))
+		return nil
+	}
+}
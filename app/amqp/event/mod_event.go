@@ -0,0 +1,31 @@
+package event
+
+import "time"
+
+// ModEventExchange Mod 领域事件交换机（topic 类型，routing key 为事件类型）
+const ModEventExchange = "mod.events"
+
+// Mod 领域事件类型
+const (
+	ModCreated = "mod.created"
+	ModUpdated = "mod.updated"
+	ModDeleted = "mod.deleted"
+	ModReindex = "mod.reindex" // 全量重建索引
+)
+
+// ModEvent Mod 领域事件
+// 只携带 ID，消费者按需回查最新数据，保证乱序/重复投递时结果一致
+type ModEvent struct {
+	Type       string    `json:"type"`
+	ModID      uint      `json:"mod_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// NewModEvent 创建 Mod 领域事件
+func NewModEvent(eventType string, modID uint) ModEvent {
+	return ModEvent{
+		Type:       eventType,
+		ModID:      modID,
+		OccurredAt: time.Now(),
+	}
+}
@@ -0,0 +1,10 @@
+package event
+
+// ModImageQueue 截图处理任务队列（持久化队列，多个实例共同消费）
+const ModImageQueue = "mod.images"
+
+// ModImageTask 截图处理任务
+// 只携带截图 ID，消费者回查截图状态，重复投递时跳过已处理的截图
+type ModImageTask struct {
+	ImageID uint `json:"image_id"`
+}
@@ -14,10 +14,11 @@ type Producer interface {
 }
 
 type BaseProducer struct {
-	conn    *amqp091.Connection
-	channel *amqp091.Channel
-	config  config.RabbitMQ
-	queue   string
+	conn     *amqp091.Connection
+	channel  *amqp091.Channel
+	config   config.RabbitMQ
+	queue    string
+	exchange string
 }
 
 func NewBaseProducer(cfg config.RabbitMQ, queue string) (*BaseProducer, error) {
@@ -49,13 +50,49 @@ func NewBaseProducer(cfg config.RabbitMQ, queue string) (*BaseProducer, error) {
 	}, err
 }
 
+// NewBaseExchangeProducer 创建基于 topic 交换机的生产者（消息按 routing key 分发到绑定的队列）
+func NewBaseExchangeProducer(cfg config.RabbitMQ, exchange string) (*BaseProducer, error) {
+	conn, err := amqp091.Dial(getAMQPURI(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	// 声明交换机
+	err = ch.ExchangeDeclare(
+		exchange,
+		amqp091.ExchangeTopic,
+		true,  // durable
+		false, // autoDelete
+		false, // internal
+		false, // noWait
+		nil,   // args
+	)
+
+	return &BaseProducer{
+		conn:     conn,
+		channel:  ch,
+		config:   cfg,
+		exchange: exchange,
+	}, err
+}
+
 func (p *BaseProducer) Publish(body []byte) error {
+	return p.PublishWithRoutingKey(p.queue, body)
+}
+
+// PublishWithRoutingKey 按 routing key 发布消息
+func (p *BaseProducer) PublishWithRoutingKey(routingKey string, body []byte) error {
 	return p.channel.PublishWithContext(
 		context.Background(),
-		"",      // exchange
-		p.queue, // routing key
-		false,   // mandatory
-		false,   // immediate
+		p.exchange, // exchange
+		routingKey, // routing key
+		false,      // mandatory
+		false,      // immediate
 		amqp091.Publishing{
 			ContentType:  "application/json",
 			Body:         body,
@@ -64,6 +101,14 @@ func (p *BaseProducer) Publish(body []byte) error {
 	)
 }
 
+// Close 关闭通道和连接
+func (p *BaseProducer) Close() error {
+	if err := p.channel.Close(); err != nil {
+		return err
+	}
+	return p.conn.Close()
+}
+
 func getAMQPURI(cfg config.RabbitMQ) string {
 	return fmt.Sprintf("amqp://%s:%s@%s:%d/%s",
 		cfg.Username,
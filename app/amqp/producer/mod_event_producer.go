@@ -0,0 +1,31 @@
+package producer
+
+import (
+	"encoding/json"
+
+	"gin-web/app/amqp/event"
+	"gin-web/config"
+)
+
+// ModEventProducer Mod 领域事件生产者
+type ModEventProducer struct {
+	*BaseProducer
+}
+
+// NewModEventProducer 创建 Mod 领域事件生产者实例
+func NewModEventProducer(cfg config.RabbitMQ) (*ModEventProducer, error) {
+	base, err := NewBaseExchangeProducer(cfg, event.ModEventExchange)
+	if err != nil {
+		return nil, err
+	}
+	return &ModEventProducer{base}, nil
+}
+
+// PublishModEvent 发布 Mod 领域事件（routing key 为事件类型）
+func (p *ModEventProducer) PublishModEvent(evt event.ModEvent) error {
+	body, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	return p.PublishWithRoutingKey(evt.Type, body)
+}
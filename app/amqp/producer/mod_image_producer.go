@@ -0,0 +1,31 @@
+package producer
+
+import (
+	"encoding/json"
+
+	"gin-web/app/amqp/event"
+	"gin-web/config"
+)
+
+// ModImageProducer 截图处理任务生产者
+type ModImageProducer struct {
+	*BaseProducer
+}
+
+// NewModImageProducer 创建截图处理任务生产者实例
+func NewModImageProducer(cfg config.RabbitMQ) (*ModImageProducer, error) {
+	base, err := NewBaseProducer(cfg, event.ModImageQueue)
+	if err != nil {
+		return nil, err
+	}
+	return &ModImageProducer{base}, nil
+}
+
+// EnqueueModImage 投递截图处理任务
+func (p *ModImageProducer) EnqueueModImage(imageID uint) error {
+	body, err := json.Marshal(event.ModImageTask{ImageID: imageID})
+	if err != nil {
+		return err
+	}
+	return p.Publish(body)
+}
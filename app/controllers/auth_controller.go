@@ -4,6 +4,7 @@ import (
 	"gin-web/app/dto"
 	"gin-web/app/middleware"
 	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -48,18 +49,18 @@ func (c *AuthController) Routes() []Route {
 // @Produce      json
 // @Param        request body dto.RegisterRequest true "注册信息"
 // @Success      200 {object} dto.Response "成功"
-// @Failure      400 {object} dto.Response "参数错误"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
 // @Router       /auth/register [post]
 func (c *AuthController) Register(ctx *gin.Context) {
 	var form dto.RegisterRequest
 	if err := ctx.ShouldBindJSON(&form); err != nil {
-		dto.ValidateFail(ctx, dto.GetErrorMsg(form, err))
+		dto.ValidateFailByError(ctx, form, err)
 		return
 	}
 
 	user, err := c.userService.Register(form)
 	if err != nil {
-		dto.BusinessFail(ctx, err.Error())
+		dto.FailByError(ctx, err)
 		return
 	}
 	dto.Success(ctx, user)
@@ -73,25 +74,25 @@ func (c *AuthController) Register(ctx *gin.Context) {
 // @Produce      json
 // @Param        request body dto.LoginRequest true "登录信息"
 // @Success      200 {object} dto.Response "成功返回 Token"
-// @Failure      400 {object} dto.Response "参数错误"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
 // @Failure      401 {object} dto.Response "认证失败"
 // @Router       /auth/login [post]
 func (c *AuthController) Login(ctx *gin.Context) {
 	var form dto.LoginRequest
 	if err := ctx.ShouldBindJSON(&form); err != nil {
-		dto.ValidateFail(ctx, dto.GetErrorMsg(form, err))
+		dto.ValidateFailByError(ctx, form, err)
 		return
 	}
 
 	user, err := c.userService.Login(form)
 	if err != nil {
-		dto.BusinessFail(ctx, err.Error())
+		dto.FailByError(ctx, err)
 		return
 	}
 
 	tokenData, _, err := c.jwtService.CreateToken(services.AppGuardName, user)
 	if err != nil {
-		dto.BusinessFail(ctx, err.Error())
+		dto.FailByError(ctx, err)
 		return
 	}
 	dto.Success(ctx, tokenData)
@@ -110,7 +111,7 @@ func (c *AuthController) Login(ctx *gin.Context) {
 func (c *AuthController) Info(ctx *gin.Context) {
 	user, err := c.userService.GetUserInfo(ctx.Keys["id"].(string))
 	if err != nil {
-		dto.BusinessFail(ctx, err.Error())
+		dto.FailByError(ctx, err)
 		return
 	}
 	dto.Success(ctx, user)
@@ -129,7 +130,7 @@ func (c *AuthController) Info(ctx *gin.Context) {
 func (c *AuthController) Logout(ctx *gin.Context) {
 	err := c.jwtService.JoinBlackList(ctx.Keys["token"].(*jwt.Token))
 	if err != nil {
-		dto.BusinessFail(ctx, "登出失败")
+		dto.FailByError(ctx, bizErr.Wrap(err, bizErr.CodeInternalError, "登出失败"))
 		return
 	}
 	dto.Success(ctx, nil)
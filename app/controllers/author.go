@@ -0,0 +1,223 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+)
+
+// AuthorController 作者控制器
+type AuthorController struct {
+	authorService  *services.AuthorService
+	jwtMiddleware  *middleware.JwtMiddleware
+	roleMiddleware *middleware.RoleMiddleware
+}
+
+// NewAuthorController 创建作者控制器实例
+func NewAuthorController(
+	authorService *services.AuthorService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *AuthorController {
+	return &AuthorController{
+		authorService:  authorService,
+		jwtMiddleware:  jwtMiddleware,
+		roleMiddleware: roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (ac *AuthorController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (ac *AuthorController) Routes() []Route {
+	auth := []gin.HandlerFunc{ac.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	admin := []gin.HandlerFunc{
+		ac.jwtMiddleware.JWTAuth(services.AppGuardName),
+		ac.roleMiddleware.Require(models.RoleAdmin),
+	}
+	return []Route{
+		{Method: "GET", Path: "/authors/:id", Handler: ac.Detail},
+		{Method: "GET", Path: "/authors/:id/mods", Handler: ac.Mods},
+		{Method: "POST", Path: "/mods/:id/maintainers", Handler: ac.AddMaintainer, Middlewares: auth},
+		{Method: "DELETE", Path: "/mods/:id/maintainers/:user_id", Handler: ac.RemoveMaintainer, Middlewares: auth},
+		{Method: "PUT", Path: "/mods/:id/owner", Handler: ac.SetOwner, Middlewares: admin},
+	}
+}
+
+// Detail 获取作者主页
+// @Summary      获取作者主页
+// @Description  获取作者信息及其拥有或共同维护的已公开 Mod 的聚合统计（Mod 数量、总下载、平均评分）
+// @Tags         作者
+// @Produce      json
+// @Param        id path int true "作者用户ID"
+// @Success      200 {object} dto.Response{data=dto.AuthorDetailResponse} "成功"
+// @Failure      404 {object} dto.Response "用户不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /authors/{id} [get]
+func (ac *AuthorController) Detail(c *gin.Context) {
+	var uri dto.AuthorDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := ac.authorService.GetAuthorDetail(uri.ID)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Mods 搜索作者的 Mod
+// @Summary      搜索作者的 Mod
+// @Description  作者拥有或共同维护的 Mod，参数与 /mods/search 相同（author_id 被忽略）
+// @Tags         作者
+// @Produce      json
+// @Param        id path int true "作者用户ID"
+// @Param        keyword query string false "搜索关键词"
+// @Param        game_id query string false "游戏ID（多个以逗号分隔）"
+// @Param        sort_by query string false "排序字段"
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Param        cursor query string false "分页游标"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
+// @Success      200 {object} dto.Response{data=dto.ModListResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /authors/{id}/mods [get]
+func (ac *AuthorController) Mods(c *gin.Context) {
+	var uri dto.AuthorDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 20
+	}
+
+	result, err := ac.authorService.SearchAuthorMods(uri.ID, req, requestLocale(c, ac.authorService))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// AddMaintainer 添加共同维护者
+// @Summary      添加共同维护者
+// @Description  作者添加共同维护者，维护者可以修改 Mod 及其依赖、发布版本和标签
+// @Tags         作者
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.ModMaintainerRequest true "维护者"
+// @Success      200 {object} dto.Response{data=dto.ModMaintainersResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "非作者"
+// @Router       /mods/{id}/maintainers [post]
+func (ac *AuthorController) AddMaintainer(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModMaintainerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := ac.authorService.AddMaintainer(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// RemoveMaintainer 移除共同维护者
+// @Summary      移除共同维护者
+// @Description  作者移除共同维护者，或维护者主动退出
+// @Tags         作者
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        user_id path int true "维护者用户ID"
+// @Success      200 {object} dto.Response{data=dto.ModMaintainersResponse} "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "非作者"
+// @Router       /mods/{id}/maintainers/{user_id} [delete]
+func (ac *AuthorController) RemoveMaintainer(c *gin.Context) {
+	var uri dto.ModMaintainerURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := ac.authorService.RemoveMaintainer(uri.ID, currentUserID(c), uri.UserID)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// SetOwner 变更 Mod 作者
+// @Summary      变更 Mod 作者
+// @Description  管理员为 Mod 指定作者账号（用于处理迁移时未能按作者名称自动关联的 Mod）
+// @Tags         作者
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.ModOwnerRequest true "新作者"
+// @Success      200 {object} dto.Response{data=dto.ModMaintainersResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /mods/{id}/owner [put]
+func (ac *AuthorController) SetOwner(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModOwnerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := ac.authorService.SetOwner(uri.ID, req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
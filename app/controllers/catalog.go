@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+)
+
+// maxCatalogUploadSize 导入文件大小上限
+const maxCatalogUploadSize = 20 << 20
+
+// CatalogController 目录数据批量导入导出控制器（仅管理员）
+type CatalogController struct {
+	catalogService *services.CatalogService
+	jwtMiddleware  *middleware.JwtMiddleware
+	roleMiddleware *middleware.RoleMiddleware
+}
+
+// NewCatalogController 创建目录数据控制器实例
+func NewCatalogController(
+	catalogService *services.CatalogService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *CatalogController {
+	return &CatalogController{
+		catalogService: catalogService,
+		jwtMiddleware:  jwtMiddleware,
+		roleMiddleware: roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (cc *CatalogController) Prefix() string {
+	return "/admin/catalog"
+}
+
+// Routes 返回路由列表
+func (cc *CatalogController) Routes() []Route {
+	admin := []gin.HandlerFunc{
+		cc.jwtMiddleware.JWTAuth(services.AppGuardName),
+		cc.roleMiddleware.Require(models.RoleAdmin),
+	}
+	return []Route{
+		{Method: "POST", Path: "/:entity/import", Handler: cc.Import, Middlewares: admin},
+		{Method: "GET", Path: "/:entity/export", Handler: cc.Export, Middlewares: admin},
+	}
+}
+
+// Import 导入目录数据
+// @Summary      导入目录数据
+// @Description  上传 CSV / JSON / NDJSON 文件批量导入游戏、分类或 Mod，按自然键（名称，Mod 为游戏 + 名称）新建或更新；
+// @Description  任何一行校验失败时不写入任何数据，dry_run=true 时只返回逐行校验结果
+// @Tags         目录管理
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     Bearer
+// @Param        entity path string true "数据类型" Enums(games, categories, mods)
+// @Param        file formData file true "导入文件（最大 20MB）"
+// @Param        format query string false "文件格式（为空时按扩展名推断）" Enums(csv, json, ndjson)
+// @Param        dry_run query bool false "只校验不写入"
+// @Success      200 {object} dto.Response{data=dto.CatalogImportResponse} "成功（含逐行错误）"
+// @Failure      400 {object} dto.Response "文件格式错误"
+// @Failure      413 {object} dto.Response "导入文件过大"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /admin/catalog/{entity}/import [post]
+func (cc *CatalogController) Import(c *gin.Context) {
+	var uri dto.CatalogEntityRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxCatalogUploadSize)
+	var req dto.CatalogImportRequest
+	if err := c.ShouldBind(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			dto.FailByError(c, bizErr.ErrCatalogTooLarge)
+			return
+		}
+		dto.FailByError(c, bizErr.ErrCatalogFileRequired)
+		return
+	}
+	format, err := services.CatalogFormat(req.Format, header.Filename)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		dto.FailByError(c, bizErr.ErrCatalogFileRequired)
+		return
+	}
+	defer file.Close()
+
+	result, err := cc.catalogService.Import(uri.Entity, format, file, req.DryRun)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Export 导出目录数据
+// @Summary      导出目录数据
+// @Description  导出全部游戏、分类（父分类在前）或 Mod（不限审核状态），列与导入文件一致，可直接重新导入
+// @Tags         目录管理
+// @Produce      text/csv
+// @Produce      json
+// @Security     Bearer
+// @Param        entity path string true "数据类型" Enums(games, categories, mods)
+// @Param        format query string false "文件格式" Enums(csv, json, ndjson) default(csv)
+// @Success      200 {file} file "导出文件"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /admin/catalog/{entity}/export [get]
+func (cc *CatalogController) Export(c *gin.Context) {
+	var uri dto.CatalogEntityRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CatalogExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+	if req.Format == "" {
+		req.Format = "csv"
+	}
+	format, err := services.CatalogFormat(req.Format, "")
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, uri.Entity, format))
+	if err := cc.catalogService.Export(uri.Entity, format, c.Writer); err != nil {
+		if c.Writer.Written() {
+			// 已开始输出文件内容，只能中断响应
+			_ = c.Error(err)
+			return
+		}
+		c.Writer.Header().Del("Content-Type")
+		c.Writer.Header().Del("Content-Disposition")
+		dto.FailByError(c, err)
+	}
+}
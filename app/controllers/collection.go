@@ -0,0 +1,466 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/services"
+)
+
+// CollectionController Mod 合集控制器
+type CollectionController struct {
+	collectionService *services.CollectionService
+	jwtMiddleware     *middleware.JwtMiddleware
+}
+
+// NewCollectionController 创建合集控制器实例
+func NewCollectionController(
+	collectionService *services.CollectionService,
+	jwtMiddleware *middleware.JwtMiddleware,
+) *CollectionController {
+	return &CollectionController{
+		collectionService: collectionService,
+		jwtMiddleware:     jwtMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (cc *CollectionController) Prefix() string {
+	return "/collections"
+}
+
+// Routes 返回路由列表
+func (cc *CollectionController) Routes() []Route {
+	auth := []gin.HandlerFunc{cc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	optional := []gin.HandlerFunc{cc.jwtMiddleware.OptionalAuth(services.AppGuardName)}
+	return []Route{
+		{Method: "GET", Path: "", Handler: cc.List, Middlewares: optional},
+		{Method: "POST", Path: "", Handler: cc.Create, Middlewares: auth},
+		{Method: "GET", Path: "/followed", Handler: cc.Followed, Middlewares: auth},
+		{Method: "GET", Path: "/:id", Handler: cc.Detail, Middlewares: optional},
+		{Method: "PUT", Path: "/:id", Handler: cc.Update, Middlewares: auth},
+		{Method: "DELETE", Path: "/:id", Handler: cc.Delete, Middlewares: auth},
+		{Method: "PUT", Path: "/:id/items", Handler: cc.ReplaceItems, Middlewares: auth},
+		{Method: "POST", Path: "/:id/items", Handler: cc.AddItem, Middlewares: auth},
+		{Method: "DELETE", Path: "/:id/items/:mod_id", Handler: cc.RemoveItem, Middlewares: auth},
+		{Method: "POST", Path: "/:id/follow", Handler: cc.Follow, Middlewares: auth},
+		{Method: "DELETE", Path: "/:id/follow", Handler: cc.Unfollow, Middlewares: auth},
+		{Method: "GET", Path: "/:id/dependencies", Handler: cc.Dependencies, Middlewares: optional},
+		{Method: "GET", Path: "/:id/manifest", Handler: cc.Manifest, Middlewares: optional},
+	}
+}
+
+// List 获取合集列表
+// @Summary      获取合集列表
+// @Description  分页查询公开合集；登录后按 owner_id 查询自己的合集时包含私有合集
+// @Tags         合集
+// @Produce      json
+// @Param        game_id query int false "游戏ID"
+// @Param        owner_id query int false "创建者ID"
+// @Param        sort_by query string false "排序方式" Enums(followers, updated_at, created_at)
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.CollectionListResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /collections [get]
+func (cc *CollectionController) List(c *gin.Context) {
+	var req dto.CollectionListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.collectionService.ListCollections(req, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Followed 获取关注的合集
+// @Summary      获取关注的合集
+// @Description  分页查询当前用户关注的公开合集，最近更新的在前
+// @Tags         合集
+// @Produce      json
+// @Security     Bearer
+// @Param        game_id query int false "游戏ID"
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.CollectionListResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /collections/followed [get]
+func (cc *CollectionController) Followed(c *gin.Context) {
+	var req dto.CollectionListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.collectionService.ListFollowed(req, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Create 创建合集
+// @Summary      创建合集
+// @Description  创建 Mod 合集，Mod 必须已公开且属于同一游戏，可锁定发布版本
+// @Tags         合集
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body dto.CollectionCreateRequest true "合集信息"
+// @Success      200 {object} dto.Response{data=dto.CollectionDetailResponse} "成功"
+// @Failure      400 {object} dto.Response "Mod 无效"
+// @Failure      404 {object} dto.Response "游戏不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /collections [post]
+func (cc *CollectionController) Create(c *gin.Context) {
+	var req dto.CollectionCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.collectionService.CreateCollection(currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Detail 获取合集详情
+// @Summary      获取合集详情
+// @Description  获取合集及其 Mod 列表（私有合集仅创建者可见）
+// @Tags         合集
+// @Produce      json
+// @Param        id path int true "合集ID"
+// @Success      200 {object} dto.Response{data=dto.CollectionDetailResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Router       /collections/{id} [get]
+func (cc *CollectionController) Detail(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := cc.collectionService.GetCollection(uri.ID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Update 更新合集
+// @Summary      更新合集
+// @Description  更新合集标题、描述与是否公开（仅创建者）
+// @Tags         合集
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "合集ID"
+// @Param        request body dto.CollectionUpdateRequest true "合集信息"
+// @Success      200 {object} dto.Response{data=dto.CollectionDetailResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是合集创建者"
+// @Router       /collections/{id} [put]
+func (cc *CollectionController) Update(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CollectionUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.collectionService.UpdateCollection(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Delete 删除合集
+// @Summary      删除合集
+// @Description  删除合集及其关注关系（仅创建者）
+// @Tags         合集
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "合集ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是合集创建者"
+// @Router       /collections/{id} [delete]
+func (cc *CollectionController) Delete(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := cc.collectionService.DeleteCollection(uri.ID, currentUserID(c)); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
+
+// ReplaceItems 替换合集 Mod 列表
+// @Summary      替换合集 Mod 列表
+// @Description  按请求顺序整体替换合集中的 Mod（用于排序与批量编辑，仅创建者）
+// @Tags         合集
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "合集ID"
+// @Param        request body dto.CollectionItemsRequest true "Mod 列表"
+// @Success      200 {object} dto.Response{data=dto.CollectionDetailResponse} "成功"
+// @Failure      400 {object} dto.Response "Mod 无效"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是合集创建者"
+// @Router       /collections/{id}/items [put]
+func (cc *CollectionController) ReplaceItems(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CollectionItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.collectionService.ReplaceItems(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// AddItem 向合集添加 Mod
+// @Summary      向合集添加 Mod
+// @Description  将 Mod 添加到合集末尾；Mod 已在合集中时更新锁定版本与备注（仅创建者）
+// @Tags         合集
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "合集ID"
+// @Param        request body dto.CollectionItemAddRequest true "Mod"
+// @Success      200 {object} dto.Response{data=dto.CollectionDetailResponse} "成功"
+// @Failure      400 {object} dto.Response "Mod 无效或已在合集中"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是合集创建者"
+// @Router       /collections/{id}/items [post]
+func (cc *CollectionController) AddItem(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CollectionItemAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.collectionService.AddItem(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// RemoveItem 从合集移除 Mod
+// @Summary      从合集移除 Mod
+// @Description  从合集中移除 Mod（仅创建者）
+// @Tags         合集
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "合集ID"
+// @Param        mod_id path int true "Mod ID"
+// @Success      200 {object} dto.Response{data=dto.CollectionDetailResponse} "成功"
+// @Failure      400 {object} dto.Response "Mod 不在合集中"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是合集创建者"
+// @Router       /collections/{id}/items/{mod_id} [delete]
+func (cc *CollectionController) RemoveItem(c *gin.Context) {
+	var uri dto.CollectionItemURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := cc.collectionService.RemoveItem(uri.ID, uri.ModID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Follow 关注合集
+// @Summary      关注合集
+// @Description  关注公开合集（重复关注不报错）
+// @Tags         合集
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "合集ID"
+// @Success      200 {object} dto.Response{data=dto.CollectionFollowResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /collections/{id}/follow [post]
+func (cc *CollectionController) Follow(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := cc.collectionService.Follow(uri.ID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Unfollow 取消关注合集
+// @Summary      取消关注合集
+// @Description  取消关注合集（未关注时不报错）
+// @Tags         合集
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "合集ID"
+// @Success      200 {object} dto.Response{data=dto.CollectionFollowResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /collections/{id}/follow [delete]
+func (cc *CollectionController) Unfollow(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := cc.collectionService.Unfollow(uri.ID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Dependencies 解析合集依赖
+// @Summary      解析合集依赖
+// @Description  合并合集中所有 Mod 的依赖，按拓扑序返回安装集合，并列出冲突与已下架的 Mod
+// @Tags         合集
+// @Produce      json
+// @Param        id path int true "合集ID"
+// @Param        include_optional query bool false "是否将可选依赖加入安装集合"
+// @Success      200 {object} dto.Response{data=dto.CollectionResolveResponse} "成功"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      409 {object} dto.Response "存在循环依赖"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /collections/{id}/dependencies [get]
+func (cc *CollectionController) Dependencies(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModDependencyResolveRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.collectionService.ResolveDependencies(uri.ID, currentUserID(c), req.IncludeOptional)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Manifest 下载整合包清单
+// @Summary      下载整合包清单
+// @Description  生成启动器使用的整合包清单文件（JSON），按安装顺序列出合集中的 Mod 及其依赖；存在依赖冲突时返回错误
+// @Tags         合集
+// @Produce      json
+// @Param        id path int true "合集ID"
+// @Param        include_optional query bool false "是否包含可选依赖"
+// @Success      200 {object} dto.CollectionManifest "清单文件"
+// @Failure      404 {object} dto.Response "合集不存在"
+// @Failure      409 {object} dto.Response "存在循环依赖或依赖冲突"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /collections/{id}/manifest [get]
+func (cc *CollectionController) Manifest(c *gin.Context) {
+	var uri dto.CollectionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModDependencyResolveRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	manifest, err := cc.collectionService.Manifest(uri.ID, currentUserID(c), req.IncludeOptional)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="collection-%d.json"`, manifest.Collection.ID))
+	c.JSON(http.StatusOK, manifest)
+}
@@ -0,0 +1,245 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+)
+
+// CommentController Mod 评论控制器
+type CommentController struct {
+	commentService *services.CommentService
+	jwtMiddleware  *middleware.JwtMiddleware
+	roleMiddleware *middleware.RoleMiddleware
+}
+
+// NewCommentController 创建评论控制器实例
+func NewCommentController(
+	commentService *services.CommentService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *CommentController {
+	return &CommentController{
+		commentService: commentService,
+		jwtMiddleware:  jwtMiddleware,
+		roleMiddleware: roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (cc *CommentController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (cc *CommentController) Routes() []Route {
+	auth := []gin.HandlerFunc{cc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	moderator := []gin.HandlerFunc{
+		cc.jwtMiddleware.JWTAuth(services.AppGuardName),
+		cc.roleMiddleware.Require(models.RoleModerator),
+	}
+	return []Route{
+		{Method: "GET", Path: "/mods/:id/comments", Handler: cc.List},
+		{Method: "POST", Path: "/mods/:id/comments", Handler: cc.Create, Middlewares: auth},
+		{Method: "GET", Path: "/comments/:id/replies", Handler: cc.Replies},
+		{Method: "PUT", Path: "/comments/:id", Handler: cc.Update, Middlewares: auth},
+		{Method: "DELETE", Path: "/comments/:id", Handler: cc.Delete, Middlewares: auth},
+		{Method: "DELETE", Path: "/moderation/comments/:id", Handler: cc.Remove, Middlewares: moderator},
+	}
+}
+
+// List 获取 Mod 评论
+// @Summary      获取 Mod 评论
+// @Description  游标分页获取顶层评论（最新的在前），回复通过 /comments/{id}/replies 获取
+// @Tags         评论
+// @Produce      json
+// @Param        id path int true "Mod ID"
+// @Param        cursor query string false "分页游标（取自上一页的 next_cursor）"
+// @Param        limit query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.CommentListResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /mods/{id}/comments [get]
+func (cc *CommentController) List(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CommentListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.commentService.ListComments(uri.ID, req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Create 发表评论
+// @Summary      发表评论
+// @Description  发表顶层评论或回复指定评论（每个用户的发表频率受限）
+// @Tags         评论
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.CommentCreateRequest true "评论内容"
+// @Success      200 {object} dto.Response{data=dto.CommentResponse} "成功"
+// @Failure      400 {object} dto.Response "评论内容为空"
+// @Failure      404 {object} dto.Response "Mod 或回复的评论不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      429 {object} dto.Response "发表过于频繁"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/comments [post]
+func (cc *CommentController) Create(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CommentCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.commentService.CreateComment(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Replies 获取评论回复
+// @Summary      获取评论回复
+// @Description  游标分页获取顶层评论下的所有回复（最早的在前），reply_to 为被回复的评论者
+// @Tags         评论
+// @Produce      json
+// @Param        id path int true "顶层评论ID"
+// @Param        cursor query string false "分页游标（取自上一页的 next_cursor）"
+// @Param        limit query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.CommentListResponse} "成功"
+// @Failure      404 {object} dto.Response "评论不存在或已删除"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /comments/{id}/replies [get]
+func (cc *CommentController) Replies(c *gin.Context) {
+	var uri dto.CommentURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CommentListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.commentService.ListReplies(uri.ID, req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Update 编辑评论
+// @Summary      编辑评论
+// @Description  评论者编辑自己的评论
+// @Tags         评论
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "评论ID"
+// @Param        request body dto.CommentUpdateRequest true "评论内容"
+// @Success      200 {object} dto.Response{data=dto.CommentResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "非评论者"
+// @Router       /comments/{id} [put]
+func (cc *CommentController) Update(c *gin.Context) {
+	var uri dto.CommentURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.CommentUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := cc.commentService.UpdateComment(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Delete 删除评论
+// @Summary      删除评论
+// @Description  评论者删除自己的评论（只隐藏内容，回复仍然保留）
+// @Tags         评论
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "评论ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "非评论者"
+// @Router       /comments/{id} [delete]
+func (cc *CommentController) Delete(c *gin.Context) {
+	var uri dto.CommentURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := cc.commentService.DeleteComment(uri.ID, currentUserID(c)); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
+
+// Remove 移除评论
+// @Summary      移除评论
+// @Description  审核员移除违规评论（只隐藏内容，回复仍然保留）
+// @Tags         审核
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "评论ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /moderation/comments/{id} [delete]
+func (cc *CommentController) Remove(c *gin.Context) {
+	var uri dto.CommentURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := cc.commentService.RemoveComment(uri.ID); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
@@ -1,6 +1,13 @@
 package controllers
 
-import "github.com/gin-gonic/gin"
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/middleware"
+	"gin-web/app/services"
+)
 
 // Route 路由定义
 type Route struct {
@@ -8,6 +15,9 @@ type Route struct {
 	Path        string
 	Handler     gin.HandlerFunc
 	Middlewares []gin.HandlerFunc
+	// CacheControl GET 接口成功响应的 Cache-Control 策略（如 "public, max-age=300"）
+	// 非空时启用 ETag 与条件请求（If-None-Match / If-Modified-Since 命中时返回 304）
+	CacheControl string
 }
 
 // Controller 控制器接口
@@ -22,7 +32,12 @@ type Controller interface {
 func RegisterController(router *gin.RouterGroup, controller Controller) {
 	group := router.Group(controller.Prefix())
 	for _, route := range controller.Routes() {
-		handlers := append(route.Middlewares, route.Handler)
+		handlers := make([]gin.HandlerFunc, 0, len(route.Middlewares)+2)
+		handlers = append(handlers, route.Middlewares...)
+		if route.CacheControl != "" {
+			handlers = append(handlers, middleware.HTTPCache(route.CacheControl))
+		}
+		handlers = append(handlers, route.Handler)
 		switch route.Method {
 		case "GET":
 			group.GET(route.Path, handlers...)
@@ -37,3 +52,32 @@ func RegisterController(router *gin.RouterGroup, controller Controller) {
 		}
 	}
 }
+
+// currentUserID 获取当前登录用户 ID（由 JWT 中间件写入），未登录时返回 0
+func currentUserID(c *gin.Context) uint {
+	id, err := strconv.ParseUint(c.GetString("id"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return uint(id)
+}
+
+// visitorKey 当前请求的访客标识（登录用户按用户 ID，匿名访客按 IP 与 User-Agent）
+func visitorKey(c *gin.Context) string {
+	return services.VisitorKey(currentUserID(c), c.ClientIP(), c.Request.UserAgent())
+}
+
+// localeNegotiator 响应语言协商（由 ModService 等服务实现）
+type localeNegotiator interface {
+	NegotiateLocale(acceptLanguage, lang string) string
+}
+
+// requestLocale 按 lang 查询参数与 Accept-Language 请求头协商响应语言，并写入 Content-Language 响应头
+func requestLocale(c *gin.Context, negotiator localeNegotiator) string {
+	locale := negotiator.NegotiateLocale(c.GetHeader("Accept-Language"), c.Query("lang"))
+	if locale != "" {
+		c.Header("Content-Language", locale)
+		c.Header("Vary", "Accept-Language")
+	}
+	return locale
+}
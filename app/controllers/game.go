@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/services"
+)
+
+// GameController 游戏控制器
+type GameController struct {
+	gameService   *services.GameService
+	jwtMiddleware *middleware.JwtMiddleware
+}
+
+// NewGameController 创建游戏控制器实例
+func NewGameController(gameService *services.GameService, jwtMiddleware *middleware.JwtMiddleware) *GameController {
+	return &GameController{gameService: gameService, jwtMiddleware: jwtMiddleware}
+}
+
+// Prefix 返回路由前缀
+func (gc *GameController) Prefix() string {
+	return "/games"
+}
+
+// Routes 返回路由列表
+func (gc *GameController) Routes() []Route {
+	auth := []gin.HandlerFunc{gc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	return []Route{
+		{Method: "GET", Path: "/:id", Handler: gc.Detail},
+		{Method: "GET", Path: "/:id/mods", Handler: gc.Mods},
+		{Method: "GET", Path: "/:id/versions", Handler: gc.Versions},
+		{Method: "POST", Path: "/:id/versions", Handler: gc.CreateVersion, Middlewares: auth},
+		{Method: "DELETE", Path: "/:id/versions/:version_id", Handler: gc.DeleteVersion, Middlewares: auth},
+	}
+}
+
+// Detail 获取游戏详情
+// @Summary      获取游戏详情
+// @Description  获取游戏信息、支持的版本及聚合统计（Mod 数量、总下载、热门分类、最新 Mod）
+// @Tags         游戏
+// @Produce      json
+// @Param        id path int true "游戏ID"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
+// @Success      200 {object} dto.Response{data=dto.GameDetailResponse} "成功"
+// @Failure      404 {object} dto.Response "游戏不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /games/{id} [get]
+func (gc *GameController) Detail(c *gin.Context) {
+	var uri dto.GameDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := gc.gameService.GetGameDetail(uri.ID, requestLocale(c, gc.gameService))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Mods 搜索游戏下的 Mod
+// @Summary      搜索游戏下的 Mod
+// @Description  限定游戏的 Mod 搜索，参数与 /mods/search 相同（game_id 被忽略）
+// @Tags         游戏
+// @Produce      json
+// @Param        id path int true "游戏ID"
+// @Param        keyword query string false "搜索关键词"
+// @Param        category_id query string false "分类ID（多个以逗号分隔）"
+// @Param        game_version_id query string false "兼容的游戏版本ID（多个以逗号分隔）"
+// @Param        game_version query string false "兼容的游戏版本约束（如 >=1.6, <1.7）"
+// @Param        sort_by query string false "排序字段"
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Param        cursor query string false "分页游标"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
+// @Success      200 {object} dto.Response{data=dto.ModListResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /games/{id}/mods [get]
+func (gc *GameController) Mods(c *gin.Context) {
+	var uri dto.GameDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = 20
+	}
+
+	result, err := gc.gameService.SearchGameMods(uri.ID, req, requestLocale(c, gc.gameService))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Versions 获取游戏版本列表
+// @Summary      获取游戏版本列表
+// @Description  获取游戏支持的版本（最新的在前）
+// @Tags         游戏
+// @Produce      json
+// @Param        id path int true "游戏ID"
+// @Success      200 {object} dto.Response{data=dto.GameVersionListResponse} "成功"
+// @Router       /games/{id}/versions [get]
+func (gc *GameController) Versions(c *gin.Context) {
+	var uri dto.GameDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := gc.gameService.GetGameVersions(uri.ID)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// CreateVersion 添加游戏版本
+// @Summary      添加游戏版本
+// @Description  为游戏添加一个支持的版本
+// @Tags         游戏
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "游戏ID"
+// @Param        request body dto.GameVersionSaveRequest true "游戏版本"
+// @Success      200 {object} dto.Response{data=models.GameVersion} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /games/{id}/versions [post]
+func (gc *GameController) CreateVersion(c *gin.Context) {
+	var uri dto.GameDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.GameVersionSaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := gc.gameService.CreateGameVersion(uri.ID, req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// DeleteVersion 删除游戏版本
+// @Summary      删除游戏版本
+// @Description  删除游戏版本，同时移除 Mod 及发布版本对它的兼容性声明
+// @Tags         游戏
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "游戏ID"
+// @Param        version_id path int true "游戏版本ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /games/{id}/versions/{version_id} [delete]
+func (gc *GameController) DeleteVersion(c *gin.Context) {
+	var uri dto.GameVersionURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := gc.gameService.DeleteGameVersion(uri.ID, uri.VersionID); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
@@ -1,23 +1,34 @@
 package controllers
 
 import (
+	"encoding/json"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"gin-web/app/dto"
+	"gin-web/app/middleware"
 	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+)
+
+// 目录接口的 HTTP 缓存策略
+const (
+	// catalogCacheControl 游戏、分类列表变化很少，允许浏览器与 CDN 缓存 5 分钟
+	catalogCacheControl = "public, max-age=300"
+	// modDetailCacheControl 详情每次请求都需要记录浏览，缓存须先向服务端验证（未变更时返回 304）
+	modDetailCacheControl = "public, no-cache"
 )
 
 // ModController mod控制器
 type ModController struct {
-	modService *services.ModService
+	modService    *services.ModService
+	jwtMiddleware *middleware.JwtMiddleware
 }
 
 // NewModController 创建Mod控制器实例
-func NewModController(modService *services.ModService) *ModController {
-	return &ModController{modService: modService}
+func NewModController(modService *services.ModService, jwtMiddleware *middleware.JwtMiddleware) *ModController {
+	return &ModController{modService: modService, jwtMiddleware: jwtMiddleware}
 }
 
 // Prefix 返回路由前缀
@@ -27,12 +38,19 @@ func (mc *ModController) Prefix() string {
 
 // Routes 返回路由列表
 func (mc *ModController) Routes() []Route {
+	auth := []gin.HandlerFunc{mc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	// 详情与下载可匿名访问，登录用户按用户统计独立访客
+	optional := []gin.HandlerFunc{mc.jwtMiddleware.OptionalAuth(services.AppGuardName)}
 	return []Route{
 		{Method: "GET", Path: "/mods/search", Handler: mc.Search},
-		{Method: "GET", Path: "/mods/:id", Handler: mc.Detail},
-		{Method: "GET", Path: "/mods/:id/download", Handler: mc.Download},
-		{Method: "GET", Path: "/games", Handler: mc.Games},
-		{Method: "GET", Path: "/categories", Handler: mc.Categories},
+		{Method: "POST", Path: "/mods", Handler: mc.Create, Middlewares: auth},
+		{Method: "GET", Path: "/mods/:id", Handler: mc.Detail, Middlewares: optional, CacheControl: modDetailCacheControl},
+		{Method: "PUT", Path: "/mods/:id", Handler: mc.Update, Middlewares: auth},
+		{Method: "DELETE", Path: "/mods/:id", Handler: mc.Delete, Middlewares: auth},
+		{Method: "GET", Path: "/mods/:id/download", Handler: mc.Download, Middlewares: optional},
+		{Method: "GET", Path: "/games", Handler: mc.Games, CacheControl: catalogCacheControl},
+		{Method: "GET", Path: "/categories", Handler: mc.Categories, CacheControl: catalogCacheControl},
+		{Method: "GET", Path: "/categories/tree", Handler: mc.CategoryTree, CacheControl: catalogCacheControl},
 	}
 }
 
@@ -43,18 +61,29 @@ func (mc *ModController) Routes() []Route {
 // @Accept       json
 // @Produce      json
 // @Param        keyword query string false "搜索关键词"
-// @Param        game_id query int false "游戏ID"
-// @Param        category_id query int false "分类ID"
+// @Param        game_id query string false "游戏ID（多个以逗号分隔）"
+// @Param        category_id query string false "分类ID（多个以逗号分隔）"
+// @Param        sort_by query string false "排序字段（有关键词时默认 relevance）" Enums(relevance, trending, rating, download_count, view_count, created_at, updated_at)
 // @Param        page query int false "页码" default(1)
 // @Param        page_size query int false "每页数量" default(20)
+// @Param        cursor query string false "分页游标（取自上一页的 next_cursor，传入时忽略 page）"
+// @Param        facets query bool false "是否返回分面统计"
+// @Param        tag query string false "标签（多个以逗号分隔）"
+// @Param        game_version_id query string false "兼容的游戏版本ID（多个以逗号分隔，Mod 或其任一发布版本兼容即可）"
+// @Param        game_version query string false "兼容的游戏版本约束（按版本号匹配，如 >=1.6, <1.7 或 ~1.6；需同时指定 game_id）"
+// @Param        author query string false "作者名称（模糊匹配）"
+// @Param        author_id query int false "作者用户ID（作者本人或共同维护者）"
+// @Param        with_total query bool false "是否统计总数（默认页码分页统计，游标分页不统计）"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
 // @Success      200 {object} dto.Response "成功"
-// @Failure      400 {object} dto.Response "参数错误"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
 // @Router       /mods/search [get]
 func (mc *ModController) Search(c *gin.Context) {
 	var req dto.ModSearchRequest
 
 	if err := c.ShouldBindQuery(&req); err != nil {
-		dto.ValidateFail(c, err.Error())
+		dto.ValidateFailByError(c, req, err)
 		return
 	}
 
@@ -65,9 +94,9 @@ func (mc *ModController) Search(c *gin.Context) {
 		req.PageSize = 20
 	}
 
-	result, err := mc.modService.SearchMods(req)
+	result, err := mc.modService.SearchMods(req, requestLocale(c, mc.modService))
 	if err != nil {
-		dto.BusinessFail(c, err.Error())
+		dto.FailByError(c, err)
 		return
 	}
 
@@ -76,59 +105,168 @@ func (mc *ModController) Search(c *gin.Context) {
 
 // Detail 获取mod详情
 // @Summary      获取 Mod 详情
-// @Description  根据 ID 获取 Mod 详细信息
+// @Description  根据 ID 获取已通过审核的 Mod 详细信息（记录一次浏览）；支持 If-None-Match 条件请求
 // @Tags         Mod
 // @Accept       json
 // @Produce      json
 // @Param        id path int true "Mod ID"
+// @Param        If-None-Match header string false "上次响应的 ETag，内容未变更时返回 304"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
 // @Success      200 {object} dto.Response "成功"
+// @Success      304 "内容未变更"
 // @Failure      404 {object} dto.Response "未找到"
 // @Router       /mods/{id} [get]
 func (mc *ModController) Detail(c *gin.Context) {
 	var req dto.ModDetailRequest
 
 	if err := c.ShouldBindUri(&req); err != nil {
-		dto.ValidateFail(c, err.Error())
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := mc.modService.GetModDetail(req.ID, visitorKey(c), requestLocale(c, mc.modService))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	setModDetailValidators(c, result)
+	dto.Success(c, result)
+}
+
+// setModDetailValidators 写入 Mod 详情的 ETag
+// 浏览、下载次数每次请求都可能变化，不参与 ETag 计算，其余内容变化（包括翻译、截图）都会生成新的 ETag；
+// 标签、截图、发布版本等关联数据变化时 Mod 的 UpdatedAt 不变，因此不写入 Last-Modified，避免 If-Modified-Since 误判为未变更
+func setModDetailValidators(c *gin.Context, result *dto.ModDetailResponse) {
+	stable := *result
+	stable.ViewCount = 0
+	stable.DownloadCount = 0
+	data, err := json.Marshal(stable)
+	if err != nil {
+		return
+	}
+	c.Header("ETag", middleware.WeakETag(data))
+}
+
+// Create 创建mod
+// @Summary      创建 Mod
+// @Description  创建新的 Mod（进入待审核状态，draft=true 时保存为草稿），审核通过后公开
+// @Tags         Mod
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body dto.ModSaveRequest true "Mod 信息"
+// @Success      200 {object} dto.Response{data=dto.ModDetailResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods [post]
+func (mc *ModController) Create(c *gin.Context) {
+	var req dto.ModSaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := mc.modService.CreateMod(req, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Update 更新mod
+// @Summary      更新 Mod
+// @Description  整体更新 Mod 信息（仅作者或共同维护者）
+// @Tags         Mod
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.ModSaveRequest true "Mod 信息"
+// @Success      200 {object} dto.Response{data=dto.ModDetailResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "非作者或共同维护者"
+// @Router       /mods/{id} [put]
+func (mc *ModController) Update(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
 		return
 	}
 
-	result, err := mc.modService.GetModDetail(req.ID)
+	var req dto.ModSaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := mc.modService.UpdateMod(uri.ID, currentUserID(c), req)
 	if err != nil {
-		dto.BusinessFail(c, "Mod not found")
+		dto.FailByError(c, err)
 		return
 	}
 
 	dto.Success(c, result)
 }
 
+// Delete 删除mod
+// @Summary      删除 Mod
+// @Description  删除 Mod 及其分类关联（仅作者或共同维护者）
+// @Tags         Mod
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "非作者或共同维护者"
+// @Router       /mods/{id} [delete]
+func (mc *ModController) Delete(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := mc.modService.DeleteMod(uri.ID, currentUserID(c)); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
+
 // Download 下载mod
 // @Summary      下载 Mod
-// @Description  获取 Mod 下载链接并重定向
+// @Description  获取 Mod 下载链接并重定向（记录一次下载）
 // @Tags         Mod
 // @Param        id path int true "Mod ID"
 // @Success      302 {string} string "重定向到下载链接"
-// @Failure      404 {object} dto.Response "未找到"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      404 {object} dto.Response "Mod 不存在或暂无下载链接"
 // @Router       /mods/{id}/download [get]
 func (mc *ModController) Download(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseUint(idStr, 10, 32)
-	if err != nil {
-		dto.ValidateFail(c, "Invalid mod ID")
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
 		return
 	}
 
-	result, err := mc.modService.GetModDetail(uint(id))
+	downloadURL, err := mc.modService.GetDownloadURL(uri.ID, visitorKey(c))
 	if err != nil {
-		dto.BusinessFail(c, "Mod not found")
+		dto.FailByError(c, err)
 		return
 	}
 
-	if result.DownloadURL != "" {
-		c.Redirect(http.StatusFound, result.DownloadURL)
+	if downloadURL != "" {
+		c.Redirect(http.StatusFound, downloadURL)
 		return
 	}
 
-	dto.BusinessFail(c, "Download URL not available")
+	dto.FailByError(c, bizErr.ErrDownloadNotFound)
 }
 
 // Games 获取游戏列表
@@ -136,12 +274,16 @@ func (mc *ModController) Download(c *gin.Context) {
 // @Description  获取所有支持的游戏列表
 // @Tags         Mod
 // @Produce      json
+// @Param        If-None-Match header string false "上次响应的 ETag，内容未变更时返回 304"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
 // @Success      200 {object} dto.Response "成功"
+// @Success      304 "内容未变更（If-None-Match 命中）"
 // @Router       /games [get]
 func (mc *ModController) Games(c *gin.Context) {
-	result, err := mc.modService.GetGames()
+	result, err := mc.modService.GetGames(requestLocale(c, mc.modService))
 	if err != nil {
-		dto.BusinessFail(c, err.Error())
+		dto.FailByError(c, err)
 		return
 	}
 
@@ -153,12 +295,37 @@ func (mc *ModController) Games(c *gin.Context) {
 // @Description  获取所有 Mod 分类列表
 // @Tags         Mod
 // @Produce      json
+// @Param        If-None-Match header string false "上次响应的 ETag，内容未变更时返回 304"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
 // @Success      200 {object} dto.Response "成功"
+// @Success      304 "内容未变更（If-None-Match 命中）"
 // @Router       /categories [get]
 func (mc *ModController) Categories(c *gin.Context) {
-	result, err := mc.modService.GetCategories()
+	result, err := mc.modService.GetCategories(requestLocale(c, mc.modService))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// CategoryTree 获取分类树
+// @Summary      获取分类树
+// @Description  按父子关系返回分类树（按分类筛选 Mod 时会包含所有子孙分类）
+// @Tags         Mod
+// @Produce      json
+// @Param        If-None-Match header string false "上次响应的 ETag，内容未变更时返回 304"
+// @Param        lang query string false "响应语言（优先于 Accept-Language，如 en）"
+// @Param        Accept-Language header string false "响应语言偏好，未翻译的内容使用默认语言"
+// @Success      200 {object} dto.Response{data=dto.CategoryTreeResponse} "成功"
+// @Success      304 "内容未变更（If-None-Match 命中）"
+// @Router       /categories/tree [get]
+func (mc *ModController) CategoryTree(c *gin.Context) {
+	result, err := mc.modService.GetCategoryTree(requestLocale(c, mc.modService))
 	if err != nil {
-		dto.BusinessFail(c, err.Error())
+		dto.FailByError(c, err)
 		return
 	}
 
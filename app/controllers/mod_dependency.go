@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/services"
+)
+
+// ModDependencyController Mod 依赖控制器
+type ModDependencyController struct {
+	depService    *services.ModDependencyService
+	jwtMiddleware *middleware.JwtMiddleware
+}
+
+// NewModDependencyController 创建 Mod 依赖控制器实例
+func NewModDependencyController(depService *services.ModDependencyService, jwtMiddleware *middleware.JwtMiddleware) *ModDependencyController {
+	return &ModDependencyController{depService: depService, jwtMiddleware: jwtMiddleware}
+}
+
+// Prefix 返回路由前缀
+func (dc *ModDependencyController) Prefix() string {
+	return "/mods/:id/dependencies"
+}
+
+// Routes 返回路由列表
+func (dc *ModDependencyController) Routes() []Route {
+	auth := []gin.HandlerFunc{dc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	return []Route{
+		{Method: "GET", Path: "", Handler: dc.Resolve},
+		{Method: "POST", Path: "", Handler: dc.Create, Middlewares: auth},
+		{Method: "PUT", Path: "/:dep_id", Handler: dc.Update, Middlewares: auth},
+		{Method: "DELETE", Path: "/:dep_id", Handler: dc.Delete, Middlewares: auth},
+	}
+}
+
+// Resolve 解析依赖
+// @Summary      解析 Mod 依赖
+// @Description  返回直接声明的依赖关系和完整的传递安装集合（按拓扑序，依赖在前）；存在循环依赖或冲突时返回错误
+// @Tags         Mod 依赖
+// @Produce      json
+// @Param        id path int true "Mod ID"
+// @Param        include_optional query bool false "是否将可选依赖加入安装集合"
+// @Success      200 {object} dto.Response{data=dto.ModDependencyResolveResponse} "成功"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      409 {object} dto.Response "循环依赖或依赖冲突"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /mods/{id}/dependencies [get]
+func (dc *ModDependencyController) Resolve(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModDependencyResolveRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := dc.depService.ResolveDependencies(uri.ID, req.IncludeOptional)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Create 添加依赖
+// @Summary      添加 Mod 依赖
+// @Description  为 Mod 声明一条依赖关系（必需 / 可选 / 不兼容）（仅作者或共同维护者）
+// @Tags         Mod 依赖
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.ModDependencySaveRequest true "依赖关系"
+// @Success      200 {object} dto.Response{data=dto.ModDependencyResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/dependencies [post]
+func (dc *ModDependencyController) Create(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModDependencySaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := dc.depService.CreateDependency(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Update 更新依赖
+// @Summary      更新 Mod 依赖
+// @Description  整体更新一条依赖关系（仅作者或共同维护者）
+// @Tags         Mod 依赖
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        dep_id path int true "依赖关系 ID"
+// @Param        request body dto.ModDependencySaveRequest true "依赖关系"
+// @Success      200 {object} dto.Response{data=dto.ModDependencyResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/dependencies/{dep_id} [put]
+func (dc *ModDependencyController) Update(c *gin.Context) {
+	var uri dto.ModDependencyURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModDependencySaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := dc.depService.UpdateDependency(uri.ID, uri.DependencyID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Delete 删除依赖
+// @Summary      删除 Mod 依赖
+// @Description  删除一条依赖关系（仅作者或共同维护者）
+// @Tags         Mod 依赖
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        dep_id path int true "依赖关系 ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/dependencies/{dep_id} [delete]
+func (dc *ModDependencyController) Delete(c *gin.Context) {
+	var uri dto.ModDependencyURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := dc.depService.DeleteDependency(uri.ID, uri.DependencyID, currentUserID(c)); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
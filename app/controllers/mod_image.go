@@ -0,0 +1,302 @@
+package controllers
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+)
+
+// multipartOverhead 上传请求中 multipart 边界与其他表单字段的额外大小
+const multipartOverhead = 1 << 20
+
+// ModImageController Mod 截图与游戏封面上传控制器
+type ModImageController struct {
+	imageService   *services.ModImageService
+	jwtMiddleware  *middleware.JwtMiddleware
+	roleMiddleware *middleware.RoleMiddleware
+}
+
+// NewModImageController 创建截图控制器实例
+func NewModImageController(
+	imageService *services.ModImageService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *ModImageController {
+	return &ModImageController{
+		imageService:   imageService,
+		jwtMiddleware:  jwtMiddleware,
+		roleMiddleware: roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (ic *ModImageController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (ic *ModImageController) Routes() []Route {
+	auth := []gin.HandlerFunc{ic.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	admin := []gin.HandlerFunc{
+		ic.jwtMiddleware.JWTAuth(services.AppGuardName),
+		ic.roleMiddleware.Require(models.RoleAdmin),
+	}
+	return []Route{
+		{Method: "GET", Path: "/mods/:id/images", Handler: ic.List, Middlewares: auth},
+		{Method: "POST", Path: "/mods/:id/images", Handler: ic.Upload, Middlewares: auth},
+		{Method: "PUT", Path: "/mods/:id/images", Handler: ic.Reorder, Middlewares: auth},
+		{Method: "PUT", Path: "/mods/:id/images/:image_id", Handler: ic.UpdateCaption, Middlewares: auth},
+		{Method: "DELETE", Path: "/mods/:id/images/:image_id", Handler: ic.Delete, Middlewares: auth},
+		{Method: "POST", Path: "/admin/games/:id/cover", Handler: ic.UploadGameCover, Middlewares: admin},
+	}
+}
+
+// List 获取 Mod 的截图
+// @Summary      获取 Mod 的截图
+// @Description  获取 Mod 的全部截图，包括处理中和处理失败的截图（仅作者或共同维护者；公开的截图见 Mod 详情）
+// @Tags         Mod截图
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Success      200 {object} dto.Response{data=dto.ModImageListResponse} "成功"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/images [get]
+func (ic *ModImageController) List(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := ic.imageService.List(uri.ID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Upload 上传截图
+// @Summary      上传截图
+// @Description  上传 JPEG / PNG / GIF 截图并排在最后，缩略图与 WebP 在后台生成，处理完成前状态为 pending（仅作者或共同维护者）
+// @Tags         Mod截图
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        file formData file true "图片文件（默认最大 10MB）"
+// @Param        caption formData string false "说明文字"
+// @Success      200 {object} dto.Response{data=dto.ModImageResponse} "成功"
+// @Failure      400 {object} dto.Response "图片格式不支持或截图数量已达上限"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      413 {object} dto.Response "文件过大"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/images [post]
+func (ic *ModImageController) Upload(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, ic.imageService.MaxUploadSize()+multipartOverhead)
+	var req dto.ModImageUploadRequest
+	if err := c.ShouldBind(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	file, ok := openUpload(c)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	result, err := ic.imageService.Upload(uri.ID, currentUserID(c), file, req.Caption)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Reorder 调整截图顺序
+// @Summary      调整截图顺序
+// @Description  按给定顺序重排截图，列表需包含该 Mod 的全部截图；第一张处理完成的截图作为列表缩略图（仅作者或共同维护者）
+// @Tags         Mod截图
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.ModImageOrderRequest true "截图顺序"
+// @Success      200 {object} dto.Response{data=dto.ModImageListResponse} "成功"
+// @Failure      400 {object} dto.Response "截图列表不完整"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/images [put]
+func (ic *ModImageController) Reorder(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModImageOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := ic.imageService.Reorder(uri.ID, currentUserID(c), req.ImageIDs)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// UpdateCaption 修改截图说明
+// @Summary      修改截图说明
+// @Description  修改截图的说明文字（仅作者或共同维护者）
+// @Tags         Mod截图
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        image_id path int true "截图 ID"
+// @Param        request body dto.ModImageCaptionRequest true "说明文字"
+// @Success      200 {object} dto.Response{data=dto.ModImageResponse} "成功"
+// @Failure      404 {object} dto.Response "Mod 或截图不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/images/{image_id} [put]
+func (ic *ModImageController) UpdateCaption(c *gin.Context) {
+	var uri dto.ModImageURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModImageCaptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := ic.imageService.UpdateCaption(uri.ID, uri.ImageID, currentUserID(c), req.Caption)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Delete 删除截图
+// @Summary      删除截图
+// @Description  删除截图及其缩略图、WebP 文件（仅作者或共同维护者）
+// @Tags         Mod截图
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        image_id path int true "截图 ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      404 {object} dto.Response "Mod 或截图不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/images/{image_id} [delete]
+func (ic *ModImageController) Delete(c *gin.Context) {
+	var uri dto.ModImageURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := ic.imageService.Delete(uri.ID, uri.ImageID, currentUserID(c)); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
+
+// UploadGameCover 上传游戏封面
+// @Summary      上传游戏封面
+// @Description  上传 JPEG / PNG / GIF 封面，同步缩放并生成 JPEG 与 WebP，JPEG 地址写入游戏的 cover_image（仅管理员）
+// @Tags         Mod截图
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "游戏ID"
+// @Param        file formData file true "图片文件（默认最大 10MB）"
+// @Success      200 {object} dto.Response{data=dto.GameCoverResponse} "成功"
+// @Failure      400 {object} dto.Response "图片格式不支持"
+// @Failure      404 {object} dto.Response "游戏不存在"
+// @Failure      413 {object} dto.Response "文件过大"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /admin/games/{id}/cover [post]
+func (ic *ModImageController) UploadGameCover(c *gin.Context) {
+	var uri dto.GameDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, ic.imageService.MaxUploadSize()+multipartOverhead)
+	file, ok := openUpload(c)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	result, err := ic.imageService.UploadGameCover(uri.ID, file)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// openUpload 打开 multipart 字段 file 上传的图片，失败时写入错误响应
+func openUpload(c *gin.Context) (multipart.File, bool) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			dto.FailByError(c, bizErr.ErrImageTooLarge)
+			return nil, false
+		}
+		dto.FailByError(c, bizErr.ErrImageRequired)
+		return nil, false
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		dto.FailByError(c, bizErr.ErrImageRequired)
+		return nil, false
+	}
+	return file, true
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/services"
+)
+
+// ModReleaseController Mod 发布版本控制器
+type ModReleaseController struct {
+	releaseService *services.ModReleaseService
+	jwtMiddleware  *middleware.JwtMiddleware
+}
+
+// NewModReleaseController 创建 Mod 发布版本控制器实例
+func NewModReleaseController(releaseService *services.ModReleaseService, jwtMiddleware *middleware.JwtMiddleware) *ModReleaseController {
+	return &ModReleaseController{releaseService: releaseService, jwtMiddleware: jwtMiddleware}
+}
+
+// Prefix 返回路由前缀
+func (rc *ModReleaseController) Prefix() string {
+	return "/mods/:id/releases"
+}
+
+// Routes 返回路由列表
+func (rc *ModReleaseController) Routes() []Route {
+	auth := []gin.HandlerFunc{rc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	return []Route{
+		{Method: "GET", Path: "", Handler: rc.List},
+		{Method: "POST", Path: "", Handler: rc.Create, Middlewares: auth},
+		{Method: "DELETE", Path: "/:release_id", Handler: rc.Delete, Middlewares: auth},
+	}
+}
+
+// List 获取发布版本列表
+// @Summary      获取 Mod 发布版本
+// @Description  获取 Mod 的所有发布版本及其兼容的游戏版本（最新的在前）
+// @Tags         Mod 发布版本
+// @Produce      json
+// @Param        id path int true "Mod ID"
+// @Success      200 {object} dto.Response{data=dto.ModReleaseListResponse} "成功"
+// @Router       /mods/{id}/releases [get]
+func (rc *ModReleaseController) List(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := rc.releaseService.ListReleases(uri.ID)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Create 发布版本
+// @Summary      发布 Mod 版本
+// @Description  发布新版本并声明兼容的游戏版本（仅作者或共同维护者）
+// @Tags         Mod 发布版本
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.ModReleaseSaveRequest true "发布版本"
+// @Success      200 {object} dto.Response{data=models.ModRelease} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/releases [post]
+func (rc *ModReleaseController) Create(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModReleaseSaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := rc.releaseService.CreateRelease(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Delete 删除发布版本
+// @Summary      删除 Mod 发布版本
+// @Description  删除一个发布版本（仅作者或共同维护者）
+// @Tags         Mod 发布版本
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        release_id path int true "发布版本ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/releases/{release_id} [delete]
+func (rc *ModReleaseController) Delete(c *gin.Context) {
+	var uri dto.ModReleaseURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := rc.releaseService.DeleteRelease(uri.ID, uri.ReleaseID, currentUserID(c)); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
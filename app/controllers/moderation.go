@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+)
+
+// ModerationController Mod 审核控制器
+type ModerationController struct {
+	moderationService *services.ModerationService
+	jwtMiddleware     *middleware.JwtMiddleware
+	roleMiddleware    *middleware.RoleMiddleware
+}
+
+// NewModerationController 创建 Mod 审核控制器实例
+func NewModerationController(
+	moderationService *services.ModerationService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *ModerationController {
+	return &ModerationController{
+		moderationService: moderationService,
+		jwtMiddleware:     jwtMiddleware,
+		roleMiddleware:    roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (mc *ModerationController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (mc *ModerationController) Routes() []Route {
+	auth := []gin.HandlerFunc{mc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	moderator := []gin.HandlerFunc{
+		mc.jwtMiddleware.JWTAuth(services.AppGuardName),
+		mc.roleMiddleware.Require(models.RoleModerator),
+	}
+	return []Route{
+		{Method: "POST", Path: "/mods/:id/submit", Handler: mc.Submit, Middlewares: auth},
+		{Method: "GET", Path: "/moderation/queue", Handler: mc.Queue, Middlewares: moderator},
+		{Method: "POST", Path: "/moderation/mods/:id/review", Handler: mc.Review, Middlewares: moderator},
+		{Method: "GET", Path: "/moderation/mods/:id/reviews", Handler: mc.Reviews, Middlewares: moderator},
+	}
+}
+
+// Submit 提交审核
+// @Summary      提交 Mod 审核
+// @Description  作者或共同维护者将草稿或被驳回的 Mod 提交审核
+// @Tags         审核
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Success      200 {object} dto.Response{data=dto.ModStatusResponse} "成功"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      409 {object} dto.Response "状态不允许提交"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/submit [post]
+func (mc *ModerationController) Submit(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := mc.moderationService.SubmitMod(uri.ID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Queue 审核队列
+// @Summary      审核队列
+// @Description  按审核状态（默认 pending）列出 Mod，先提交的在前（需审核员权限）
+// @Tags         审核
+// @Produce      json
+// @Security     Bearer
+// @Param        status query string false "审核状态" Enums(draft, pending, approved, rejected, hidden)
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.ModerationQueueResponse} "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "没有操作权限"
+// @Router       /moderation/queue [get]
+func (mc *ModerationController) Queue(c *gin.Context) {
+	var req dto.ModerationQueueRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := mc.moderationService.GetQueue(req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Review 审核 Mod
+// @Summary      审核 Mod
+// @Description  通过、驳回或下架 Mod（驳回和下架须填写原因），结果会通知提交者（需审核员权限）
+// @Tags         审核
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.ModReviewRequest true "审核操作"
+// @Success      200 {object} dto.Response{data=dto.ModStatusResponse} "成功"
+// @Failure      400 {object} dto.Response "驳回或下架未填写原因"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      409 {object} dto.Response "状态不允许该操作"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "没有操作权限"
+// @Router       /moderation/mods/{id}/review [post]
+func (mc *ModerationController) Review(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.ModReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := mc.moderationService.ReviewMod(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Reviews 审核记录
+// @Summary      审核记录
+// @Description  获取 Mod 的状态变更及审核记录（需审核员权限）
+// @Tags         审核
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Success      200 {object} dto.Response{data=dto.ModReviewListResponse} "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "没有操作权限"
+// @Router       /moderation/mods/{id}/reviews [get]
+func (mc *ModerationController) Reviews(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := mc.moderationService.GetReviews(uri.ID)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/services"
+)
+
+// NotificationController 站内通知控制器
+type NotificationController struct {
+	notificationService *services.NotificationService
+	jwtMiddleware       *middleware.JwtMiddleware
+}
+
+// NewNotificationController 创建站内通知控制器实例
+func NewNotificationController(notificationService *services.NotificationService, jwtMiddleware *middleware.JwtMiddleware) *NotificationController {
+	return &NotificationController{notificationService: notificationService, jwtMiddleware: jwtMiddleware}
+}
+
+// Prefix 返回路由前缀
+func (nc *NotificationController) Prefix() string {
+	return "/notifications"
+}
+
+// Routes 返回路由列表（均需登录）
+func (nc *NotificationController) Routes() []Route {
+	auth := []gin.HandlerFunc{nc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	return []Route{
+		{Method: "GET", Path: "", Handler: nc.List, Middlewares: auth},
+		{Method: "PUT", Path: "/read-all", Handler: nc.MarkAllRead, Middlewares: auth},
+		{Method: "PUT", Path: "/:id/read", Handler: nc.MarkRead, Middlewares: auth},
+	}
+}
+
+// List 通知列表
+// @Summary      通知列表
+// @Description  获取当前用户的站内通知（最新的在前），在线用户同时通过 WebSocket 实时接收
+// @Tags         通知
+// @Produce      json
+// @Security     Bearer
+// @Param        unread query bool false "是否只返回未读通知"
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.NotificationListResponse} "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /notifications [get]
+func (nc *NotificationController) List(c *gin.Context) {
+	var req dto.NotificationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := nc.notificationService.ListNotifications(currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// MarkRead 标记已读
+// @Summary      标记通知已读
+// @Tags         通知
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "通知 ID"
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /notifications/{id}/read [put]
+func (nc *NotificationController) MarkRead(c *gin.Context) {
+	var uri dto.NotificationURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := nc.notificationService.MarkRead(currentUserID(c), uri.ID); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
+
+// MarkAllRead 全部标记已读
+// @Summary      全部通知标记已读
+// @Tags         通知
+// @Produce      json
+// @Security     Bearer
+// @Success      200 {object} dto.Response "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /notifications/read-all [put]
+func (nc *NotificationController) MarkAllRead(c *gin.Context) {
+	if err := nc.notificationService.MarkAllRead(currentUserID(c)); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+)
+
+// ReportController 内容举报控制器
+type ReportController struct {
+	reportService  *services.ReportService
+	jwtMiddleware  *middleware.JwtMiddleware
+	roleMiddleware *middleware.RoleMiddleware
+}
+
+// NewReportController 创建内容举报控制器实例
+func NewReportController(
+	reportService *services.ReportService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *ReportController {
+	return &ReportController{
+		reportService:  reportService,
+		jwtMiddleware:  jwtMiddleware,
+		roleMiddleware: roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (rc *ReportController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (rc *ReportController) Routes() []Route {
+	auth := []gin.HandlerFunc{rc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	moderator := []gin.HandlerFunc{
+		rc.jwtMiddleware.JWTAuth(services.AppGuardName),
+		rc.roleMiddleware.Require(models.RoleModerator),
+	}
+	return []Route{
+		{Method: "POST", Path: "/reports", Handler: rc.Create, Middlewares: auth},
+		{Method: "GET", Path: "/moderation/reports", Handler: rc.Queue, Middlewares: moderator},
+		{Method: "POST", Path: "/moderation/reports/:id/resolve", Handler: rc.Resolve, Middlewares: moderator},
+		{Method: "POST", Path: "/moderation/reports/:id/dismiss", Handler: rc.Dismiss, Middlewares: moderator},
+	}
+}
+
+// Create 提交举报
+// @Summary      提交举报
+// @Description  举报 Mod 或评论（同一用户对同一对象只能举报一次），待处理举报数达到阈值时自动隐藏
+// @Tags         举报
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request body dto.ReportCreateRequest true "举报信息"
+// @Success      200 {object} dto.Response{data=dto.ReportResponse} "成功"
+// @Failure      400 {object} dto.Response "举报对象不存在或不支持举报"
+// @Failure      409 {object} dto.Response "重复举报"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /reports [post]
+func (rc *ReportController) Create(c *gin.Context) {
+	var req dto.ReportCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := rc.reportService.SubmitReport(currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Queue 获取举报队列
+// @Summary      获取举报队列
+// @Description  按处理状态分页获取举报（默认待处理），先提交的在前
+// @Tags         审核
+// @Produce      json
+// @Security     Bearer
+// @Param        status query string false "处理状态" Enums(pending, resolved, dismissed)
+// @Param        target_type query string false "举报对象类型" Enums(mod, comment)
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.ReportQueueResponse} "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /moderation/reports [get]
+func (rc *ReportController) Queue(c *gin.Context) {
+	var req dto.ReportQueueRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := rc.reportService.GetQueue(req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Resolve 确认举报
+// @Summary      确认举报
+// @Description  隐藏被举报的内容，并将该对象的所有待处理举报标记为已处理
+// @Tags         审核
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "举报ID"
+// @Param        request body dto.ReportHandleRequest false "处理说明"
+// @Success      200 {object} dto.Response{data=dto.ReportHandleResponse} "成功"
+// @Failure      404 {object} dto.Response "举报不存在"
+// @Failure      409 {object} dto.Response "举报已处理"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /moderation/reports/{id}/resolve [post]
+func (rc *ReportController) Resolve(c *gin.Context) {
+	uri, req, ok := bindReportHandle(c)
+	if !ok {
+		return
+	}
+
+	result, err := rc.reportService.ResolveReport(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Dismiss 驳回举报
+// @Summary      驳回举报
+// @Description  将该对象的所有待处理举报标记为已驳回（已自动隐藏的内容不会恢复）
+// @Tags         审核
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "举报ID"
+// @Param        request body dto.ReportHandleRequest false "处理说明"
+// @Success      200 {object} dto.Response{data=dto.ReportHandleResponse} "成功"
+// @Failure      404 {object} dto.Response "举报不存在"
+// @Failure      409 {object} dto.Response "举报已处理"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /moderation/reports/{id}/dismiss [post]
+func (rc *ReportController) Dismiss(c *gin.Context) {
+	uri, req, ok := bindReportHandle(c)
+	if !ok {
+		return
+	}
+
+	result, err := rc.reportService.DismissReport(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// bindReportHandle 绑定处理举报的路径参数和请求体（请求体可以为空）
+func bindReportHandle(c *gin.Context) (dto.ReportURIRequest, dto.ReportHandleRequest, bool) {
+	var uri dto.ReportURIRequest
+	var req dto.ReportHandleRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return uri, req, false
+	}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			dto.ValidateFailByError(c, req, err)
+			return uri, req, false
+		}
+	}
+	return uri, req, true
+}
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+)
+
+// StatsController 下载 / 浏览统计控制器
+type StatsController struct {
+	analyticsService *services.ModAnalyticsService
+	jwtMiddleware    *middleware.JwtMiddleware
+	roleMiddleware   *middleware.RoleMiddleware
+}
+
+// NewStatsController 创建统计控制器实例
+func NewStatsController(
+	analyticsService *services.ModAnalyticsService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *StatsController {
+	return &StatsController{
+		analyticsService: analyticsService,
+		jwtMiddleware:    jwtMiddleware,
+		roleMiddleware:   roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (sc *StatsController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (sc *StatsController) Routes() []Route {
+	auth := []gin.HandlerFunc{sc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	admin := []gin.HandlerFunc{
+		sc.jwtMiddleware.JWTAuth(services.AppGuardName),
+		sc.roleMiddleware.Require(models.RoleAdmin),
+	}
+	return []Route{
+		{Method: "GET", Path: "/mods/:id/stats", Handler: sc.ModStats, Middlewares: auth},
+		{Method: "GET", Path: "/admin/stats/dashboard", Handler: sc.Dashboard, Middlewares: admin},
+	}
+}
+
+// ModStats 获取 Mod 统计
+// @Summary      获取 Mod 统计
+// @Description  按天或按周返回 Mod 的下载、浏览、独立访客时间序列及各版本下载量（仅作者或共同维护者）
+// @Tags         统计
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        from query string false "开始日期（YYYY-MM-DD，默认结束日期前 29 天）"
+// @Param        to query string false "结束日期（YYYY-MM-DD，默认今天）"
+// @Param        interval query string false "统计粒度" Enums(day, week) default(day)
+// @Success      200 {object} dto.Response{data=dto.ModStatsResponse} "成功"
+// @Failure      400 {object} dto.Response "统计时间范围无效"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/stats [get]
+func (sc *StatsController) ModStats(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.StatsRangeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := sc.analyticsService.ModStats(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Dashboard 全站统计看板
+// @Summary      全站统计看板
+// @Description  全站下载、浏览、独立访客时间序列，以及区间内下载量最多的 Mod 与游戏（仅管理员）
+// @Tags         统计
+// @Produce      json
+// @Security     Bearer
+// @Param        from query string false "开始日期（YYYY-MM-DD，默认结束日期前 29 天）"
+// @Param        to query string false "结束日期（YYYY-MM-DD，默认今天）"
+// @Param        interval query string false "统计粒度" Enums(day, week) default(day)
+// @Success      200 {object} dto.Response{data=dto.StatsDashboardResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /admin/stats/dashboard [get]
+func (sc *StatsController) Dashboard(c *gin.Context) {
+	var req dto.StatsRangeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := sc.analyticsService.Dashboard(req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/services"
+)
+
+// TagController 标签控制器
+type TagController struct {
+	tagService    *services.TagService
+	jwtMiddleware *middleware.JwtMiddleware
+}
+
+// NewTagController 创建标签控制器实例
+func NewTagController(tagService *services.TagService, jwtMiddleware *middleware.JwtMiddleware) *TagController {
+	return &TagController{tagService: tagService, jwtMiddleware: jwtMiddleware}
+}
+
+// Prefix 返回路由前缀
+func (tc *TagController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (tc *TagController) Routes() []Route {
+	auth := []gin.HandlerFunc{tc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	return []Route{
+		{Method: "GET", Path: "/tags/search", Handler: tc.Search},
+		{Method: "GET", Path: "/tags/autocomplete", Handler: tc.Autocomplete},
+		{Method: "POST", Path: "/mods/:id/tags", Handler: tc.Suggest, Middlewares: auth},
+		{Method: "DELETE", Path: "/mods/:id/tags/:tag_id", Handler: tc.Remove, Middlewares: auth},
+	}
+}
+
+// Search 搜索标签
+// @Summary      搜索标签
+// @Description  按名称模糊搜索标签，按使用数降序
+// @Tags         标签
+// @Produce      json
+// @Param        keyword query string false "搜索关键词"
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.TagListResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /tags/search [get]
+func (tc *TagController) Search(c *gin.Context) {
+	var req dto.TagSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := tc.tagService.SearchTags(req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Autocomplete 标签补全
+// @Summary      标签补全
+// @Description  按名称前缀补全标签，按使用数降序
+// @Tags         标签
+// @Produce      json
+// @Param        prefix query string true "名称前缀"
+// @Param        limit query int false "返回数量" default(10)
+// @Success      200 {object} dto.Response{data=dto.TagSuggestionResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /tags/autocomplete [get]
+func (tc *TagController) Autocomplete(c *gin.Context) {
+	var req dto.TagAutocompleteRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := tc.tagService.AutocompleteTags(req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Suggest 建议标签
+// @Summary      为 Mod 建议标签
+// @Description  为 Mod 添加标签，标签不存在时自动创建
+// @Tags         标签
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        request body dto.TagSuggestRequest true "标签"
+// @Success      200 {object} dto.Response{data=dto.ModTagsResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/tags [post]
+func (tc *TagController) Suggest(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.TagSuggestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := tc.tagService.SuggestTag(uri.ID, currentUserID(c), req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Remove 移除标签
+// @Summary      移除 Mod 标签
+// @Description  移除 Mod 的一个标签（仅作者或共同维护者）
+// @Tags         标签
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        tag_id path int true "标签 ID"
+// @Success      200 {object} dto.Response{data=dto.ModTagsResponse} "成功"
+// @Failure      401 {object} dto.Response "未授权"
+// @Router       /mods/{id}/tags/{tag_id} [delete]
+func (tc *TagController) Remove(c *gin.Context) {
+	var uri dto.ModTagURIRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := tc.tagService.RemoveTag(uri.ID, uri.TagID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
@@ -0,0 +1,251 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+	"gin-web/app/models"
+	"gin-web/app/services"
+)
+
+// TranslationController 游戏、分类、Mod 翻译管理控制器
+type TranslationController struct {
+	translationService *services.TranslationService
+	jwtMiddleware      *middleware.JwtMiddleware
+	roleMiddleware     *middleware.RoleMiddleware
+}
+
+// NewTranslationController 创建翻译管理控制器实例
+func NewTranslationController(
+	translationService *services.TranslationService,
+	jwtMiddleware *middleware.JwtMiddleware,
+	roleMiddleware *middleware.RoleMiddleware,
+) *TranslationController {
+	return &TranslationController{
+		translationService: translationService,
+		jwtMiddleware:      jwtMiddleware,
+		roleMiddleware:     roleMiddleware,
+	}
+}
+
+// Prefix 返回路由前缀
+func (tc *TranslationController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (tc *TranslationController) Routes() []Route {
+	auth := []gin.HandlerFunc{tc.jwtMiddleware.JWTAuth(services.AppGuardName)}
+	admin := []gin.HandlerFunc{
+		tc.jwtMiddleware.JWTAuth(services.AppGuardName),
+		tc.roleMiddleware.Require(models.RoleAdmin),
+	}
+	return []Route{
+		{Method: "GET", Path: "/mods/:id/translations", Handler: tc.ListMod, Middlewares: auth},
+		{Method: "PUT", Path: "/mods/:id/translations/:locale", Handler: tc.SaveMod, Middlewares: auth},
+		{Method: "DELETE", Path: "/mods/:id/translations/:locale", Handler: tc.DeleteMod, Middlewares: auth},
+		{Method: "GET", Path: "/admin/translations/:entity/:id", Handler: tc.List, Middlewares: admin},
+		{Method: "PUT", Path: "/admin/translations/:entity/:id/:locale", Handler: tc.Save, Middlewares: admin},
+		{Method: "DELETE", Path: "/admin/translations/:entity/:id/:locale", Handler: tc.Delete, Middlewares: admin},
+	}
+}
+
+// ListMod 获取 Mod 的翻译
+// @Summary      获取 Mod 的翻译
+// @Description  获取 Mod 名称与描述在各语言下的翻译（仅作者或共同维护者）
+// @Tags         多语言
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Success      200 {object} dto.Response{data=dto.TranslationListResponse} "成功"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/translations [get]
+func (tc *TranslationController) ListMod(c *gin.Context) {
+	var uri dto.ModDetailRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := tc.translationService.ListModTranslations(uri.ID, currentUserID(c))
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// SaveMod 保存 Mod 的翻译
+// @Summary      保存 Mod 的翻译
+// @Description  新增或覆盖 Mod 在指定语言下的名称与描述（仅作者或共同维护者，不能为默认语言添加翻译）
+// @Tags         多语言
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        locale path string true "语言" example(en)
+// @Param        request body dto.TranslationSaveRequest true "翻译内容"
+// @Success      200 {object} dto.Response{data=dto.TranslationResponse} "成功"
+// @Failure      400 {object} dto.Response "不支持该语言"
+// @Failure      404 {object} dto.Response "Mod 不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/translations/{locale} [put]
+func (tc *TranslationController) SaveMod(c *gin.Context) {
+	var uri dto.ModTranslationLocaleRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.TranslationSaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := tc.translationService.SaveModTranslation(uri.ID, currentUserID(c), uri.Locale, req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// DeleteMod 删除 Mod 的翻译
+// @Summary      删除 Mod 的翻译
+// @Description  删除 Mod 在指定语言下的翻译，之后该语言回退到默认语言的内容（仅作者或共同维护者）
+// @Tags         多语言
+// @Produce      json
+// @Security     Bearer
+// @Param        id path int true "Mod ID"
+// @Param        locale path string true "语言" example(en)
+// @Success      200 {object} dto.Response "成功"
+// @Failure      400 {object} dto.Response "不支持该语言"
+// @Failure      404 {object} dto.Response "Mod 或翻译不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "不是 Mod 作者或共同维护者"
+// @Router       /mods/{id}/translations/{locale} [delete]
+func (tc *TranslationController) DeleteMod(c *gin.Context) {
+	var uri dto.ModTranslationLocaleRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := tc.translationService.DeleteModTranslation(uri.ID, currentUserID(c), uri.Locale); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
+
+// List 获取翻译
+// @Summary      获取翻译
+// @Description  获取游戏、分类或 Mod 在各语言下的翻译（仅管理员）
+// @Tags         多语言
+// @Produce      json
+// @Security     Bearer
+// @Param        entity path string true "数据类型" Enums(games, categories, mods)
+// @Param        id path int true "游戏、分类或 Mod 的 ID"
+// @Success      200 {object} dto.Response{data=dto.TranslationListResponse} "成功"
+// @Failure      404 {object} dto.Response "数据不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /admin/translations/{entity}/{id} [get]
+func (tc *TranslationController) List(c *gin.Context) {
+	var uri dto.TranslationTargetRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	result, err := tc.translationService.List(uri.Entity, uri.ID)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Save 保存翻译
+// @Summary      保存翻译
+// @Description  新增或覆盖游戏、分类或 Mod 在指定语言下的名称与描述（仅管理员，不能为默认语言添加翻译）
+// @Tags         多语言
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        entity path string true "数据类型" Enums(games, categories, mods)
+// @Param        id path int true "游戏、分类或 Mod 的 ID"
+// @Param        locale path string true "语言" example(en)
+// @Param        request body dto.TranslationSaveRequest true "翻译内容"
+// @Success      200 {object} dto.Response{data=dto.TranslationResponse} "成功"
+// @Failure      400 {object} dto.Response "不支持该语言"
+// @Failure      404 {object} dto.Response "数据不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /admin/translations/{entity}/{id}/{locale} [put]
+func (tc *TranslationController) Save(c *gin.Context) {
+	var uri dto.TranslationLocaleRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	var req dto.TranslationSaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := tc.translationService.Save(uri.Entity, uri.ID, uri.Locale, req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
+
+// Delete 删除翻译
+// @Summary      删除翻译
+// @Description  删除游戏、分类或 Mod 在指定语言下的翻译（仅管理员）
+// @Tags         多语言
+// @Produce      json
+// @Security     Bearer
+// @Param        entity path string true "数据类型" Enums(games, categories, mods)
+// @Param        id path int true "游戏、分类或 Mod 的 ID"
+// @Param        locale path string true "语言" example(en)
+// @Success      200 {object} dto.Response "成功"
+// @Failure      400 {object} dto.Response "不支持该语言"
+// @Failure      404 {object} dto.Response "数据或翻译不存在"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Failure      401 {object} dto.Response "未授权"
+// @Failure      403 {object} dto.Response "权限不足"
+// @Router       /admin/translations/{entity}/{id}/{locale} [delete]
+func (tc *TranslationController) Delete(c *gin.Context) {
+	var uri dto.TranslationLocaleRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		dto.ValidateFailByError(c, uri, err)
+		return
+	}
+
+	if err := tc.translationService.Delete(uri.Entity, uri.ID, uri.Locale); err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, nil)
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/services"
+)
+
+// TrendingController 热门 Mod 控制器
+type TrendingController struct {
+	trendingService *services.TrendingService
+}
+
+// NewTrendingController 创建热门 Mod 控制器实例
+func NewTrendingController(trendingService *services.TrendingService) *TrendingController {
+	return &TrendingController{trendingService: trendingService}
+}
+
+// Prefix 返回路由前缀
+func (tc *TrendingController) Prefix() string {
+	return ""
+}
+
+// Routes 返回路由列表
+func (tc *TrendingController) Routes() []Route {
+	return []Route{
+		{Method: "GET", Path: "/mods/trending", Handler: tc.Trending},
+	}
+}
+
+// Trending 获取热门 Mod
+// @Summary      获取热门 Mod
+// @Description  按热度分（近期每日下载/浏览按时间衰减加权）排序，可按游戏或分类筛选
+// @Tags         Mod
+// @Produce      json
+// @Param        game_id query int false "游戏ID"
+// @Param        category_id query int false "分类ID（包含子孙分类）"
+// @Param        page query int false "页码" default(1)
+// @Param        page_size query int false "每页数量" default(20)
+// @Success      200 {object} dto.Response{data=dto.ModListResponse} "成功"
+// @Failure      422 {object} dto.Response{data=dto.ValidationErrorResponse} "参数错误"
+// @Router       /mods/trending [get]
+func (tc *TrendingController) Trending(c *gin.Context) {
+	var req dto.TrendingRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		dto.ValidateFailByError(c, req, err)
+		return
+	}
+
+	result, err := tc.trendingService.GetTrending(req)
+	if err != nil {
+		dto.FailByError(c, err)
+		return
+	}
+
+	dto.Success(c, result)
+}
@@ -0,0 +1,55 @@
+package cron
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/services"
+)
+
+// defaultImageMaintenanceSpec 默认每 5 分钟检查一次积压与孤立的截图
+const defaultImageMaintenanceSpec = "0 */5 * * * *"
+
+// ImageMaintenanceJob 截图维护任务：重新投递积压的待处理截图，清理所属 Mod 已删除的截图
+type ImageMaintenanceJob struct {
+	service *services.ModImageService
+	spec    string
+	log     *zap.Logger
+}
+
+// NewImageMaintenanceJob 创建截图维护任务，spec 为空时使用默认调度
+func NewImageMaintenanceJob(service *services.ModImageService, spec string, log *zap.Logger) *ImageMaintenanceJob {
+	if spec == "" {
+		spec = defaultImageMaintenanceSpec
+	}
+	return &ImageMaintenanceJob{
+		service: service,
+		spec:    spec,
+		log:     log,
+	}
+}
+
+// Name 返回任务名称
+func (j *ImageMaintenanceJob) Name() string {
+	return "maintain_mod_images"
+}
+
+// Spec 返回 cron 表达式
+func (j *ImageMaintenanceJob) Spec() string {
+	return j.spec
+}
+
+// Run 重新投递积压截图并清理孤立截图
+func (j *ImageMaintenanceJob) Run() {
+	startTime := time.Now()
+	if err := j.service.Maintain(startTime); err != nil {
+		j.log.Error("image maintenance job failed", zap.Error(err))
+		return
+	}
+
+	j.log.Info("image maintenance job completed",
+		zap.String("job", j.Name()),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+}
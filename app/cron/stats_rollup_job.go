@@ -0,0 +1,55 @@
+package cron
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/services"
+)
+
+// defaultStatsRollupSpec 默认每 5 分钟汇总一次统计事件
+const defaultStatsRollupSpec = "0 */5 * * * *"
+
+// StatsRollupJob 下载 / 浏览事件汇总任务
+type StatsRollupJob struct {
+	service *services.ModAnalyticsService
+	spec    string
+	log     *zap.Logger
+}
+
+// NewStatsRollupJob 创建统计汇总任务，spec 为空时使用默认调度
+func NewStatsRollupJob(service *services.ModAnalyticsService, spec string, log *zap.Logger) *StatsRollupJob {
+	if spec == "" {
+		spec = defaultStatsRollupSpec
+	}
+	return &StatsRollupJob{
+		service: service,
+		spec:    spec,
+		log:     log,
+	}
+}
+
+// Name 返回任务名称
+func (j *StatsRollupJob) Name() string {
+	return "rollup_mod_stats"
+}
+
+// Spec 返回 cron 表达式
+func (j *StatsRollupJob) Spec() string {
+	return j.spec
+}
+
+// Run 汇总统计事件为每日计数并清理过期事件
+func (j *StatsRollupJob) Run() {
+	startTime := time.Now()
+	if err := j.service.Rollup(startTime); err != nil {
+		j.log.Error("stats rollup job failed", zap.Error(err))
+		return
+	}
+
+	j.log.Info("stats rollup job completed",
+		zap.String("job", j.Name()),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+}
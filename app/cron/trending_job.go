@@ -0,0 +1,55 @@
+package cron
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/services"
+)
+
+// defaultTrendingSpec 默认每 10 分钟重新计算一次热度
+const defaultTrendingSpec = "0 */10 * * * *"
+
+// TrendingJob 热度重新计算任务
+type TrendingJob struct {
+	service *services.TrendingService
+	spec    string
+	log     *zap.Logger
+}
+
+// NewTrendingJob 创建热度计算任务，spec 为空时使用默认调度
+func NewTrendingJob(service *services.TrendingService, spec string, log *zap.Logger) *TrendingJob {
+	if spec == "" {
+		spec = defaultTrendingSpec
+	}
+	return &TrendingJob{
+		service: service,
+		spec:    spec,
+		log:     log,
+	}
+}
+
+// Name 返回任务名称
+func (j *TrendingJob) Name() string {
+	return "recompute_trending_scores"
+}
+
+// Spec 返回 cron 表达式
+func (j *TrendingJob) Spec() string {
+	return j.spec
+}
+
+// Run 重新计算热度分并重建榜单
+func (j *TrendingJob) Run() {
+	startTime := time.Now()
+	if err := j.service.Recompute(startTime); err != nil {
+		j.log.Error("trending job failed", zap.Error(err))
+		return
+	}
+
+	j.log.Info("trending job completed",
+		zap.String("job", j.Name()),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+}
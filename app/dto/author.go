@@ -0,0 +1,84 @@
+package dto
+
+// AuthorDetailRequest 作者路径参数
+type AuthorDetailRequest struct {
+	ID uint `uri:"id" binding:"required,min=1" example:"1"` // 作者用户ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r AuthorDetailRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required": "作者ID不能为空",
+		"ID.min":      "作者ID必须大于0",
+	}
+}
+
+// ModMaintainerRequest 添加共同维护者请求
+type ModMaintainerRequest struct {
+	UserID uint `json:"user_id" binding:"required,min=1" example:"2"` // 用户ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModMaintainerRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"UserID.required": "用户ID不能为空",
+		"UserID.min":      "用户ID必须大于0",
+	}
+}
+
+// ModMaintainerURIRequest Mod 共同维护者路径参数
+type ModMaintainerURIRequest struct {
+	ID     uint `uri:"id" binding:"required,min=1" example:"1"`      // Mod ID
+	UserID uint `uri:"user_id" binding:"required,min=1" example:"2"` // 用户ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModMaintainerURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":     "Mod ID 不能为空",
+		"ID.min":          "Mod ID 必须大于0",
+		"UserID.required": "用户ID不能为空",
+		"UserID.min":      "用户ID必须大于0",
+	}
+}
+
+// ModOwnerRequest 变更 Mod 作者请求
+type ModOwnerRequest struct {
+	UserID uint `json:"user_id" binding:"required,min=1" example:"1"` // 新作者用户ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModOwnerRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"UserID.required": "用户ID不能为空",
+		"UserID.min":      "用户ID必须大于0",
+	}
+}
+
+// AuthorResponse 作者基本信息
+type AuthorResponse struct {
+	ID   uint   `json:"id" example:"1"`           // 用户ID
+	Name string `json:"name" example:"ModAuthor"` // 用户名称
+}
+
+// AuthorDetailResponse 作者主页响应
+// @Description 作者信息及其 Mod 的聚合统计
+type AuthorDetailResponse struct {
+	AuthorResponse
+	Stats AuthorStatsResponse `json:"stats"` // 聚合统计
+}
+
+// AuthorStatsResponse 作者聚合统计（作者拥有或共同维护的已通过审核的 Mod）
+type AuthorStatsResponse struct {
+	ModCount       int64   `json:"mod_count" example:"12"`          // Mod 数量
+	TotalDownloads int64   `json:"total_downloads" example:"35000"` // 总下载次数
+	TotalViews     int64   `json:"total_views" example:"98000"`     // 总浏览次数
+	AverageRating  float64 `json:"average_rating" example:"4.35"`   // 平均评分
+}
+
+// ModMaintainersResponse Mod 作者及共同维护者
+type ModMaintainersResponse struct {
+	ModID       uint             `json:"mod_id" example:"1"` // Mod ID
+	Owner       *AuthorResponse  `json:"owner"`              // 作者（未关联用户时为 null）
+	Maintainers []AuthorResponse `json:"maintainers"`        // 共同维护者
+}
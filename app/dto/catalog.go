@@ -0,0 +1,59 @@
+package dto
+
+// CatalogEntityRequest 目录数据类型路径参数
+type CatalogEntityRequest struct {
+	Entity string `uri:"entity" binding:"required,oneof=games categories mods"` // 数据类型
+}
+
+// GetMessages 自定义验证错误信息
+func (r CatalogEntityRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Entity.required": "数据类型不能为空",
+		"Entity.oneof":    "数据类型只能是 games、categories 或 mods",
+	}
+}
+
+// CatalogImportRequest 目录导入参数（文件通过 multipart 字段 file 上传）
+type CatalogImportRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv json ndjson jsonl" example:"csv"` // 文件格式（为空时按文件扩展名推断）
+	DryRun bool   `form:"dry_run" example:"true"`                                               // 只校验不写入
+}
+
+// GetMessages 自定义验证错误信息
+func (r CatalogImportRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Format.oneof": "文件格式只能是 csv、json 或 ndjson",
+	}
+}
+
+// CatalogExportRequest 目录导出参数
+type CatalogExportRequest struct {
+	Format string `form:"format" binding:"omitempty,oneof=csv json ndjson jsonl" example:"csv"` // 文件格式（默认 csv）
+}
+
+// GetMessages 自定义验证错误信息
+func (r CatalogExportRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Format.oneof": "文件格式只能是 csv、json 或 ndjson",
+	}
+}
+
+// CatalogRowError 导入文件中某一行的错误
+type CatalogRowError struct {
+	Row     int    `json:"row" example:"3"`                // 行号（CSV / NDJSON 为文件行号，JSON 为数组中的序号）
+	Field   string `json:"field,omitempty" example:"game"` // 出错的列
+	Message string `json:"message" example:"游戏不存在"`        // 错误信息
+}
+
+// CatalogImportResponse 目录导入结果
+// @Description 存在任何错误时不写入任何数据；dry_run 时 created / updated 为预计数量
+type CatalogImportResponse struct {
+	Entity  string            `json:"entity" example:"mods"`  // 数据类型
+	DryRun  bool              `json:"dry_run" example:"true"` // 是否只校验
+	Applied bool              `json:"applied"`                // 是否已写入
+	Total   int               `json:"total" example:"10"`     // 总行数
+	Created int               `json:"created" example:"8"`    // 新建数量
+	Updated int               `json:"updated" example:"2"`    // 按自然键匹配并更新的数量
+	Failed  int               `json:"failed" example:"0"`     // 出错的行数
+	Errors  []CatalogRowError `json:"errors"`                 // 逐行错误
+}
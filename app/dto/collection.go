@@ -0,0 +1,223 @@
+package dto
+
+import "time"
+
+// CollectionListRequest 合集列表请求
+type CollectionListRequest struct {
+	GameID   uint   `form:"game_id" json:"game_id" example:"1"`                                                                   // 游戏ID（为空时不限）
+	OwnerID  uint   `form:"owner_id" json:"owner_id" example:"7"`                                                                 // 创建者ID（查询自己的合集时包含私有合集）
+	SortBy   string `form:"sort_by" json:"sort_by" binding:"omitempty,oneof=followers updated_at created_at" example:"followers"` // 排序方式（默认按关注数）
+	Page     int    `form:"page" json:"page" binding:"min=0" example:"1"`                                                         // 页码
+	PageSize int    `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"`                                      // 每页数量
+}
+
+// GetMessages 自定义验证错误信息
+func (r CollectionListRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"SortBy.oneof": "排序方式只能是 followers、updated_at 或 created_at",
+		"Page.min":     "页码不能小于0",
+		"PageSize.min": "每页数量不能小于0",
+		"PageSize.max": "每页数量不能超过100",
+	}
+}
+
+// CollectionItemRequest 合集中的 Mod
+type CollectionItemRequest struct {
+	ModID     uint   `json:"mod_id" binding:"required,min=1" example:"1"`    // Mod ID
+	ReleaseID uint   `json:"release_id" example:"3"`                         // 锁定的发布版本ID（为空时跟随 Mod 当前版本）
+	Note      string `json:"note" binding:"max=500" example:"需要在 SKSE 之后安装"` // 备注
+}
+
+// CollectionCreateRequest 创建合集请求
+type CollectionCreateRequest struct {
+	GameID      uint                    `json:"game_id" binding:"required,min=1" example:"1"`     // 游戏ID
+	Title       string                  `json:"title" binding:"required,max=100" example:"新手整合包"` // 标题
+	Description string                  `json:"description" binding:"max=5000" example:"界面与画质增强"` // 描述
+	IsPublic    *bool                   `json:"is_public" example:"true"`                         // 是否公开（默认公开）
+	Items       []CollectionItemRequest `json:"items" binding:"max=500,dive"`                     // Mod 列表（按安装顺序）
+}
+
+// GetMessages 自定义验证错误信息
+func (r CollectionCreateRequest) GetMessages() ValidatorMessages {
+	return collectionMessages()
+}
+
+// CollectionUpdateRequest 更新合集请求
+type CollectionUpdateRequest struct {
+	Title       string `json:"title" binding:"required,max=100" example:"新手整合包"` // 标题
+	Description string `json:"description" binding:"max=5000" example:"界面与画质增强"` // 描述
+	IsPublic    *bool  `json:"is_public" example:"false"`                        // 是否公开（为空时不修改）
+}
+
+// GetMessages 自定义验证错误信息
+func (r CollectionUpdateRequest) GetMessages() ValidatorMessages {
+	return collectionMessages()
+}
+
+// CollectionItemsRequest 替换合集 Mod 列表请求
+type CollectionItemsRequest struct {
+	Items []CollectionItemRequest `json:"items" binding:"max=500,dive"` // Mod 列表（按安装顺序）
+}
+
+// GetMessages 自定义验证错误信息
+func (r CollectionItemsRequest) GetMessages() ValidatorMessages {
+	return collectionMessages()
+}
+
+// CollectionItemAddRequest 向合集添加 Mod 请求（已存在时更新锁定版本与备注）
+type CollectionItemAddRequest struct {
+	CollectionItemRequest
+}
+
+// GetMessages 自定义验证错误信息
+func (r CollectionItemAddRequest) GetMessages() ValidatorMessages {
+	return collectionMessages()
+}
+
+// collectionMessages 合集请求共用的验证错误信息
+func collectionMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"GameID.required": "游戏ID不能为空",
+		"GameID.min":      "游戏ID无效",
+		"Title.required":  "合集标题不能为空",
+		"Title.max":       "合集标题不能超过100个字符",
+		"Description.max": "合集描述不能超过5000个字符",
+		"Items.max":       "合集中的 Mod 不能超过500个",
+		"ModID.required":  "Mod ID不能为空",
+		"ModID.min":       "Mod ID无效",
+		"Note.max":        "备注不能超过500个字符",
+	}
+}
+
+// CollectionURIRequest 合集路径参数
+type CollectionURIRequest struct {
+	ID uint `uri:"id" binding:"required,min=1"` // 合集ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r CollectionURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required": "合集ID不能为空",
+		"ID.min":      "合集ID无效",
+	}
+}
+
+// CollectionItemURIRequest 合集中的 Mod 路径参数
+type CollectionItemURIRequest struct {
+	ID    uint `uri:"id" binding:"required,min=1"`     // 合集ID
+	ModID uint `uri:"mod_id" binding:"required,min=1"` // Mod ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r CollectionItemURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":    "合集ID不能为空",
+		"ID.min":         "合集ID无效",
+		"ModID.required": "Mod ID不能为空",
+		"ModID.min":      "Mod ID无效",
+	}
+}
+
+// CollectionResponse 合集基本信息
+type CollectionResponse struct {
+	ID            uint            `json:"id" example:"1"`             // 合集ID
+	Title         string          `json:"title" example:"新手整合包"`      // 标题
+	Description   string          `json:"description"`                // 描述
+	IsPublic      bool            `json:"is_public" example:"true"`   // 是否公开
+	Owner         *AuthorResponse `json:"owner"`                      // 创建者
+	GameID        uint            `json:"game_id" example:"1"`        // 游戏ID
+	GameName      string          `json:"game_name" example:"Skyrim"` // 游戏名称
+	ItemCount     int             `json:"item_count" example:"12"`    // Mod 数量
+	FollowerCount int             `json:"follower_count" example:"3"` // 关注数
+	CreatedAt     time.Time       `json:"created_at"`                 // 创建时间
+	UpdatedAt     time.Time       `json:"updated_at"`                 // 更新时间
+}
+
+// CollectionItemResponse 合集中的 Mod
+type CollectionItemResponse struct {
+	ModID     uint   `json:"mod_id" example:"1"`           // Mod ID
+	ModName   string `json:"mod_name" example:"SkyUI"`     // Mod 名称
+	ReleaseID uint   `json:"release_id" example:"3"`       // 锁定的发布版本ID（0 表示跟随当前版本）
+	Version   string `json:"version" example:"5.2SE"`      // 安装的版本
+	Pinned    bool   `json:"pinned" example:"true"`        // 是否锁定版本
+	Available bool   `json:"available" example:"true"`     // Mod 是否仍公开可用（下架后不会出现在清单中）
+	Position  int    `json:"position" example:"0"`         // 顺序
+	Note      string `json:"note" example:"需要在 SKSE 之后安装"` // 备注
+}
+
+// CollectionDetailResponse 合集详情
+type CollectionDetailResponse struct {
+	CollectionResponse
+	Following bool                     `json:"following"` // 当前用户是否已关注
+	Items     []CollectionItemResponse `json:"items"`     // Mod 列表
+}
+
+// CollectionListResponse 合集列表
+type CollectionListResponse struct {
+	List       []CollectionResponse `json:"list"`        // 合集列表
+	Total      int64                `json:"total"`       // 总数
+	Page       int                  `json:"page"`        // 当前页
+	PageSize   int                  `json:"page_size"`   // 每页数量
+	TotalPages int                  `json:"total_pages"` // 总页数
+}
+
+// CollectionFollowResponse 关注结果
+type CollectionFollowResponse struct {
+	Following     bool `json:"following" example:"true"`   // 是否已关注
+	FollowerCount int  `json:"follower_count" example:"4"` // 关注数
+}
+
+// CollectionInstallItemResponse 合集安装集合中的 Mod
+type CollectionInstallItemResponse struct {
+	ID           uint   `json:"id"`            // Mod ID
+	Name         string `json:"name"`          // Mod 名称
+	Version      string `json:"version"`       // 安装的版本（锁定版本或当前版本）
+	Pinned       bool   `json:"pinned"`        // 是否为合集锁定的版本
+	InCollection bool   `json:"in_collection"` // 是否为合集中的 Mod（否则为被依赖的 Mod）
+	Optional     bool   `json:"optional"`      // 是否仅因可选依赖被加入
+}
+
+// CollectionResolveResponse 合集依赖解析结果
+// @Description 合集中所有 Mod 合并后的安装集合，按拓扑序排列；存在冲突时仍返回安装集合并列出冲突
+type CollectionResolveResponse struct {
+	CollectionID uint                            `json:"collection_id"` // 合集ID
+	InstallOrder []CollectionInstallItemResponse `json:"install_order"` // 安装顺序（依赖在前）
+	Conflicts    []string                        `json:"conflicts"`     // 不兼容关系与不满足的版本约束
+	Unavailable  []uint                          `json:"unavailable"`   // 已下架或删除、未加入安装集合的 Mod ID
+}
+
+// CollectionManifest 启动器使用的整合包清单
+// @Description 按安装顺序列出合集中的 Mod 及其依赖，字段结构由 format_version 标识
+type CollectionManifest struct {
+	FormatVersion int                `json:"format_version" example:"1"` // 清单格式版本
+	Collection    ManifestCollection `json:"collection"`                 // 合集信息
+	Game          ManifestGame       `json:"game"`                       // 游戏
+	Mods          []ManifestMod      `json:"mods"`                       // 按安装顺序排列的 Mod
+}
+
+// ManifestCollection 清单中的合集信息
+type ManifestCollection struct {
+	ID        uint      `json:"id"`         // 合集ID
+	Title     string    `json:"title"`      // 标题
+	Owner     string    `json:"owner"`      // 创建者名称
+	UpdatedAt time.Time `json:"updated_at"` // 更新时间
+}
+
+// ManifestGame 清单中的游戏信息
+type ManifestGame struct {
+	ID   uint   `json:"id"`   // 游戏ID
+	Name string `json:"name"` // 游戏名称
+}
+
+// ManifestMod 清单中的 Mod
+type ManifestMod struct {
+	ID          uint   `json:"id"`                                     // Mod ID
+	Name        string `json:"name"`                                   // Mod 名称
+	Version     string `json:"version"`                                // 安装的版本
+	DownloadURL string `json:"download_url"`                           // 下载地址
+	FileSize    int64  `json:"file_size"`                              // 文件大小（字节）
+	Pinned      bool   `json:"pinned"`                                 // 是否锁定版本
+	Source      string `json:"source" example:"collection"`            // 来源：collection（合集中的 Mod）或 dependency（依赖）
+	Optional    bool   `json:"optional"`                               // 是否为可选依赖
+	Note        string `json:"note,omitempty" example:"需要在 SKSE 之后安装"` // 整理者备注
+}
@@ -0,0 +1,84 @@
+package dto
+
+import "time"
+
+// CommentListRequest 评论列表请求
+type CommentListRequest struct {
+	Cursor string `form:"cursor" json:"cursor" binding:"max=512"`                  // 分页游标（取自上一页的 next_cursor）
+	Limit  int    `form:"limit" json:"limit" binding:"min=0,max=100" example:"20"` // 每页数量（默认 20）
+}
+
+// GetMessages 自定义验证错误信息
+func (r CommentListRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Cursor.max": "分页游标格式错误",
+		"Limit.min":  "每页数量不能小于0",
+		"Limit.max":  "每页数量不能超过100",
+	}
+}
+
+// CommentURIRequest 评论路径参数
+type CommentURIRequest struct {
+	ID uint `uri:"id" binding:"required,min=1" example:"1"` // 评论ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r CommentURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required": "评论ID不能为空",
+		"ID.min":      "评论ID必须大于0",
+	}
+}
+
+// CommentCreateRequest 发表评论请求
+// @Description parent_id 为空时发表顶层评论，否则回复指定评论
+type CommentCreateRequest struct {
+	Content  string `json:"content" binding:"required,max=2000" example:"安装后菜单显示异常"` // 评论内容
+	ParentID uint   `json:"parent_id" example:"0"`                                   // 回复的评论ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r CommentCreateRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Content.required": "评论内容不能为空",
+		"Content.max":      "评论内容不能超过2000个字符",
+	}
+}
+
+// CommentUpdateRequest 编辑评论请求
+type CommentUpdateRequest struct {
+	Content string `json:"content" binding:"required,max=2000" example:"已解决，需要先安装 SKSE"` // 评论内容
+}
+
+// GetMessages 自定义验证错误信息
+func (r CommentUpdateRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Content.required": "评论内容不能为空",
+		"Content.max":      "评论内容不能超过2000个字符",
+	}
+}
+
+// CommentResponse 评论
+// @Description 已删除的评论不返回内容和评论者，仅保留在线程中的位置
+type CommentResponse struct {
+	ID         uint            `json:"id" example:"1"`                        // 评论ID
+	ModID      uint            `json:"mod_id" example:"1"`                    // Mod ID
+	RootID     uint            `json:"root_id" example:"0"`                   // 所属顶层评论ID（顶层评论为 0）
+	ParentID   uint            `json:"parent_id" example:"0"`                 // 回复的评论ID（顶层评论为 0）
+	Author     *AuthorResponse `json:"author"`                                // 评论者（已删除时为 null）
+	ReplyTo    *AuthorResponse `json:"reply_to,omitempty"`                    // 被回复的评论者（仅回复返回）
+	Content    string          `json:"content" example:"安装后菜单显示异常"`           // 评论内容（已删除时为空）
+	ReplyCount int             `json:"reply_count" example:"3"`               // 回复数（仅顶层评论）
+	EditedAt   *time.Time      `json:"edited_at,omitempty"`                   // 最后编辑时间（未编辑过时省略）
+	Deleted    bool            `json:"deleted" example:"false"`               // 是否已删除
+	DeletedBy  string          `json:"deleted_by,omitempty" example:"author"` // 删除者：author / moderator / reports
+	CreatedAt  time.Time       `json:"created_at"`                            // 发表时间
+	UpdatedAt  time.Time       `json:"updated_at"`                            // 更新时间
+}
+
+// CommentListResponse 评论列表响应
+type CommentListResponse struct {
+	List       []CommentResponse `json:"list"`                  // 评论列表
+	NextCursor string            `json:"next_cursor,omitempty"` // 下一页游标
+	HasMore    bool              `json:"has_more"`              // 是否还有更多
+}
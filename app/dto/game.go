@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+
+	"gin-web/app/models"
+)
+
+// GameDetailRequest 游戏路径参数
+type GameDetailRequest struct {
+	ID uint `uri:"id" binding:"required,min=1" example:"1"` // 游戏ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r GameDetailRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required": "游戏ID不能为空",
+		"ID.min":      "游戏ID必须大于0",
+	}
+}
+
+// GameVersionURIRequest 游戏版本路径参数
+type GameVersionURIRequest struct {
+	ID        uint `uri:"id" binding:"required,min=1" example:"1"`         // 游戏ID
+	VersionID uint `uri:"version_id" binding:"required,min=1" example:"3"` // 游戏版本ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r GameVersionURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":        "游戏ID不能为空",
+		"ID.min":             "游戏ID必须大于0",
+		"VersionID.required": "游戏版本ID不能为空",
+		"VersionID.min":      "游戏版本ID必须大于0",
+	}
+}
+
+// GameVersionSaveRequest 添加游戏版本请求
+// @Description 游戏版本信息
+type GameVersionSaveRequest struct {
+	Name       string     `json:"name" binding:"required,max=50" example:"1.20.1"` // 版本号
+	ReleasedAt *time.Time `json:"released_at" example:"2023-06-12T00:00:00Z"`      // 发布时间
+}
+
+// GetMessages 自定义验证错误信息
+func (r GameVersionSaveRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Name.required": "版本号不能为空",
+		"Name.max":      "版本号不能超过50个字符",
+	}
+}
+
+// GameDetailResponse 游戏详情响应
+// @Description 游戏信息及聚合统计
+type GameDetailResponse struct {
+	models.Game
+	Stats         GameStatsResponse       `json:"stats"`          // 聚合统计
+	TopCategories []CategoryCountResponse `json:"top_categories"` // Mod 数量最多的分类
+	NewestMods    []ModItemResponse       `json:"newest_mods"`    // 最新 Mod
+}
+
+// GameStatsResponse 游戏聚合统计
+type GameStatsResponse struct {
+	ModCount       int64 `json:"mod_count" example:"120"`         // Mod 数量
+	TotalDownloads int64 `json:"total_downloads" example:"35000"` // 总下载次数
+	TotalViews     int64 `json:"total_views" example:"98000"`     // 总浏览次数
+}
+
+// CategoryCountResponse 分类 Mod 数量
+type CategoryCountResponse struct {
+	ID       uint   `json:"id" example:"1"`          // 分类ID
+	Name     string `json:"name" example:"Gameplay"` // 分类名称
+	ModCount int64  `json:"mod_count" example:"42"`  // Mod 数量
+}
+
+// GameVersionListResponse 游戏版本列表响应
+type GameVersionListResponse struct {
+	List []models.GameVersion `json:"list"` // 游戏版本（按版本号从新到旧）
+}
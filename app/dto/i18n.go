@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/i18n"
+)
+
+// LocalizerKey 当前请求的消息本地化器在 gin.Context 中的键（由 I18n 中间件写入）
+const LocalizerKey = "localizer"
+
+// requestLocalizer 当前请求的消息本地化器，未经过 I18n 中间件时返回 nil（使用代码中的消息）
+func requestLocalizer(c *gin.Context) *i18n.Localizer {
+	if value, ok := c.Get(LocalizerKey); ok {
+		if l, ok := value.(*i18n.Localizer); ok {
+			return l
+		}
+	}
+	return nil
+}
+
+// errorMessage 业务错误在请求语言下的错误信息
+// 依次查找 MessageKey、错误码（仅非代码语言，代码语言中同一错误码的信息可能更具体），都没有时返回 Message；
+// 消息目录中的 {name} 占位符按错误的 Params 替换
+func errorMessage(l *i18n.Localizer, biz *bizErr.BizError) string {
+	if l == nil {
+		return biz.Message
+	}
+	if message, ok := l.Lookup(biz.MessageKey()); ok {
+		return biz.Render(message)
+	}
+	if !l.IsSource() {
+		if message, ok := l.Lookup(strconv.Itoa(biz.Code)); ok {
+			return biz.Render(message)
+		}
+	}
+	return biz.Message
+}
+
+// fieldMessage 字段验证错误在请求语言下的错误信息，查找顺序：
+//  1. 消息目录中带请求结构体名前缀的键（如 RegisterRequest.Mobile.required）
+//  2. GetMessages 中的消息：作为消息目录的键查找，代码语言时直接使用
+//  3. 消息目录中的 "字段名.规则名"
+//  4. 验证器内置规则的翻译
+//  5. GetMessages 中的消息（代码语言），最后为验证器的原始错误信息
+func fieldMessage(l *i18n.Localizer, requestName string, messages ValidatorMessages, v validator.FieldError) string {
+	key := v.StructField() + "." + v.Tag()
+	custom, hasCustom := messages[key]
+	if l == nil {
+		if hasCustom {
+			return custom
+		}
+		return v.Error()
+	}
+
+	if requestName != "" {
+		if message, ok := l.Lookup(requestName + "." + key); ok {
+			return message
+		}
+	}
+	if hasCustom {
+		if message, ok := l.Lookup(custom); ok {
+			return message
+		}
+		if l.IsSource() {
+			return custom
+		}
+	}
+	if message, ok := l.Lookup(key); ok {
+		return message
+	}
+	if message, ok := l.TranslateField(v); ok {
+		return message
+	}
+	if hasCustom {
+		return custom
+	}
+	return v.Error()
+}
+
+// requestTypeName 请求结构体的类型名
+func requestTypeName(request interface{}) string {
+	t := reflect.TypeOf(request)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+	return t.Name()
+}
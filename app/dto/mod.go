@@ -14,22 +14,31 @@ import (
 // ModSearchRequest 搜索 Mod 请求
 // @Description Mod 搜索筛选条件
 type ModSearchRequest struct {
-	Keyword    string `form:"keyword" json:"keyword" example:"武器"`                                                      // 搜索关键词
-	GameID     uint   `form:"game_id" json:"game_id" example:"1"`                                                       // 游戏ID
-	CategoryID uint   `form:"category_id" json:"category_id" example:"2"`                                               // 分类ID
-	Author     string `form:"author" json:"author" example:"ModAuthor"`                                                 // 作者名称
-	SortBy     string `form:"sort_by" json:"sort_by" example:"download_count" enums:"rating,download_count,created_at"` // 排序字段
-	Order      string `form:"order" json:"order" example:"desc" enums:"asc,desc"`                                       // 排序方向
-	Page       int    `form:"page" json:"page" binding:"min=0" example:"1"`                                             // 页码
-	PageSize   int    `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"`                          // 每页数量
+	Keyword       string `form:"keyword" json:"keyword" example:"武器"`                                                                                    // 搜索关键词
+	GameID        string `form:"game_id" json:"game_id" example:"1,2"`                                                                                   // 游戏ID（多个以逗号分隔）
+	CategoryID    string `form:"category_id" json:"category_id" example:"1,3"`                                                                           // 分类ID（多个以逗号分隔，命中任一即可，包含子孙分类）
+	Author        string `form:"author" json:"author" example:"ModAuthor"`                                                                               // 作者名称（模糊匹配）
+	AuthorID      uint   `form:"author_id" json:"author_id" example:"1"`                                                                                 // 作者用户ID（作者本人或共同维护者）
+	Tag           string `form:"tag" json:"tag" example:"ui,skse"`                                                                                       // 标签（多个以逗号分隔，命中任一即可）
+	GameVersionID string `form:"game_version_id" json:"game_version_id" example:"3,4"`                                                                   // 兼容的游戏版本ID（多个以逗号分隔，兼容任一即可）
+	GameVersion   string `form:"game_version" json:"game_version" binding:"max=100" example:">=1.6, <1.7"`                                               // 兼容的游戏版本约束（按游戏版本号匹配，兼容任一满足约束的版本即可，需同时指定 game_id）
+	SortBy        string `form:"sort_by" json:"sort_by" example:"download_count" enums:"relevance,trending,rating,download_count,view_count,created_at"` // 排序字段（有关键词时默认 relevance）
+	Order         string `form:"order" json:"order" example:"desc" enums:"asc,desc"`                                                                     // 排序方向
+	Page          int    `form:"page" json:"page" binding:"min=0" example:"1"`                                                                           // 页码
+	PageSize      int    `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"`                                                        // 每页数量
+	Cursor        string `form:"cursor" json:"cursor" binding:"max=512"`                                                                                 // 分页游标（取自上一页的 next_cursor，传入时忽略 page）
+	Facets        bool   `form:"facets" json:"facets" example:"false"`                                                                                   // 是否返回分面统计
+	WithTotal     *bool  `form:"with_total" json:"with_total" example:"true"`                                                                            // 是否统计总数（默认页码分页统计，游标分页不统计）
 }
 
 // GetMessages 自定义验证错误信息
 func (r ModSearchRequest) GetMessages() ValidatorMessages {
 	return ValidatorMessages{
-		"Page.min":     "页码不能小于0",
-		"PageSize.min": "每页数量不能小于0",
-		"PageSize.max": "每页数量不能超过100",
+		"Page.min":        "页码不能小于0",
+		"PageSize.min":    "每页数量不能小于0",
+		"PageSize.max":    "每页数量不能超过100",
+		"Cursor.max":      "分页游标格式错误",
+		"GameVersion.max": "游戏版本约束不能超过100个字符",
 	}
 }
 
@@ -47,16 +56,66 @@ func (r ModDetailRequest) GetMessages() ValidatorMessages {
 	}
 }
 
+// ModSaveRequest 创建/更新 Mod 请求
+// @Description Mod 基本信息（更新时整体覆盖）
+type ModSaveRequest struct {
+	Name           string `json:"name" binding:"required,max=255" example:"超级武器包"`                            // Mod 名称
+	Description    string `json:"description" example:"这是一个..."`                                              // 详细描述
+	Author         string `json:"author" binding:"max=100" example:"ModAuthor"`                               // 作者
+	Version        string `json:"version" binding:"max=50" example:"1.0.0"`                                   // 版本号
+	DownloadURL    string `json:"download_url" binding:"omitempty,url,max=500" example:"https://example.com"` // 下载链接
+	ImageURL       string `json:"image_url" binding:"omitempty,url,max=500" example:"https://example.com"`    // 封面图
+	FileSize       int64  `json:"file_size" binding:"min=0" example:"1048576"`                                // 文件大小（字节）
+	GameID         uint   `json:"game_id" binding:"required,min=1" example:"1"`                               // 游戏ID
+	CategoryIDs    []uint `json:"category_ids" example:"1,2"`                                                 // 分类ID列表
+	GameVersionIDs []uint `json:"game_version_ids" example:"3,4"`                                             // 兼容的游戏版本ID列表（须属于所选游戏）
+	Draft          bool   `json:"draft" example:"false"`                                                      // 创建时保存为草稿（不进入审核队列），更新时忽略
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModSaveRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Name.required":   "Mod 名称不能为空",
+		"Name.max":        "Mod 名称不能超过255个字符",
+		"Author.max":      "作者不能超过100个字符",
+		"Version.max":     "版本号不能超过50个字符",
+		"DownloadURL.url": "下载链接格式不正确",
+		"ImageURL.url":    "封面图链接格式不正确",
+		"FileSize.min":    "文件大小不能小于0",
+		"GameID.required": "游戏ID不能为空",
+		"GameID.min":      "游戏ID必须大于0",
+	}
+}
+
 // -------------------- Response --------------------
 
 // ModListResponse Mod 列表响应
 // @Description Mod 分页列表
 type ModListResponse struct {
-	List       []ModItemResponse `json:"list"`        // Mod 列表
-	Total      int64             `json:"total"`       // 总数
-	Page       int               `json:"page"`        // 当前页
-	PageSize   int               `json:"page_size"`   // 每页数量
-	TotalPages int               `json:"total_pages"` // 总页数
+	List       []ModItemResponse  `json:"list"`                  // Mod 列表
+	Total      *int64             `json:"total,omitempty"`       // 总数（未统计时省略）
+	Page       int                `json:"page"`                  // 当前页（游标分页时无意义）
+	PageSize   int                `json:"page_size"`             // 每页数量
+	TotalPages *int               `json:"total_pages,omitempty"` // 总页数（未统计时省略）
+	NextCursor string             `json:"next_cursor"`           // 下一页游标（为空表示没有更多数据）
+	HasMore    bool               `json:"has_more"`              // 是否还有下一页
+	Facets     *ModFacetsResponse `json:"facets,omitempty"`      // 分面统计（facets=true 时返回）
+}
+
+// ModFacetsResponse 分面统计
+// @Description 当前关键词和筛选条件下各筛选项的结果数（游戏、分类分面忽略自身维度的筛选）
+type ModFacetsResponse struct {
+	Games      []FacetBucketResponse `json:"games"`      // 按游戏
+	Categories []FacetBucketResponse `json:"categories"` // 按分类
+	Authors    []FacetBucketResponse `json:"authors"`    // 按作者（value 为作者用户 ID，可用作 author_id 筛选；最多 20 条）
+	Ratings    []FacetBucketResponse `json:"ratings"`    // 按评分区间
+}
+
+// FacetBucketResponse 分面统计项
+type FacetBucketResponse struct {
+	Value string `json:"value" example:"1"`    // 筛选值
+	Label string `json:"label" example:"我的世界"` // 展示名称
+	Count int64  `json:"count" example:"42"`   // 结果数
 }
 
 // ModItemResponse Mod 列表项
@@ -65,32 +124,54 @@ type ModItemResponse struct {
 	ID            uint      `json:"id" example:"1"`                 // Mod ID
 	Name          string    `json:"name" example:"超级武器包"`           // Mod 名称
 	Author        string    `json:"author" example:"ModAuthor"`     // 作者
+	OwnerID       uint      `json:"owner_id" example:"1"`           // 作者用户ID（0 表示未关联用户）
 	Version       string    `json:"version" example:"1.0.0"`        // 版本号
 	Rating        float64   `json:"rating" example:"4.5"`           // 评分
 	DownloadCount int       `json:"download_count" example:"10000"` // 下载次数
+	ViewCount     int       `json:"view_count" example:"50000"`     // 浏览次数
+	TrendingScore float64   `json:"trending_score" example:"128.5"` // 热度分
 	FileSize      int64     `json:"file_size" example:"1048576"`    // 文件大小（字节）
+	ThumbnailURL  string    `json:"thumbnail_url"`                  // 缩略图（第一张截图的缩略图，没有截图时为封面图链接）
 	GameName      string    `json:"game_name" example:"GTA5"`       // 游戏名称
 	Categories    []string  `json:"categories" example:"武器,载具"`     // 分类列表
+	Tags          []string  `json:"tags" example:"ui,skse"`         // 标签列表
 	CreatedAt     time.Time `json:"created_at"`                     // 创建时间
 	UpdatedAt     time.Time `json:"updated_at"`                     // 更新时间
+
+	Highlight *ModHighlightResponse `json:"highlight,omitempty"` // 关键词高亮片段（仅关键词搜索时返回）
+}
+
+// ModHighlightResponse 关键词高亮片段
+// @Description 命中关键词用 <em></em> 包裹，其余文本已做 HTML 转义
+type ModHighlightResponse struct {
+	Name        string `json:"name,omitempty" example:"超级<em>武器</em>包"`             // 名称高亮
+	Description string `json:"description,omitempty" example:"...新增<em>武器</em>..."` // 描述高亮片段
 }
 
 // ModDetailResponse Mod 详情响应
 // @Description Mod 完整详情信息
 type ModDetailResponse struct {
-	ID            uint              `json:"id" example:"1"`                 // Mod ID
-	Name          string            `json:"name" example:"超级武器包"`           // Mod 名称
-	Description   string            `json:"description" example:"这是一个..."`  // 详细描述
-	Author        string            `json:"author" example:"ModAuthor"`     // 作者
-	Version       string            `json:"version" example:"1.0.0"`        // 版本号
-	DownloadURL   string            `json:"download_url"`                   // 下载链接
-	Rating        float64           `json:"rating" example:"4.5"`           // 评分
-	DownloadCount int               `json:"download_count" example:"10000"` // 下载次数
-	FileSize      int64             `json:"file_size" example:"1048576"`    // 文件大小（字节）
-	Game          models.Game       `json:"game"`                           // 所属游戏
-	Categories    []models.Category `json:"categories"`                     // 分类列表
-	CreatedAt     time.Time         `json:"created_at"`                     // 创建时间
-	UpdatedAt     time.Time         `json:"updated_at"`                     // 更新时间
+	ID            uint                 `json:"id" example:"1"`                 // Mod ID
+	Name          string               `json:"name" example:"超级武器包"`           // Mod 名称
+	Description   string               `json:"description" example:"这是一个..."`  // 详细描述
+	Author        string               `json:"author" example:"ModAuthor"`     // 作者
+	Version       string               `json:"version" example:"1.0.0"`        // 版本号
+	DownloadURL   string               `json:"download_url"`                   // 下载链接
+	Rating        float64              `json:"rating" example:"4.5"`           // 评分
+	DownloadCount int                  `json:"download_count" example:"10000"` // 下载次数
+	ViewCount     int                  `json:"view_count" example:"50000"`     // 浏览次数
+	FileSize      int64                `json:"file_size" example:"1048576"`    // 文件大小（字节）
+	Game          models.Game          `json:"game"`                           // 所属游戏
+	Categories    []models.Category    `json:"categories"`                     // 分类列表
+	Tags          []models.Tag         `json:"tags"`                           // 标签列表
+	GameVersions  []models.GameVersion `json:"game_versions"`                  // 兼容的游戏版本
+	Status        string               `json:"status" example:"approved"`      // 审核状态
+	OwnerID       uint                 `json:"owner_id" example:"1"`           // 作者用户 ID（0 表示未关联用户）
+	Owner         *AuthorResponse      `json:"owner"`                          // 作者（未关联用户时为 null）
+	Maintainers   []AuthorResponse     `json:"maintainers"`                    // 共同维护者
+	Images        []ModImageResponse   `json:"images"`                         // 截图（按顺序排列，只包含处理完成的截图）
+	CreatedAt     time.Time            `json:"created_at"`                     // 创建时间
+	UpdatedAt     time.Time            `json:"updated_at"`                     // 更新时间
 }
 
 // GameListResponse 游戏列表响应
@@ -104,3 +185,18 @@ type GameListResponse struct {
 type CategoryListResponse struct {
 	List []models.Category `json:"list"` // 分类列表
 }
+
+// CategoryTreeResponse 分类树响应
+// @Description 按父子关系组织的分类树
+type CategoryTreeResponse struct {
+	List []CategoryTreeNode `json:"list"` // 顶级分类
+}
+
+// CategoryTreeNode 分类树节点
+type CategoryTreeNode struct {
+	ID          uint               `json:"id" example:"1"`          // 分类ID
+	Name        string             `json:"name" example:"Gameplay"` // 分类名称
+	Description string             `json:"description"`             // 分类描述
+	ParentID    *uint              `json:"parent_id"`               // 父分类ID
+	Children    []CategoryTreeNode `json:"children"`                // 子分类
+}
@@ -0,0 +1,72 @@
+package dto
+
+import "time"
+
+// ModDependencySaveRequest 创建/更新 Mod 依赖关系请求
+// @Description 依赖关系（更新时整体覆盖）
+type ModDependencySaveRequest struct {
+	DependsOnID       uint   `json:"depends_on_id" binding:"required,min=1" example:"5"`                              // 被依赖的 Mod ID
+	Type              string `json:"type" binding:"required,oneof=required optional incompatible" example:"required"` // 依赖类型
+	VersionConstraint string `json:"version_constraint" binding:"max=100,version_constraint" example:">=2.2, <3"`     // 版本约束（逗号分隔的条件需同时满足，|| 分隔的多组满足任一组即可，支持 ~、^、1.2.x 与 1.2 - 1.4）
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModDependencySaveRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"DependsOnID.required":                 "被依赖的 Mod ID 不能为空",
+		"DependsOnID.min":                      "被依赖的 Mod ID 必须大于0",
+		"Type.required":                        "依赖类型不能为空",
+		"Type.oneof":                           "依赖类型只能为 required、optional 或 incompatible",
+		"VersionConstraint.max":                "版本约束不能超过100个字符",
+		"VersionConstraint.version_constraint": "版本约束格式错误",
+	}
+}
+
+// ModDependencyURIRequest 依赖关系路径参数
+type ModDependencyURIRequest struct {
+	ID           uint `uri:"id" binding:"required,min=1" example:"4"`     // Mod ID
+	DependencyID uint `uri:"dep_id" binding:"required,min=1" example:"1"` // 依赖关系 ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModDependencyURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":           "Mod ID 不能为空",
+		"ID.min":                "Mod ID 必须大于0",
+		"DependencyID.required": "依赖关系 ID 不能为空",
+		"DependencyID.min":      "依赖关系 ID 必须大于0",
+	}
+}
+
+// ModDependencyResolveRequest 依赖解析请求
+type ModDependencyResolveRequest struct {
+	IncludeOptional bool `form:"include_optional" json:"include_optional" example:"false"` // 是否将可选依赖加入安装集合
+}
+
+// ModDependencyResponse 依赖关系
+// @Description Mod 声明的一条依赖关系
+type ModDependencyResponse struct {
+	ID                uint      `json:"id"`                 // 依赖关系 ID
+	ModID             uint      `json:"mod_id"`             // Mod ID
+	DependsOnID       uint      `json:"depends_on_id"`      // 被依赖的 Mod ID
+	DependsOnName     string    `json:"depends_on_name"`    // 被依赖的 Mod 名称
+	Type              string    `json:"type"`               // 依赖类型
+	VersionConstraint string    `json:"version_constraint"` // 版本约束
+	CreatedAt         time.Time `json:"created_at"`         // 创建时间
+}
+
+// ModInstallItemResponse 安装集合中的 Mod
+type ModInstallItemResponse struct {
+	ID       uint   `json:"id"`       // Mod ID
+	Name     string `json:"name"`     // Mod 名称
+	Version  string `json:"version"`  // 当前版本
+	Optional bool   `json:"optional"` // 是否仅因可选依赖被加入
+}
+
+// ModDependencyResolveResponse 依赖解析结果
+// @Description 直接声明的依赖关系及按拓扑序排列的完整安装集合
+type ModDependencyResolveResponse struct {
+	ModID        uint                     `json:"mod_id"`        // Mod ID
+	Dependencies []ModDependencyResponse  `json:"dependencies"`  // 直接声明的依赖关系
+	InstallOrder []ModInstallItemResponse `json:"install_order"` // 安装顺序（依赖在前，Mod 自身在最后）
+}
@@ -0,0 +1,84 @@
+package dto
+
+import "time"
+
+// ModImageURIRequest 截图路径参数
+type ModImageURIRequest struct {
+	ID      uint `uri:"id" binding:"required,min=1" example:"1"`       // Mod ID
+	ImageID uint `uri:"image_id" binding:"required,min=1" example:"3"` // 截图 ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModImageURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":      "Mod ID 不能为空",
+		"ID.min":           "Mod ID 必须大于0",
+		"ImageID.required": "截图 ID 不能为空",
+		"ImageID.min":      "截图 ID 必须大于0",
+	}
+}
+
+// ModImageUploadRequest 上传截图参数（图片通过 multipart 字段 file 上传）
+type ModImageUploadRequest struct {
+	Caption string `form:"caption" binding:"max=255" example:"主菜单"` // 说明文字
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModImageUploadRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Caption.max": "说明文字不能超过255个字符",
+	}
+}
+
+// ModImageCaptionRequest 修改截图说明请求
+type ModImageCaptionRequest struct {
+	Caption string `json:"caption" binding:"max=255" example:"主菜单"` // 说明文字（可为空）
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModImageCaptionRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Caption.max": "说明文字不能超过255个字符",
+	}
+}
+
+// ModImageOrderRequest 截图排序请求
+type ModImageOrderRequest struct {
+	ImageIDs []uint `json:"image_ids" binding:"required,min=1,dive,min=1" example:"3,1,2"` // 按展示顺序排列的全部截图 ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModImageOrderRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ImageIDs.required": "截图 ID 列表不能为空",
+		"ImageIDs.min":      "截图 ID 列表不能为空",
+	}
+}
+
+// ModImageResponse 截图
+// @Description 缩略图与 WebP 大图在后台生成，处理完成（status=ready）前只有原图地址
+type ModImageResponse struct {
+	ID               uint      `json:"id" example:"3"`                                                      // 截图 ID
+	Position         int       `json:"position" example:"0"`                                                // 排列顺序（从 0 开始）
+	Caption          string    `json:"caption" example:"主菜单"`                                               // 说明文字
+	Status           string    `json:"status" example:"ready" enums:"pending,ready,failed"`                 // 处理状态
+	Error            string    `json:"error,omitempty"`                                                     // 处理失败原因
+	Width            int       `json:"width" example:"1920"`                                                // 原图宽度
+	Height           int       `json:"height" example:"1080"`                                               // 原图高度
+	URL              string    `json:"url" example:"/uploads/mods/1/images/3f2a/original.png"`              // 原图地址
+	ThumbnailURL     string    `json:"thumbnail_url" example:"/uploads/mods/1/images/3f2a/thumb.jpg"`       // 缩略图（JPEG）
+	ThumbnailWebPURL string    `json:"thumbnail_webp_url" example:"/uploads/mods/1/images/3f2a/thumb.webp"` // 缩略图（WebP）
+	WebPURL          string    `json:"webp_url" example:"/uploads/mods/1/images/3f2a/large.webp"`           // 大图（WebP）
+	CreatedAt        time.Time `json:"created_at"`                                                          // 上传时间
+}
+
+// ModImageListResponse 截图列表
+type ModImageListResponse struct {
+	List []ModImageResponse `json:"list"` // 按顺序排列的截图
+}
+
+// GameCoverResponse 游戏封面上传结果
+type GameCoverResponse struct {
+	CoverImage string `json:"cover_image" example:"/uploads/games/1/cover/9c1e/cover.jpg"` // 封面（JPEG，同时写入游戏的 cover_image）
+	CoverWebP  string `json:"cover_webp" example:"/uploads/games/1/cover/9c1e/cover.webp"` // 封面（WebP）
+}
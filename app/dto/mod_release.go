@@ -0,0 +1,47 @@
+package dto
+
+import "gin-web/app/models"
+
+// ModReleaseSaveRequest 发布 Mod 版本请求
+// @Description 发布版本信息
+type ModReleaseSaveRequest struct {
+	Version        string `json:"version" binding:"required,max=50,version" example:"5.2.1"`                  // 版本号（需以数字开头，如 5.2.1、5.2SE、2.0-beta1）
+	Changelog      string `json:"changelog" example:"修复若干问题"`                                                 // 更新日志
+	DownloadURL    string `json:"download_url" binding:"omitempty,url,max=500" example:"https://example.com"` // 下载链接
+	FileSize       int64  `json:"file_size" binding:"min=0" example:"1048576"`                                // 文件大小（字节）
+	GameVersionIDs []uint `json:"game_version_ids" example:"3,4"`                                             // 兼容的游戏版本ID列表
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModReleaseSaveRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Version.required": "版本号不能为空",
+		"Version.max":      "版本号不能超过50个字符",
+		"Version.version":  "版本号需以数字开头，如 1.2.3、5.2SE、2.0-beta1",
+		"DownloadURL.url":  "下载链接格式不正确",
+		"DownloadURL.max":  "下载链接不能超过500个字符",
+		"FileSize.min":     "文件大小不能小于0",
+	}
+}
+
+// ModReleaseURIRequest 发布版本路径参数
+type ModReleaseURIRequest struct {
+	ID        uint `uri:"id" binding:"required,min=1" example:"1"`         // Mod ID
+	ReleaseID uint `uri:"release_id" binding:"required,min=1" example:"1"` // 发布版本ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModReleaseURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":        "Mod ID 不能为空",
+		"ID.min":             "Mod ID 必须大于0",
+		"ReleaseID.required": "发布版本ID不能为空",
+		"ReleaseID.min":      "发布版本ID必须大于0",
+	}
+}
+
+// ModReleaseListResponse 发布版本列表响应
+type ModReleaseListResponse struct {
+	List   []models.ModRelease `json:"list"`                             // 发布版本（按版本号从新到旧）
+	Latest string              `json:"latest,omitempty" example:"5.2.1"` // 最新的正式版本（没有正式版本时为最新的预发布版本）
+}
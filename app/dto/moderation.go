@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"gin-web/app/models"
+)
+
+// ModerationQueueRequest 审核队列请求
+type ModerationQueueRequest struct {
+	Status   string `form:"status" json:"status" binding:"omitempty,oneof=draft pending approved rejected hidden" example:"pending"` // 审核状态（默认 pending）
+	Page     int    `form:"page" json:"page" binding:"min=0" example:"1"`                                                            // 页码
+	PageSize int    `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"`                                         // 每页数量
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModerationQueueRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Status.oneof": "审核状态无效",
+		"Page.min":     "页码不能小于0",
+		"PageSize.min": "每页数量不能小于0",
+		"PageSize.max": "每页数量不能超过100",
+	}
+}
+
+// ModReviewRequest 审核操作请求
+// @Description 驳回和下架必须填写原因
+type ModReviewRequest struct {
+	Action string `json:"action" binding:"required,oneof=approve reject hide" example:"reject"` // 审核操作
+	Reason string `json:"reason" binding:"max=500" example:"缺少安装说明"`                            // 原因（驳回、下架时必填）
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModReviewRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Action.required": "审核操作不能为空",
+		"Action.oneof":    "审核操作只能是 approve、reject 或 hide",
+		"Reason.max":      "原因不能超过500个字符",
+	}
+}
+
+// ModerationItemResponse 审核队列条目
+type ModerationItemResponse struct {
+	ModItemResponse
+	Status string `json:"status" example:"pending"` // 审核状态
+}
+
+// ModerationQueueResponse 审核队列响应
+// @Description 按提交（最后修改）时间升序，先提交的先审核
+type ModerationQueueResponse struct {
+	List       []ModerationItemResponse `json:"list"`        // Mod 列表
+	Total      int64                    `json:"total"`       // 总数
+	Page       int                      `json:"page"`        // 当前页
+	PageSize   int                      `json:"page_size"`   // 每页数量
+	TotalPages int                      `json:"total_pages"` // 总页数
+}
+
+// ModStatusResponse 审核状态变更结果
+type ModStatusResponse struct {
+	ID        uint      `json:"id" example:"1"`           // Mod ID
+	Status    string    `json:"status" example:"pending"` // 当前审核状态
+	UpdatedAt time.Time `json:"updated_at"`               // 更新时间
+}
+
+// ModReviewListResponse 审核记录响应
+type ModReviewListResponse struct {
+	List []models.ModReview `json:"list"` // 审核记录（最新的在前）
+}
@@ -0,0 +1,42 @@
+package dto
+
+import "gin-web/app/models"
+
+// NotificationListRequest 通知列表请求
+type NotificationListRequest struct {
+	Unread   bool `form:"unread" json:"unread" example:"false"`                            // 是否只返回未读通知
+	Page     int  `form:"page" json:"page" binding:"min=0" example:"1"`                    // 页码
+	PageSize int  `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"` // 每页数量
+}
+
+// GetMessages 自定义验证错误信息
+func (r NotificationListRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Page.min":     "页码不能小于0",
+		"PageSize.min": "每页数量不能小于0",
+		"PageSize.max": "每页数量不能超过100",
+	}
+}
+
+// NotificationURIRequest 通知路径参数
+type NotificationURIRequest struct {
+	ID uint `uri:"id" binding:"required,min=1" example:"1"` // 通知 ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r NotificationURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required": "通知 ID 不能为空",
+		"ID.min":      "通知 ID 必须大于0",
+	}
+}
+
+// NotificationListResponse 通知分页列表响应
+type NotificationListResponse struct {
+	List        []models.Notification `json:"list"`         // 通知列表（最新的在前）
+	Total       int64                 `json:"total"`        // 总数
+	UnreadCount int64                 `json:"unread_count"` // 未读数量
+	Page        int                   `json:"page"`         // 当前页
+	PageSize    int                   `json:"page_size"`    // 每页数量
+	TotalPages  int                   `json:"total_pages"`  // 总页数
+}
@@ -0,0 +1,102 @@
+package dto
+
+import "time"
+
+// ReportCreateRequest 提交举报请求
+type ReportCreateRequest struct {
+	TargetType string `json:"target_type" binding:"required,oneof=mod comment" example:"mod"`                                       // 举报对象类型
+	TargetID   uint   `json:"target_id" binding:"required,min=1" example:"1"`                                                       // 举报对象ID
+	Reason     string `json:"reason" binding:"required,oneof=spam malicious broken inappropriate copyright other" example:"broken"` // 举报原因
+	Details    string `json:"details" binding:"max=1000" example:"下载链接已失效"`                                                         // 补充说明
+}
+
+// GetMessages 自定义验证错误信息
+func (r ReportCreateRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"TargetType.required": "举报对象类型不能为空",
+		"TargetType.oneof":    "举报对象类型只能是 mod 或 comment",
+		"TargetID.required":   "举报对象ID不能为空",
+		"TargetID.min":        "举报对象ID无效",
+		"Reason.required":     "举报原因不能为空",
+		"Reason.oneof":        "举报原因无效",
+		"Details.max":         "补充说明不能超过1000个字符",
+	}
+}
+
+// ReportQueueRequest 举报队列请求
+type ReportQueueRequest struct {
+	Status     string `form:"status" json:"status" binding:"omitempty,oneof=pending resolved dismissed" example:"pending"` // 处理状态（默认 pending）
+	TargetType string `form:"target_type" json:"target_type" binding:"omitempty,oneof=mod comment" example:"mod"`          // 举报对象类型（为空时不限）
+	Page       int    `form:"page" json:"page" binding:"min=0" example:"1"`                                                // 页码
+	PageSize   int    `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"`                             // 每页数量
+}
+
+// GetMessages 自定义验证错误信息
+func (r ReportQueueRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Status.oneof":     "处理状态无效",
+		"TargetType.oneof": "举报对象类型只能是 mod 或 comment",
+		"Page.min":         "页码不能小于0",
+		"PageSize.min":     "每页数量不能小于0",
+		"PageSize.max":     "每页数量不能超过100",
+	}
+}
+
+// ReportURIRequest 举报路径参数
+type ReportURIRequest struct {
+	ID uint `uri:"id" binding:"required,min=1"` // 举报ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ReportURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required": "举报ID不能为空",
+		"ID.min":      "举报ID无效",
+	}
+}
+
+// ReportHandleRequest 处理举报请求
+type ReportHandleRequest struct {
+	Note string `json:"note" binding:"max=500" example:"确认为失效资源"` // 处理说明（确认举报时作为下架原因通知作者）
+}
+
+// GetMessages 自定义验证错误信息
+func (r ReportHandleRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Note.max": "处理说明不能超过500个字符",
+	}
+}
+
+// ReportResponse 举报响应
+type ReportResponse struct {
+	ID         uint       `json:"id" example:"1"`                   // 举报ID
+	TargetType string     `json:"target_type" example:"mod"`        // 举报对象类型
+	TargetID   uint       `json:"target_id" example:"1"`            // 举报对象ID
+	ReporterID uint       `json:"reporter_id" example:"7"`          // 举报人
+	Reason     string     `json:"reason" example:"broken"`          // 举报原因
+	Details    string     `json:"details" example:"下载链接已失效"`        // 补充说明
+	Status     string     `json:"status" example:"pending"`         // 处理状态
+	HandlerID  uint       `json:"handler_id,omitempty" example:"1"` // 处理人
+	Note       string     `json:"note,omitempty"`                   // 处理说明
+	HandledAt  *time.Time `json:"handled_at,omitempty"`             // 处理时间
+	CreatedAt  time.Time  `json:"created_at"`                       // 提交时间
+}
+
+// ReportQueueResponse 举报队列响应
+// @Description 按提交时间升序，先提交的先处理
+type ReportQueueResponse struct {
+	List       []ReportResponse `json:"list"`        // 举报列表
+	Total      int64            `json:"total"`       // 总数
+	Page       int              `json:"page"`        // 当前页
+	PageSize   int              `json:"page_size"`   // 每页数量
+	TotalPages int              `json:"total_pages"` // 总页数
+}
+
+// ReportHandleResponse 处理举报结果
+// @Description 同一对象的所有待处理举报会一并处理
+type ReportHandleResponse struct {
+	TargetType string `json:"target_type" example:"mod"` // 举报对象类型
+	TargetID   uint   `json:"target_id" example:"1"`     // 举报对象ID
+	Status     string `json:"status" example:"resolved"` // 处理结果
+	Handled    int64  `json:"handled" example:"3"`       // 本次处理的举报数
+}
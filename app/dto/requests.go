@@ -0,0 +1,25 @@
+package dto
+
+// Requests 所有请求结构体（导出的 *Request 结构体及其他带 binding 验证标签的结构体）
+// 启动时用于检查验证标签是否都已注册（新增请求结构体后需加入此列表，test/dto 中的测试会检查是否遗漏）
+func Requests() []interface{} {
+	return []interface{}{
+		LoginRequest{}, RegisterRequest{},
+		AuthorDetailRequest{}, ModMaintainerRequest{}, ModMaintainerURIRequest{}, ModOwnerRequest{},
+		CatalogEntityRequest{}, CatalogExportRequest{}, CatalogImportRequest{},
+		CollectionCreateRequest{}, CollectionItemAddRequest{}, CollectionItemRequest{}, CollectionItemURIRequest{}, CollectionItemsRequest{}, CollectionListRequest{}, CollectionURIRequest{}, CollectionUpdateRequest{},
+		CommentCreateRequest{}, CommentListRequest{}, CommentURIRequest{}, CommentUpdateRequest{},
+		GameDetailRequest{}, GameVersionSaveRequest{}, GameVersionURIRequest{},
+		ModDetailRequest{}, ModSaveRequest{}, ModSearchRequest{},
+		ModDependencyResolveRequest{}, ModDependencySaveRequest{}, ModDependencyURIRequest{},
+		ModImageCaptionRequest{}, ModImageOrderRequest{}, ModImageURIRequest{}, ModImageUploadRequest{},
+		ModReleaseSaveRequest{}, ModReleaseURIRequest{},
+		ModReviewRequest{}, ModerationQueueRequest{},
+		NotificationListRequest{}, NotificationURIRequest{},
+		ReportCreateRequest{}, ReportHandleRequest{}, ReportQueueRequest{}, ReportURIRequest{},
+		StatsRangeRequest{},
+		ModTagURIRequest{}, TagAutocompleteRequest{}, TagSearchRequest{}, TagSuggestRequest{},
+		ModTranslationLocaleRequest{}, TranslationLocaleRequest{}, TranslationSaveRequest{}, TranslationTargetRequest{},
+		TrendingRequest{},
+	}
+}
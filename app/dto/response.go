@@ -1,12 +1,15 @@
 package dto
 
 import (
+	"errors"
 	"net/http"
 	"os"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 
 	"gin-web/config"
+	bizErr "gin-web/pkg/errors"
 )
 
 // ================================
@@ -46,18 +49,33 @@ func Success(c *gin.Context, data interface{}) {
 }
 
 // Fail 失败响应
-// ErrorCode 不为 0 表示失败
+// ErrorCode 不为 0 表示失败，HTTP 状态码按错误码映射（如 42200 为 422、30001 为 404）
 func Fail(c *gin.Context, errorCode int, msg string) {
-	c.JSON(http.StatusOK, Response{
+	c.JSON(bizErr.HTTPStatus(errorCode), Response{
 		ErrorCode: errorCode,
 		Data:      nil,
 		Message:   msg,
 	})
 }
 
-// FailByError 失败响应（使用自定义错误）
-func FailByError(c *gin.Context, err CustomError) {
-	Fail(c, err.ErrorCode, err.ErrorMsg)
+// FailByError 失败响应（按错误类型统一映射）
+// 业务错误返回其错误码与错误信息，包装的内部原因不返回给客户端；记录不存在映射为 ErrNotFound；
+// 其他错误统一返回服务器内部错误。内部原因通过 c.Error 附加到请求上，由 ErrorLog 中间件记录；
+// 错误信息按请求语言从消息目录中查找（见 I18n 中间件）
+func FailByError(c *gin.Context, err error) {
+	var biz *bizErr.BizError
+	switch {
+	case errors.As(err, &biz):
+		if biz.Err != nil || biz.HTTPStatus() >= http.StatusInternalServerError {
+			_ = c.Error(err)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		biz = bizErr.ErrNotFound
+	default:
+		_ = c.Error(err)
+		biz = bizErr.ErrInternal
+	}
+	Fail(c, biz.Code, errorMessage(requestLocalizer(c), biz))
 }
 
 // ValidateFail 参数验证失败响应
@@ -72,7 +90,7 @@ func BusinessFail(c *gin.Context, msg string) {
 
 // TokenFail Token 验证失败响应
 func TokenFail(c *gin.Context) {
-	FailByError(c, ErrToken)
+	FailByError(c, bizErr.ErrUnauthorized)
 }
 
 // ServerError 服务器内部错误响应
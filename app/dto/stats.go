@@ -0,0 +1,75 @@
+package dto
+
+// StatsRangeRequest 统计时间范围请求
+type StatsRangeRequest struct {
+	From     string `form:"from" json:"from" binding:"omitempty,datetime=2006-01-02" example:"2026-10-01"` // 开始日期（含，默认结束日期前 29 天）
+	To       string `form:"to" json:"to" binding:"omitempty,datetime=2006-01-02" example:"2026-10-14"`     // 结束日期（含，默认今天）
+	Interval string `form:"interval" json:"interval" binding:"omitempty,oneof=day week" example:"day"`     // 统计粒度（默认 day）
+}
+
+// GetMessages 自定义验证错误信息
+func (r StatsRangeRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"From.datetime":  "开始日期格式应为 YYYY-MM-DD",
+		"To.datetime":    "结束日期格式应为 YYYY-MM-DD",
+		"Interval.oneof": "统计粒度只能是 day 或 week",
+	}
+}
+
+// StatsCountResponse 下载 / 浏览 / 独立访客计数
+type StatsCountResponse struct {
+	Downloads   int64 `json:"downloads" example:"120"`   // 下载次数
+	Views       int64 `json:"views" example:"860"`       // 浏览次数
+	UniqueUsers int64 `json:"unique_users" example:"95"` // 独立访客数（按天去重，多天的数据为每日独立访客数之和）
+}
+
+// StatsPointResponse 时间序列中的一个数据点
+type StatsPointResponse struct {
+	Date string `json:"date" example:"2026-10-12"` // 日期（week 粒度为所在周的周一）
+	StatsCountResponse
+}
+
+// VersionStatsResponse 版本下载量
+type VersionStatsResponse struct {
+	Version   string `json:"version" example:"5.2SE"` // 版本号（下载时 Mod 的当前版本）
+	Downloads int64  `json:"downloads" example:"80"`  // 区间内的下载次数
+}
+
+// ModStatsResponse Mod 统计报表
+// @Description 每日计数由定时任务从下载 / 浏览事件汇总，最近几分钟的数据可能尚未计入
+type ModStatsResponse struct {
+	ModID    uint                   `json:"mod_id" example:"1"`        // Mod ID
+	Interval string                 `json:"interval" example:"day"`    // 统计粒度
+	From     string                 `json:"from" example:"2026-10-01"` // 开始日期
+	To       string                 `json:"to" example:"2026-10-14"`   // 结束日期
+	Totals   StatsCountResponse     `json:"totals"`                    // 区间合计
+	Points   []StatsPointResponse   `json:"points"`                    // 时间序列（无数据的日期计为 0）
+	Versions []VersionStatsResponse `json:"versions"`                  // 各版本下载量
+}
+
+// ModStatsRankResponse 统计排行中的 Mod
+type ModStatsRankResponse struct {
+	ModID     uint   `json:"mod_id" example:"1"`      // Mod ID
+	Name      string `json:"name" example:"SkyUI"`    // Mod 名称
+	Downloads int64  `json:"downloads" example:"120"` // 下载次数
+	Views     int64  `json:"views" example:"860"`     // 浏览次数
+}
+
+// GameStatsRankResponse 统计排行中的游戏
+type GameStatsRankResponse struct {
+	GameID    uint   `json:"game_id" example:"1"`      // 游戏ID
+	Name      string `json:"name" example:"Skyrim"`    // 游戏名称
+	Downloads int64  `json:"downloads" example:"3400"` // 下载次数
+	Views     int64  `json:"views" example:"21000"`    // 浏览次数
+}
+
+// StatsDashboardResponse 全站统计看板
+type StatsDashboardResponse struct {
+	Interval string                  `json:"interval" example:"day"`    // 统计粒度
+	From     string                  `json:"from" example:"2026-10-01"` // 开始日期
+	To       string                  `json:"to" example:"2026-10-14"`   // 结束日期
+	Totals   StatsCountResponse      `json:"totals"`                    // 区间合计
+	Points   []StatsPointResponse    `json:"points"`                    // 全站时间序列
+	TopMods  []ModStatsRankResponse  `json:"top_mods"`                  // 下载量最多的 Mod
+	TopGames []GameStatsRankResponse `json:"top_games"`                 // 下载量最多的游戏
+}
@@ -0,0 +1,88 @@
+package dto
+
+import "gin-web/app/models"
+
+// TagSuggestRequest 为 Mod 建议标签请求
+// @Description 标签名称会统一转为小写，不存在时自动创建
+type TagSuggestRequest struct {
+	Name string `json:"name" binding:"required,max=50" example:"ui"` // 标签名称
+}
+
+// GetMessages 自定义验证错误信息
+func (r TagSuggestRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Name.required": "标签名称不能为空",
+		"Name.max":      "标签名称不能超过50个字符",
+	}
+}
+
+// ModTagURIRequest Mod 标签路径参数
+type ModTagURIRequest struct {
+	ID    uint `uri:"id" binding:"required,min=1" example:"1"`     // Mod ID
+	TagID uint `uri:"tag_id" binding:"required,min=1" example:"1"` // 标签 ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModTagURIRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":    "Mod ID 不能为空",
+		"ID.min":         "Mod ID 必须大于0",
+		"TagID.required": "标签 ID 不能为空",
+		"TagID.min":      "标签 ID 必须大于0",
+	}
+}
+
+// TagSearchRequest 搜索标签请求
+type TagSearchRequest struct {
+	Keyword  string `form:"keyword" json:"keyword" binding:"max=50" example:"ui"`            // 搜索关键词
+	Page     int    `form:"page" json:"page" binding:"min=0" example:"1"`                    // 页码
+	PageSize int    `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"` // 每页数量
+}
+
+// GetMessages 自定义验证错误信息
+func (r TagSearchRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Keyword.max":  "搜索关键词不能超过50个字符",
+		"Page.min":     "页码不能小于0",
+		"PageSize.min": "每页数量不能小于0",
+		"PageSize.max": "每页数量不能超过100",
+	}
+}
+
+// TagAutocompleteRequest 标签补全请求
+type TagAutocompleteRequest struct {
+	Prefix string `form:"prefix" json:"prefix" binding:"required,max=50" example:"sk"` // 名称前缀
+	Limit  int    `form:"limit" json:"limit" binding:"min=0,max=50" example:"10"`      // 返回数量
+}
+
+// GetMessages 自定义验证错误信息
+func (r TagAutocompleteRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Prefix.required": "前缀不能为空",
+		"Prefix.max":      "前缀不能超过50个字符",
+		"Limit.min":       "返回数量不能小于0",
+		"Limit.max":       "返回数量不能超过50",
+	}
+}
+
+// TagListResponse 标签分页列表响应
+// @Description 标签列表（按使用数降序）
+type TagListResponse struct {
+	List       []models.Tag `json:"list"`        // 标签列表
+	Total      int64        `json:"total"`       // 总数
+	Page       int          `json:"page"`        // 当前页
+	PageSize   int          `json:"page_size"`   // 每页数量
+	TotalPages int          `json:"total_pages"` // 总页数
+}
+
+// TagSuggestionResponse 标签补全响应
+// @Description 按前缀匹配的标签（按使用数降序）
+type TagSuggestionResponse struct {
+	List []models.Tag `json:"list"` // 标签列表
+}
+
+// ModTagsResponse Mod 标签列表响应
+type ModTagsResponse struct {
+	ModID uint         `json:"mod_id"` // Mod ID
+	Tags  []models.Tag `json:"tags"`   // 标签列表
+}
@@ -0,0 +1,86 @@
+package dto
+
+import "time"
+
+// TranslationTargetRequest 翻译对象路径参数（管理员）
+type TranslationTargetRequest struct {
+	Entity string `uri:"entity" binding:"required,oneof=games categories mods"` // 数据类型
+	ID     uint   `uri:"id" binding:"required,min=1" example:"1"`               // 游戏、分类或 Mod 的 ID
+}
+
+// GetMessages 自定义验证错误信息
+func (r TranslationTargetRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Entity.required": "数据类型不能为空",
+		"Entity.oneof":    "数据类型只能是 games、categories 或 mods",
+		"ID.required":     "ID 不能为空",
+		"ID.min":          "ID 必须大于0",
+	}
+}
+
+// TranslationLocaleRequest 指定语言的翻译路径参数（管理员）
+type TranslationLocaleRequest struct {
+	Entity string `uri:"entity" binding:"required,oneof=games categories mods"` // 数据类型
+	ID     uint   `uri:"id" binding:"required,min=1" example:"1"`               // 游戏、分类或 Mod 的 ID
+	Locale string `uri:"locale" binding:"required,max=20" example:"en"`         // 语言
+}
+
+// GetMessages 自定义验证错误信息
+func (r TranslationLocaleRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Entity.required": "数据类型不能为空",
+		"Entity.oneof":    "数据类型只能是 games、categories 或 mods",
+		"ID.required":     "ID 不能为空",
+		"ID.min":          "ID 必须大于0",
+		"Locale.required": "语言不能为空",
+		"Locale.max":      "语言格式错误",
+	}
+}
+
+// ModTranslationLocaleRequest 指定语言的 Mod 翻译路径参数
+type ModTranslationLocaleRequest struct {
+	ID     uint   `uri:"id" binding:"required,min=1" example:"1"`       // Mod ID
+	Locale string `uri:"locale" binding:"required,max=20" example:"en"` // 语言
+}
+
+// GetMessages 自定义验证错误信息
+func (r ModTranslationLocaleRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"ID.required":     "Mod ID 不能为空",
+		"ID.min":          "Mod ID 必须大于0",
+		"Locale.required": "语言不能为空",
+		"Locale.max":      "语言格式错误",
+	}
+}
+
+// TranslationSaveRequest 保存翻译请求
+type TranslationSaveRequest struct {
+	Name        string `json:"name" binding:"required,max=255" example:"Weapon Pack"`         // 名称
+	Description string `json:"description" binding:"max=65535" example:"Adds 50 new weapons"` // 描述（为空时使用默认语言的描述）
+}
+
+// GetMessages 自定义验证错误信息
+func (r TranslationSaveRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Name.required":   "名称不能为空",
+		"Name.max":        "名称不能超过255个字符",
+		"Description.max": "描述过长",
+	}
+}
+
+// TranslationResponse 一条翻译
+type TranslationResponse struct {
+	Locale      string    `json:"locale" example:"en"`                       // 语言
+	Name        string    `json:"name" example:"Weapon Pack"`                // 名称
+	Description string    `json:"description" example:"Adds 50 new weapons"` // 描述
+	UpdatedAt   time.Time `json:"updated_at"`                                // 更新时间
+}
+
+// TranslationListResponse 翻译列表
+type TranslationListResponse struct {
+	Entity        string                `json:"entity" example:"mods"`          // 数据类型
+	ID            uint                  `json:"id" example:"1"`                 // 游戏、分类或 Mod 的 ID
+	DefaultLocale string                `json:"default_locale" example:"zh-CN"` // 默认语言（原数据使用的语言）
+	Supported     []string              `json:"supported" example:"zh-CN,en"`   // 支持的语言
+	List          []TranslationResponse `json:"list"`                           // 各语言的翻译
+}
@@ -0,0 +1,18 @@
+package dto
+
+// TrendingRequest 热门 Mod 请求
+type TrendingRequest struct {
+	GameID     uint `form:"game_id" json:"game_id" binding:"min=0" example:"1"`              // 游戏ID
+	CategoryID uint `form:"category_id" json:"category_id" binding:"min=0" example:"2"`      // 分类ID（包含子孙分类）
+	Page       int  `form:"page" json:"page" binding:"min=0" example:"1"`                    // 页码
+	PageSize   int  `form:"page_size" json:"page_size" binding:"min=0,max=100" example:"20"` // 每页数量
+}
+
+// GetMessages 自定义验证错误信息
+func (r TrendingRequest) GetMessages() ValidatorMessages {
+	return ValidatorMessages{
+		"Page.min":     "页码不能小于0",
+		"PageSize.min": "每页数量不能小于0",
+		"PageSize.max": "每页数量不能超过100",
+	}
+}
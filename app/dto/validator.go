@@ -1,7 +1,15 @@
 package dto
 
 import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
+
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/i18n"
 )
 
 // Validator 验证器接口
@@ -11,26 +19,92 @@ type Validator interface {
 }
 
 // ValidatorMessages 验证消息映射
-// key 格式: "字段名.规则名"，如 "Mobile.required"
+// key 格式: "字段名.规则名"，如 "Mobile.required"（字段名为结构体字段名）
+// value 为代码语言的消息，也可以是消息目录中的键（其他语言从消息目录中查找，见 app/messages）
 type ValidatorMessages map[string]string
 
-// GetErrorMsg 获取验证错误信息
+// FieldError 字段验证错误
+// @Description 单个字段的验证错误
+type FieldError struct {
+	Field   string `json:"field" example:"mobile"`     // 字段名（JSON 名称，嵌套字段如 items[0].name）
+	Rule    string `json:"rule" example:"required"`    // 未通过的验证规则（JSON 类型不匹配时为 type）
+	Message string `json:"message" example:"手机号码不能为空"` // 错误信息
+}
+
+// ValidationErrorResponse 参数验证失败响应
+// @Description 参数验证失败时 data 中返回全部未通过验证的字段
+type ValidationErrorResponse struct {
+	Errors []FieldError `json:"errors"` // 字段验证错误（按字段声明顺序）
+}
+
+// GetFieldErrors 获取全部字段验证错误
+// 如果 request 实现了 Validator 接口，则优先使用自定义错误信息；不是字段验证错误时返回 nil
+func GetFieldErrors(request interface{}, err error) []FieldError {
+	return localizedFieldErrors(nil, request, err)
+}
+
+// localizedFieldErrors 按 l 的语言获取全部字段验证错误（l 为 nil 时使用代码中的消息）
+func localizedFieldErrors(l *i18n.Localizer, request interface{}, err error) []FieldError {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return []FieldError{{Field: typeErr.Field, Rule: "type", Message: typeErr.Error()}}
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	var messages ValidatorMessages
+	if v, ok := request.(Validator); ok {
+		messages = v.GetMessages()
+	}
+	requestName := requestTypeName(request)
+
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+	for _, v := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fieldPath(v),
+			Rule:    v.Tag(),
+			Message: fieldMessage(l, requestName, messages, v),
+		})
+	}
+	return fieldErrors
+}
+
+// GetErrorMsg 获取验证错误信息（第一个字段的错误信息）
 // 如果 request 实现了 Validator 接口，则使用自定义错误信息
 func GetErrorMsg(request interface{}, err error) string {
-	if _, isValidatorErrors := err.(validator.ValidationErrors); isValidatorErrors {
-		_, isValidator := request.(Validator)
-		for _, v := range err.(validator.ValidationErrors) {
-			// 若 request 结构体实现 Validator 接口即可实现自定义错误信息
-			if isValidator {
-				if message, exist := request.(Validator).GetMessages()[v.Field()+"."+v.Tag()]; exist {
-					return message
-				}
-			}
-			return v.Error()
-		}
-	} else {
+	if fieldErrors := GetFieldErrors(request, err); len(fieldErrors) > 0 {
+		return fieldErrors[0].Message
+	}
+	if err != nil {
 		return err.Error()
 	}
-
 	return "Parameter error"
 }
+
+// ValidateFailByError 参数验证失败响应
+// message 为第一个字段的错误信息，data.errors 返回全部未通过验证的字段；错误信息按请求语言本地化
+func ValidateFailByError(c *gin.Context, request interface{}, err error) {
+	fieldErrors := localizedFieldErrors(requestLocalizer(c), request, err)
+	if len(fieldErrors) == 0 {
+		ValidateFail(c, GetErrorMsg(request, err))
+		return
+	}
+	c.JSON(bizErr.HTTPStatus(CodeValidateError), Response{
+		ErrorCode: CodeValidateError,
+		Data:      ValidationErrorResponse{Errors: fieldErrors},
+		Message:   fieldErrors[0].Message,
+	})
+}
+
+// fieldPath 字段在请求中的路径（去掉顶层结构体名，如 ModSaveRequest.tags[0] 为 tags[0]）
+// 字段名来自验证器注册的 TagNameFunc（bootstrap.InitializeValidator 中按 json、form、uri 标签命名）
+func fieldPath(v validator.FieldError) string {
+	namespace := v.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return v.Field()
+}
@@ -0,0 +1,13 @@
+package messages
+
+import "embed"
+
+// Source 代码中错误信息与验证消息使用的语言（pkg/errors 的 Message、请求结构体的 GetMessages）
+const Source = "zh-CN"
+
+// FS 内置的消息目录，每种语言一个文件（如 en.yaml）
+// 键为错误码（如 "30001"，同一错误码有多种信息时为 BizError.Key，如 "40300.mod_owner"）
+// 或验证消息键（"字段名.规则名"，如 "Mobile.mobile"；只对某个请求生效时加请求结构体名前缀，如 "RegisterRequest.Mobile.required"）
+//
+//go:embed *.yaml
+var FS embed.FS
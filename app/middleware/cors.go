@@ -8,9 +8,9 @@ import (
 func Cors() gin.HandlerFunc {
 	config := cors.DefaultConfig()
 	config.AllowAllOrigins = true
-	config.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization"}
+	config.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization", "If-None-Match", "If-Modified-Since"}
 	config.AllowCredentials = true
-	config.ExposeHeaders = []string{"New-Token", "New-Expires-In", "Content-Disposition"}
+	config.ExposeHeaders = []string{"New-Token", "New-Expires-In", "Content-Disposition", "ETag", "Last-Modified"}
 
 	return cors.New(config)
 }
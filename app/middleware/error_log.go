@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// ErrorLog 记录处理函数通过 c.Error 附加的错误
+// dto.FailByError 不向客户端返回内部错误原因，原因在此记录到日志
+func ErrorLog(log *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		for _, e := range c.Errors {
+			log.Error("request failed",
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path),
+				zap.Int("status", c.Writer.Status()),
+				zap.Error(e.Err),
+			)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HTTPCache HTTP 缓存中间件（对 GET 请求生效）
+// 成功响应（HTTP 200 且 error_code 为 0）写入 cacheControl 指定的 Cache-Control 响应头，
+// 处理函数未设置 ETag 时按响应内容的摘要生成；请求的 If-None-Match 或 If-Modified-Since 命中时返回 304 且不返回响应体
+// 失败响应原样返回，不写入缓存相关的响应头
+func HTTPCache(cacheControl string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		w := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		// 处理函数 panic 时恢复原始 Writer，由 Recovery 中间件输出错误响应
+		defer func() { c.Writer = w.ResponseWriter }()
+
+		c.Next()
+
+		body := w.body.Bytes()
+		if w.Status() == http.StatusOK && succeeded(body) {
+			header := w.Header()
+			header.Set("Cache-Control", cacheControl)
+			if header.Get("ETag") == "" {
+				header.Set("ETag", `"`+digest(body)+`"`)
+			}
+			if notModified(c.Request, header) {
+				header.Del("Content-Type")
+				header.Del("Content-Length")
+				w.ResponseWriter.WriteHeader(http.StatusNotModified)
+				w.ResponseWriter.WriteHeaderNow()
+				return
+			}
+		}
+
+		w.ResponseWriter.WriteHeaderNow()
+		if len(body) > 0 {
+			_, _ = w.ResponseWriter.Write(body)
+		}
+	}
+}
+
+// WeakETag 按内容摘要生成弱 ETag
+// 用于响应中包含不影响语义的易变字段（如浏览次数）的接口：处理函数去掉这些字段后计算 ETag 并写入响应头
+func WeakETag(data []byte) string {
+	return `W/"` + digest(data) + `"`
+}
+
+// SetLastModified 写入 Last-Modified 响应头（用于 If-Modified-Since 判断），零值时间不写入
+func SetLastModified(c *gin.Context, t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	c.Header("Last-Modified", t.UTC().Format(http.TimeFormat))
+}
+
+// bufferedWriter 缓存响应体，处理函数执行完成后再决定返回完整响应还是 304
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+// succeeded 响应体是否为成功的统一响应（error_code 为 0）
+func succeeded(body []byte) bool {
+	var resp struct {
+		ErrorCode *int `json:"error_code"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false
+	}
+	return resp.ErrorCode != nil && *resp.ErrorCode == 0
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16])
+}
+
+// notModified 按条件请求头判断客户端缓存是否仍然有效
+// 同时携带 If-None-Match 与 If-Modified-Since 时只判断 If-None-Match
+func notModified(r *http.Request, header http.Header) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etagMatch(inm, header.Get("ETag"))
+	}
+
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	lastModified, err := http.ParseTime(header.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(ims)
+}
+
+// etagMatch 弱比较：忽略 W/ 前缀，If-None-Match 为 * 时匹配任意 ETag
+func etagMatch(ifNoneMatch, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
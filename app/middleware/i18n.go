@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/pkg/i18n"
+)
+
+// I18n 协商请求语言（lang 参数优先，其次 Accept-Language），
+// 并将对应的消息本地化器写入上下文，供 dto 本地化错误信息与验证消息
+func I18n(bundle *i18n.Bundle) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tag := bundle.Negotiate(c.GetHeader("Accept-Language"), c.Query("lang"))
+		c.Set(dto.LocalizerKey, bundle.Localizer(tag))
+		c.Next()
+	}
+}
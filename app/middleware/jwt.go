@@ -24,27 +24,8 @@ func NewJwtMiddleware(jwtService *services.JwtService) *JwtMiddleware {
 // JWTAuth 创建JWT认证中间件
 func (m *JwtMiddleware) JWTAuth(guardName string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tokenStr := c.Request.Header.Get("Authorization")
-		if tokenStr == "" {
-			dto.TokenFail(c)
-			c.Abort()
-			return
-		}
-		tokenStr = tokenStr[len(services.TokenType)+1:]
-
-		// Token 解析校验
-		token, err := jwt.ParseWithClaims(tokenStr, &services.CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-			return []byte(m.jwtService.GetSecret()), nil
-		})
-		if err != nil || !token.Valid || m.jwtService.IsInBlacklist(tokenStr) {
-			dto.TokenFail(c)
-			c.Abort()
-			return
-		}
-
-		claims := token.Claims.(*services.CustomClaims)
-		// Token 发布者校验
-		if claims.Issuer != guardName {
+		token, claims, ok := m.parseToken(c, guardName)
+		if !ok {
 			dto.TokenFail(c)
 			c.Abort()
 			return
@@ -65,3 +46,37 @@ func (m *JwtMiddleware) JWTAuth(guardName string) gin.HandlerFunc {
 		c.Set("id", claims.ID)
 	}
 }
+
+// OptionalAuth 创建可选认证中间件：携带有效 Token 时写入当前用户，否则按未登录继续处理（不续签）
+func (m *JwtMiddleware) OptionalAuth(guardName string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token, claims, ok := m.parseToken(c, guardName); ok {
+			c.Set("token", token)
+			c.Set("id", claims.ID)
+		}
+	}
+}
+
+// parseToken 解析并校验请求头中的 Token（签名、黑名单、发布者）
+func (m *JwtMiddleware) parseToken(c *gin.Context, guardName string) (*jwt.Token, *services.CustomClaims, bool) {
+	tokenStr := c.Request.Header.Get("Authorization")
+	if len(tokenStr) <= len(services.TokenType)+1 {
+		return nil, nil, false
+	}
+	tokenStr = tokenStr[len(services.TokenType)+1:]
+
+	// Token 解析校验
+	token, err := jwt.ParseWithClaims(tokenStr, &services.CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return []byte(m.jwtService.GetSecret()), nil
+	})
+	if err != nil || !token.Valid || m.jwtService.IsInBlacklist(tokenStr) {
+		return nil, nil, false
+	}
+
+	claims := token.Claims.(*services.CustomClaims)
+	// Token 发布者校验
+	if claims.Issuer != guardName {
+		return nil, nil, false
+	}
+	return token, claims, true
+}
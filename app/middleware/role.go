@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-web/app/dto"
+	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+)
+
+// RoleMiddleware 角色校验中间件依赖
+type RoleMiddleware struct {
+	userService *services.UserService
+}
+
+// NewRoleMiddleware 创建角色校验中间件实例
+func NewRoleMiddleware(userService *services.UserService) *RoleMiddleware {
+	return &RoleMiddleware{userService: userService}
+}
+
+// Require 要求当前用户拥有任一指定角色（需放在 JWTAuth 之后）
+func (m *RoleMiddleware) Require(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := m.userService.GetUserInfo(c.GetString("id"))
+		if err != nil || !user.HasRole(roles...) {
+			dto.FailByError(c, bizErr.ErrForbidden)
+			c.Abort()
+			return
+		}
+		c.Set("role", user.Role)
+	}
+}
@@ -9,6 +9,7 @@ type Category struct {
 	ID          uint      `json:"id" gorm:"primaryKey"`
 	Name        string    `json:"name" gorm:"size:100;not null;index" binding:"required"`
 	Description string    `json:"description" gorm:"type:text"`
+	ParentID    *uint     `json:"parent_id" gorm:"index"` // 父分类 ID，顶级分类为空
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
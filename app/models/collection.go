@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// Collection Mod 合集（玩家整理的有序 Mod 列表，可导出为启动器使用的整合包清单）
+type Collection struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	OwnerID       uint      `json:"owner_id" gorm:"not null;index"`
+	GameID        uint      `json:"game_id" gorm:"not null;index"` // 合集中的 Mod 均属于该游戏
+	Title         string    `json:"title" gorm:"size:100;not null"`
+	Description   string    `json:"description" gorm:"type:text"`
+	IsPublic      bool      `json:"is_public" gorm:"not null;default:true;index"` // 私有合集仅创建者可见
+	ItemCount     int       `json:"item_count" gorm:"not null;default:0"`
+	FollowerCount int       `json:"follower_count" gorm:"not null;default:0;index"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	Owner     *User            `json:"-" gorm:"foreignKey:OwnerID"`
+	Game      *Game            `json:"-" gorm:"foreignKey:GameID"`
+	Items     []CollectionItem `json:"-" gorm:"foreignKey:CollectionID"`
+	Followers []User           `json:"-" gorm:"many2many:gw_collection_followers;"`
+}
+
+// TableName 指定表名
+func (Collection) TableName() string {
+	return "collections"
+}
+
+// CanView 用户是否可以查看该合集
+func (c Collection) CanView(userID uint) bool {
+	return c.IsPublic || (userID != 0 && c.OwnerID == userID)
+}
+
+// CollectionItem 合集中的 Mod（按 Position 排序，可锁定发布版本）
+type CollectionItem struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	CollectionID uint      `json:"collection_id" gorm:"not null;uniqueIndex:uk_collection_mod,priority:1"`
+	ModID        uint      `json:"mod_id" gorm:"not null;uniqueIndex:uk_collection_mod,priority:2;index"`
+	ReleaseID    uint      `json:"release_id" gorm:"not null;default:0;index"` // 锁定的发布版本，0 表示跟随 Mod 当前版本
+	Position     int       `json:"position" gorm:"not null;default:0"`
+	Note         string    `json:"note" gorm:"size:500"` // 整理者备注（如安装说明）
+	CreatedAt    time.Time `json:"created_at"`
+
+	Mod     *Mod        `json:"-" gorm:"foreignKey:ModID"`
+	Release *ModRelease `json:"-" gorm:"foreignKey:ReleaseID"`
+}
+
+// TableName 指定表名
+func (CollectionItem) TableName() string {
+	return "collection_items"
+}
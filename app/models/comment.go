@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// 评论删除者
+const (
+	CommentDeletedByAuthor    = "author"    // 评论者本人删除
+	CommentDeletedByModerator = "moderator" // 审核员移除
+	CommentDeletedByReports   = "reports"   // 举报数达到阈值自动隐藏
+)
+
+// Comment Mod 评论
+// 回复统一挂在所属顶层评论（RootID）下按时间排列，ParentID 记录直接回复的评论；
+// 删除为软删除，只隐藏内容，保留线程结构
+type Comment struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	ModID      uint       `json:"mod_id" gorm:"not null;index:idx_comment_thread,priority:1"`
+	RootID     uint       `json:"root_id" gorm:"not null;default:0;index:idx_comment_thread,priority:2"` // 所属顶层评论，顶层评论为 0
+	ParentID   uint       `json:"parent_id" gorm:"not null;default:0"`                                   // 直接回复的评论，顶层评论为 0
+	UserID     uint       `json:"user_id" gorm:"not null;index"`
+	User       *User      `json:"-" gorm:"foreignKey:UserID"`
+	Parent     *Comment   `json:"-" gorm:"foreignKey:ParentID"`
+	Content    string     `json:"content" gorm:"type:text;not null"`
+	ReplyCount int        `json:"reply_count" gorm:"not null;default:0"` // 回复总数（仅顶层评论维护）
+	EditedAt   *time.Time `json:"edited_at"`                             // 最后编辑时间
+	DeletedAt  *time.Time `json:"deleted_at"`                            // 删除时间
+	DeletedBy  string     `json:"deleted_by" gorm:"size:20"`             // 删除者：author / moderator / reports
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// IsDeleted 评论是否已删除
+func (c Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
@@ -13,6 +13,8 @@ type Game struct {
 	CoverImage  string    `json:"cover_image" gorm:"size:500"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	Versions []GameVersion `json:"versions,omitempty" gorm:"foreignKey:GameID"` // 支持的游戏版本
 }
 
 // TableName 指定表名
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// GameVersion 游戏版本（Mod 及其发布版本据此声明兼容性）
+type GameVersion struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	GameID     uint       `json:"game_id" gorm:"not null;uniqueIndex:uk_game_version"`
+	Name       string     `json:"name" gorm:"size:50;not null;uniqueIndex:uk_game_version"` // 版本号，如 1.20.1
+	ReleasedAt *time.Time `json:"released_at"`                                              // 游戏版本发布时间
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (GameVersion) TableName() string {
+	return "game_versions"
+}
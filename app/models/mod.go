@@ -4,6 +4,15 @@ import (
 	"time"
 )
 
+// Mod 审核状态
+const (
+	ModStatusDraft    = "draft"    // 草稿，仅作者可见
+	ModStatusPending  = "pending"  // 待审核
+	ModStatusApproved = "approved" // 已通过，公开可见
+	ModStatusRejected = "rejected" // 已驳回
+	ModStatusHidden   = "hidden"   // 已下架
+)
+
 // Mod mod模型
 type Mod struct {
 	ID            uint    `json:"id" gorm:"primaryKey"`
@@ -13,14 +22,28 @@ type Mod struct {
 	Version       string  `json:"version" gorm:"size:50"`
 	DownloadURL   string  `json:"download_url" gorm:"size:500"`
 	ImageURL      string  `json:"image_url" gorm:"size:500"`
+	ThumbnailURL  string  `json:"thumbnail_url" gorm:"size:500"` // 第一张截图的缩略图（由截图处理任务维护）
 	Rating        float64 `json:"rating" gorm:"type:decimal(3,2);default:0"`
 	DownloadCount int     `json:"download_count" gorm:"default:0;index"`
+	ViewCount     int     `json:"view_count" gorm:"default:0;index"`
 	FileSize      int64   `json:"file_size" gorm:"default:0"`
+	TrendingScore float64 `json:"trending_score" gorm:"default:0;index"`                 // 热度分（由定时任务按时间衰减重新计算）
+	Status        string  `json:"status" gorm:"size:20;not null;default:approved;index"` // 审核状态（存量数据默认为已通过）
+	OwnerID       uint    `json:"owner_id" gorm:"index"`                                 // 作者（所有者）用户 ID，0 表示尚未关联用户
 
 	// 外键关联
-	GameID     uint       `json:"game_id" gorm:"not null;index"`
-	Game       Game       `json:"game" gorm:"foreignKey:GameID"`
-	Categories []Category `json:"categories" gorm:"many2many:gw_mod_categories;"`
+	Owner       *User      `json:"-" gorm:"foreignKey:OwnerID"`            // 作者账号
+	Maintainers []User     `json:"-" gorm:"many2many:gw_mod_maintainers;"` // 共同维护者
+	GameID      uint       `json:"game_id" gorm:"not null;index"`
+	Game        Game       `json:"game" gorm:"foreignKey:GameID"`
+	Categories  []Category `json:"categories" gorm:"many2many:gw_mod_categories;"`
+	Tags        []Tag      `json:"tags" gorm:"many2many:gw_mod_tags;"`
+
+	GameVersions []GameVersion `json:"game_versions" gorm:"many2many:gw_mod_game_versions;"` // 兼容的游戏版本
+	Releases     []ModRelease  `json:"releases,omitempty" gorm:"foreignKey:ModID"`           // 发布版本
+
+	Translations []ModTranslation `json:"-" gorm:"foreignKey:ModID"` // 名称与描述的翻译（仅用于建立检索索引）
+	Images       []ModImage       `json:"-" gorm:"foreignKey:ModID"` // 截图（详情接口只预加载处理完成的截图）
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -30,3 +53,19 @@ type Mod struct {
 func (Mod) TableName() string {
 	return "mods"
 }
+
+// CanEdit 用户是否可以修改该 Mod（作者或共同维护者，需预加载 Maintainers）
+func (m Mod) CanEdit(userID uint) bool {
+	if userID == 0 {
+		return false
+	}
+	if m.OwnerID == userID {
+		return true
+	}
+	for _, u := range m.Maintainers {
+		if u.ID.ID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+)
+
+// Mod 统计事件类型
+const (
+	StatEventDownload = "download"
+	StatEventView     = "view"
+)
+
+// ModDailyStat Mod 每日下载 / 浏览计数（由定时任务从统计事件汇总，用于热度计算与统计报表）
+type ModDailyStat struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	ModID       uint      `json:"mod_id" gorm:"not null;uniqueIndex:uk_mod_daily_stat"`
+	Date        time.Time `json:"date" gorm:"type:date;not null;uniqueIndex:uk_mod_daily_stat;index"`
+	Downloads   int64     `json:"downloads" gorm:"default:0"`
+	Views       int64     `json:"views" gorm:"default:0"`
+	UniqueUsers int64     `json:"unique_users" gorm:"default:0"` // 当天下载或浏览过的独立访客数
+}
+
+// TableName 指定表名
+func (ModDailyStat) TableName() string {
+	return "mod_daily_stats"
+}
+
+// ModVersionDailyStat Mod 各版本的每日下载计数（按下载时 Mod 的当前版本号汇总）
+type ModVersionDailyStat struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ModID     uint      `json:"mod_id" gorm:"not null;uniqueIndex:uk_mod_version_daily_stat"`
+	Version   string    `json:"version" gorm:"size:50;not null;uniqueIndex:uk_mod_version_daily_stat"`
+	Date      time.Time `json:"date" gorm:"type:date;not null;uniqueIndex:uk_mod_version_daily_stat;index"`
+	Downloads int64     `json:"downloads" gorm:"default:0"`
+}
+
+// TableName 指定表名
+func (ModVersionDailyStat) TableName() string {
+	return "mod_version_daily_stats"
+}
+
+// ModStatEvent 单次下载 / 浏览事件（原始数据，汇总后按保留期清理）
+type ModStatEvent struct {
+	ID        uint64    `json:"id" gorm:"primaryKey"`
+	ModID     uint      `json:"mod_id" gorm:"not null;index"`
+	Type      string    `json:"type" gorm:"size:10;not null"`
+	Version   string    `json:"version" gorm:"size:50"`           // 事件发生时 Mod 的当前版本
+	Visitor   string    `json:"-" gorm:"size:64;not null"`        // 访客标识摘要（登录用户按用户 ID，否则按 IP 与 User-Agent）
+	CreatedAt time.Time `json:"created_at" gorm:"not null;index"` // 汇总任务按该列增量扫描
+}
+
+// TableName 指定表名
+func (ModStatEvent) TableName() string {
+	return "mod_stat_events"
+}
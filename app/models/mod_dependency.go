@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+)
+
+// 依赖类型
+const (
+	DependencyRequired     = "required"     // 必需依赖
+	DependencyOptional     = "optional"     // 可选依赖
+	DependencyIncompatible = "incompatible" // 不兼容（不能同时安装）
+)
+
+// ModDependency Mod 依赖关系（ModID 依赖 / 不兼容 DependsOnID）
+type ModDependency struct {
+	ID                uint      `json:"id" gorm:"primaryKey"`
+	ModID             uint      `json:"mod_id" gorm:"not null;uniqueIndex:uk_mod_dependency"`
+	DependsOnID       uint      `json:"depends_on_id" gorm:"not null;uniqueIndex:uk_mod_dependency;index"`
+	Type              string    `json:"type" gorm:"size:20;not null;default:required"`
+	VersionConstraint string    `json:"version_constraint" gorm:"size:100"` // 版本约束，如 ">=2.2, <3"
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+
+	// 外键关联（删除 Mod 时级联删除相关依赖关系）
+	Mod       Mod `json:"-" gorm:"foreignKey:ModID;constraint:OnDelete:CASCADE"`
+	DependsOn Mod `json:"depends_on" gorm:"foreignKey:DependsOnID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName 指定表名
+func (ModDependency) TableName() string {
+	return "mod_dependencies"
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+// Mod 截图处理状态
+const (
+	ModImageStatusPending = "pending" // 已上传原图，等待生成缩略图与 WebP
+	ModImageStatusReady   = "ready"   // 处理完成，公开展示
+	ModImageStatusFailed  = "failed"  // 原图无法解码，需删除后重新上传
+)
+
+// ModImage Mod 截图（按 Position 升序排列）
+// 原图上传后由后台任务生成缩略图（JPEG / WebP）与大图（WebP），各文件的存储键均位于 StorageKey 目录下
+type ModImage struct {
+	ID          uint   `json:"id" gorm:"primaryKey"`
+	ModID       uint   `json:"mod_id" gorm:"not null;index:idx_mod_image_position"`
+	Position    int    `json:"position" gorm:"not null;default:0;index:idx_mod_image_position"`
+	Caption     string `json:"caption" gorm:"size:255"`
+	Status      string `json:"status" gorm:"size:20;not null;default:pending;index"`
+	Error       string `json:"error,omitempty" gorm:"size:255"` // 处理失败原因
+	StorageKey  string `json:"-" gorm:"size:255;not null"`      // 文件目录（如 mods/1/images/3f2a...）
+	OriginalKey string `json:"-" gorm:"size:255;not null"`      // 原图存储键
+	ContentType string `json:"content_type" gorm:"size:50"`
+	Size        int64  `json:"size"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+
+	URL              string `json:"url" gorm:"size:500"`                // 原图地址
+	ThumbnailURL     string `json:"thumbnail_url" gorm:"size:500"`      // 缩略图（JPEG）
+	ThumbnailWebPURL string `json:"thumbnail_webp_url" gorm:"size:500"` // 缩略图（WebP）
+	WebPURL          string `json:"webp_url" gorm:"size:500"`           // 大图（WebP）
+
+	UploadedBy uint      `json:"uploaded_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ModImage) TableName() string {
+	return "mod_images"
+}
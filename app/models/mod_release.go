@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+)
+
+// ModRelease Mod 发布版本
+type ModRelease struct {
+	ID           uint          `json:"id" gorm:"primaryKey"`
+	ModID        uint          `json:"mod_id" gorm:"not null;uniqueIndex:uk_mod_release"`
+	Version      string        `json:"version" gorm:"size:50;not null;uniqueIndex:uk_mod_release"`
+	Changelog    string        `json:"changelog" gorm:"type:text"`
+	DownloadURL  string        `json:"download_url" gorm:"size:500"`
+	FileSize     int64         `json:"file_size" gorm:"default:0"`
+	GameVersions []GameVersion `json:"game_versions" gorm:"many2many:gw_release_game_versions;"` // 兼容的游戏版本
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ModRelease) TableName() string {
+	return "mod_releases"
+}
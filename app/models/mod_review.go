@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+)
+
+// 审核操作
+const (
+	ReviewActionSubmit  = "submit"  // 作者提交审核
+	ReviewActionApprove = "approve" // 通过
+	ReviewActionReject  = "reject"  // 驳回
+	ReviewActionHide    = "hide"    // 下架
+)
+
+// ModReview Mod 审核记录（每次状态变更一条）
+type ModReview struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	ModID      uint      `json:"mod_id" gorm:"not null;index"`
+	OperatorID uint      `json:"operator_id" gorm:"not null;index"` // 操作人（审核员或提交审核的作者，0 表示系统自动操作）
+	Action     string    `json:"action" gorm:"size:20;not null"`
+	FromStatus string    `json:"from_status" gorm:"size:20;not null"`
+	ToStatus   string    `json:"to_status" gorm:"size:20;not null"`
+	Reason     string    `json:"reason" gorm:"size:500"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// TableName 指定表名
+func (ModReview) TableName() string {
+	return "mod_reviews"
+}
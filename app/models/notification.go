@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+)
+
+// 通知类型
+const (
+	NotificationModStatus = "mod_status" // Mod 审核状态变更
+)
+
+// Notification 站内通知
+type Notification struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	UserID    uint       `json:"user_id" gorm:"not null;index"`
+	Type      string     `json:"type" gorm:"size:50;not null"`
+	Title     string     `json:"title" gorm:"size:255;not null"`
+	Content   string     `json:"content" gorm:"type:text"`
+	RelatedID uint       `json:"related_id" gorm:"default:0"` // 关联对象 ID（如 Mod ID）
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TableName 指定表名
+func (Notification) TableName() string {
+	return "notifications"
+}
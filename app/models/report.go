@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// 举报对象类型
+const (
+	ReportTargetMod     = "mod"
+	ReportTargetComment = "comment"
+)
+
+// 举报原因
+const (
+	ReportReasonSpam          = "spam"          // 垃圾广告
+	ReportReasonMalicious     = "malicious"     // 恶意代码
+	ReportReasonBroken        = "broken"        // 无法使用 / 下载失效
+	ReportReasonInappropriate = "inappropriate" // 不当内容
+	ReportReasonCopyright     = "copyright"     // 侵权
+	ReportReasonOther         = "other"         // 其他
+)
+
+// 举报处理状态
+const (
+	ReportStatusPending   = "pending"   // 待处理
+	ReportStatusResolved  = "resolved"  // 已确认并处理
+	ReportStatusDismissed = "dismissed" // 已驳回
+)
+
+// Report 内容举报（同一用户对同一对象只能举报一次）
+type Report struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	TargetType string     `json:"target_type" gorm:"size:20;not null;uniqueIndex:uk_report_target_reporter,priority:1;index:idx_report_target,priority:1"`
+	TargetID   uint       `json:"target_id" gorm:"not null;uniqueIndex:uk_report_target_reporter,priority:2;index:idx_report_target,priority:2"`
+	ReporterID uint       `json:"reporter_id" gorm:"not null;uniqueIndex:uk_report_target_reporter,priority:3"`
+	Reason     string     `json:"reason" gorm:"size:20;not null"`
+	Details    string     `json:"details" gorm:"size:1000"`
+	Status     string     `json:"status" gorm:"size:20;not null;default:pending;index"`
+	HandlerID  uint       `json:"handler_id" gorm:"not null;default:0"` // 处理人
+	Note       string     `json:"note" gorm:"size:500"`                 // 处理说明
+	HandledAt  *time.Time `json:"handled_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Report) TableName() string {
+	return "reports"
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// Tag 标签模型（由用户为 Mod 建议，名称统一为小写）
+type Tag struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"size:50;not null;uniqueIndex"`
+	ModCount  int       `json:"mod_count" gorm:"default:0;index"` // 使用该标签的 Mod 数量
+	CreatedBy uint      `json:"created_by" gorm:"index"`          // 首次建议该标签的用户 ID
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (Tag) TableName() string {
+	return "tags"
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+)
+
+// 可翻译的实体类型
+const (
+	TranslationEntityGame     = "games"
+	TranslationEntityCategory = "categories"
+	TranslationEntityMod      = "mods"
+)
+
+// GameTranslation 游戏名称与描述的翻译（默认语言的内容保存在 games 表中）
+type GameTranslation struct {
+	ID          uint      `json:"-" gorm:"primaryKey"`
+	GameID      uint      `json:"game_id" gorm:"not null;uniqueIndex:idx_game_locale"`
+	Locale      string    `json:"locale" gorm:"size:20;not null;uniqueIndex:idx_game_locale;index"`
+	Name        string    `json:"name" gorm:"size:255;not null"`
+	Description string    `json:"description" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (GameTranslation) TableName() string {
+	return "game_translations"
+}
+
+// CategoryTranslation 分类名称与描述的翻译（默认语言的内容保存在 categories 表中）
+type CategoryTranslation struct {
+	ID          uint      `json:"-" gorm:"primaryKey"`
+	CategoryID  uint      `json:"category_id" gorm:"not null;uniqueIndex:idx_category_locale"`
+	Locale      string    `json:"locale" gorm:"size:20;not null;uniqueIndex:idx_category_locale;index"`
+	Name        string    `json:"name" gorm:"size:100;not null"`
+	Description string    `json:"description" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (CategoryTranslation) TableName() string {
+	return "category_translations"
+}
+
+// ModTranslation Mod 名称与描述的翻译（默认语言的内容保存在 mods 表中）
+type ModTranslation struct {
+	ID          uint      `json:"-" gorm:"primaryKey"`
+	ModID       uint      `json:"mod_id" gorm:"not null;uniqueIndex:idx_mod_locale"`
+	Locale      string    `json:"locale" gorm:"size:20;not null;uniqueIndex:idx_mod_locale;index"`
+	Name        string    `json:"name" gorm:"size:255;not null"`
+	Description string    `json:"description" gorm:"type:text"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ModTranslation) TableName() string {
+	return "mod_translations"
+}
@@ -4,12 +4,20 @@ import (
 	"strconv"
 )
 
+// 用户角色
+const (
+	RoleUser      = "user"      // 普通用户
+	RoleModerator = "moderator" // 审核员
+	RoleAdmin     = "admin"     // 管理员
+)
+
 // User 用户模型
 type User struct {
 	ID
 	Name     string `json:"name" gorm:"type:varchar(100);not null;comment:用户名称"`
 	Mobile   string `json:"mobile" gorm:"type:varchar(20);not null;uniqueIndex;comment:用户手机号"`
 	Password string `json:"-" gorm:"type:varchar(255);not null;comment:用户密码"`
+	Role     string `json:"role" gorm:"type:varchar(20);not null;default:user;comment:用户角色"`
 	Timestamps
 	SoftDeletes
 }
@@ -31,3 +39,16 @@ func (u User) MaskMobile() string {
 	}
 	return u.Mobile[:3] + "****" + u.Mobile[7:]
 }
+
+// HasRole 是否拥有任一指定角色（管理员拥有所有角色）
+func (u User) HasRole(roles ...string) bool {
+	if u.Role == RoleAdmin {
+		return true
+	}
+	for _, role := range roles {
+		if u.Role == role {
+			return true
+		}
+	}
+	return false
+}
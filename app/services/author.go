@@ -0,0 +1,197 @@
+package services
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// AuthorService 作者服务（作者主页及 Mod 作者、共同维护者管理）
+type AuthorService struct {
+	repo       repository.AuthorRepository
+	modRepo    repository.ModRepository
+	modService *ModService
+	log        *zap.Logger
+}
+
+// NewAuthorService 创建作者服务实例
+func NewAuthorService(
+	repo repository.AuthorRepository,
+	modRepo repository.ModRepository,
+	modService *ModService,
+	log *zap.Logger,
+) *AuthorService {
+	return &AuthorService{repo: repo, modRepo: modRepo, modService: modService, log: log}
+}
+
+// GetAuthorDetail 获取作者主页信息及聚合统计
+func (s *AuthorService) GetAuthorDetail(id uint) (*dto.AuthorDetailResponse, error) {
+	user, err := s.findUser(id)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.repo.Stats(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthorDetailResponse{
+		AuthorResponse: toAuthorResponse(*user),
+		Stats: dto.AuthorStatsResponse{
+			ModCount:       stats.ModCount,
+			TotalDownloads: stats.TotalDownloads,
+			TotalViews:     stats.TotalViews,
+			AverageRating:  stats.AverageRating,
+		},
+	}, nil
+}
+
+// SearchAuthorMods 搜索作者拥有或共同维护的 Mod（忽略请求中的 author_id，以 locale 指定的语言返回）
+func (s *AuthorService) SearchAuthorMods(id uint, req dto.ModSearchRequest, locale string) (*dto.ModListResponse, error) {
+	if _, err := s.findUser(id); err != nil {
+		return nil, err
+	}
+
+	req.AuthorID = id
+	return s.modService.SearchMods(req, locale)
+}
+
+// NegotiateLocale 协商响应语言（见 ModService.NegotiateLocale）
+func (s *AuthorService) NegotiateLocale(acceptLanguage, lang string) string {
+	return s.modService.NegotiateLocale(acceptLanguage, lang)
+}
+
+// AddMaintainer 作者添加共同维护者
+func (s *AuthorService) AddMaintainer(modID, ownerID uint, req dto.ModMaintainerRequest) (*dto.ModMaintainersResponse, error) {
+	mod, err := s.findOwnedMod(modID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == mod.OwnerID {
+		return nil, bizErr.ErrMaintainerIsOwner
+	}
+	if _, err := s.findUser(req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.AddMaintainer(modID, req.UserID); err != nil {
+		s.log.Error("add mod maintainer failed", zap.Uint("mod_id", modID), zap.Uint("user_id", req.UserID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "添加共同维护者失败")
+	}
+	return s.modMaintainers(modID)
+}
+
+// RemoveMaintainer 移除共同维护者（作者可以移除任意维护者，维护者可以主动退出）
+func (s *AuthorService) RemoveMaintainer(modID, operatorID, userID uint) (*dto.ModMaintainersResponse, error) {
+	mod, err := s.modRepo.FindByID(modID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+	if operatorID != userID && mod.OwnerID != operatorID {
+		return nil, bizErr.ErrNotModOwner
+	}
+	if userID == mod.OwnerID || !mod.CanEdit(userID) {
+		return nil, bizErr.ErrMaintainerNotFound
+	}
+
+	if err := s.repo.RemoveMaintainer(modID, userID); err != nil {
+		s.log.Error("remove mod maintainer failed", zap.Uint("mod_id", modID), zap.Uint("user_id", userID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "移除共同维护者失败")
+	}
+	return s.modMaintainers(modID)
+}
+
+// SetOwner 管理员变更 Mod 作者（用于处理迁移时未能自动关联的 Mod）
+func (s *AuthorService) SetOwner(modID uint, req dto.ModOwnerRequest) (*dto.ModMaintainersResponse, error) {
+	if _, err := s.modRepo.FindByID(modID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+	if _, err := s.findUser(req.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.SetOwner(modID, req.UserID); err != nil {
+		s.log.Error("set mod owner failed", zap.Uint("mod_id", modID), zap.Uint("user_id", req.UserID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "变更作者失败")
+	}
+	return s.modMaintainers(modID)
+}
+
+// findUser 查询用户，不存在时返回 ErrUserNotFound
+func (s *AuthorService) findUser(id uint) (*models.User, error) {
+	user, err := s.repo.FindUser(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bizErr.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// findOwnedMod 查询 Mod 并校验操作者为作者本人
+func (s *AuthorService) findOwnedMod(modID, userID uint) (*models.Mod, error) {
+	mod, err := s.modRepo.FindByID(modID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+	if userID == 0 || mod.OwnerID != userID {
+		return nil, bizErr.ErrNotModOwner
+	}
+	return mod, nil
+}
+
+// modMaintainers 返回 Mod 当前的作者及共同维护者
+func (s *AuthorService) modMaintainers(modID uint) (*dto.ModMaintainersResponse, error) {
+	mod, err := s.modRepo.FindByID(modID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+	return &dto.ModMaintainersResponse{
+		ModID:       mod.ID,
+		Owner:       toOwnerResponse(mod.Owner),
+		Maintainers: toAuthorResponses(mod.Maintainers),
+	}, nil
+}
+
+// findEditableMod 查询 Mod 并校验操作者为作者或共同维护者（所有 Mod 写操作共用）
+func findEditableMod(repo repository.ModRepository, modID, userID uint) (*models.Mod, error) {
+	mod, err := repo.FindByID(modID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+	if !mod.CanEdit(userID) {
+		return nil, bizErr.ErrNotModEditor
+	}
+	return mod, nil
+}
+
+// toAuthorResponse 转换为作者信息（不包含手机号等隐私字段）
+func toAuthorResponse(user models.User) dto.AuthorResponse {
+	return dto.AuthorResponse{ID: user.ID.ID, Name: user.Name}
+}
+
+// toOwnerResponse 转换作者信息，未关联用户时返回 nil
+func toOwnerResponse(user *models.User) *dto.AuthorResponse {
+	if user == nil {
+		return nil
+	}
+	resp := toAuthorResponse(*user)
+	return &resp
+}
+
+// toAuthorResponses 批量转换作者信息
+func toAuthorResponses(users []models.User) []dto.AuthorResponse {
+	result := make([]dto.AuthorResponse, len(users))
+	for i, user := range users {
+		result[i] = toAuthorResponse(user)
+	}
+	return result
+}
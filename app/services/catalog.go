@@ -0,0 +1,620 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/amqp/event"
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	"gin-web/pkg/catalog"
+	bizErr "gin-web/pkg/errors"
+)
+
+// 目录数据类型
+const (
+	CatalogGames      = "games"
+	CatalogCategories = "categories"
+	CatalogMods       = "mods"
+)
+
+const (
+	// maxCatalogRows 单次导入的最大行数
+	maxCatalogRows = 10000
+	// catalogExportBatchSize 导出 Mod 时每批查询的数量
+	catalogExportBatchSize = 500
+)
+
+// catalogColumns 各数据类型的列（导出与导入使用相同的列，导出文件可直接重新导入）
+// 游戏、分类以 name 为自然键，Mod 以 game（游戏名称）+ name 为自然键；
+// 分类的 parent 与 Mod 的 categories 均按名称引用
+var catalogColumns = map[string][]string{
+	CatalogGames:      {"name", "english_name", "description", "cover_image"},
+	CatalogCategories: {"name", "parent", "description"},
+	CatalogMods: {"game", "name", "author", "version", "description", "download_url", "image_url",
+		"file_size", "rating", "download_count", "view_count", "status", "categories"},
+}
+
+// CatalogService 目录数据（游戏、分类、Mod）批量导入导出服务
+type CatalogService struct {
+	repo   repository.CatalogRepository
+	events ModEventPublisher
+	log    *zap.Logger
+}
+
+// NewCatalogService 创建目录数据服务实例
+// events 为空时导入 Mod 后不触发检索索引重建
+func NewCatalogService(repo repository.CatalogRepository, events ModEventPublisher, log *zap.Logger) *CatalogService {
+	return &CatalogService{repo: repo, events: events, log: log}
+}
+
+// CatalogFormat 确定文件格式：优先使用指定的格式名称，否则按文件名扩展名推断
+func CatalogFormat(name, filename string) (catalog.Format, error) {
+	var format catalog.Format
+	var err error
+	if name != "" {
+		format, err = catalog.ParseFormat(name)
+	} else {
+		format, err = catalog.FormatFromFilename(filename)
+	}
+	if err != nil {
+		return "", bizErr.ErrCatalogFormatInvalid
+	}
+	return format, nil
+}
+
+// catalogPlan 导入计划：校验通过的行转换为待执行的写操作
+type catalogPlan struct {
+	resp    *dto.CatalogImportResponse
+	changes []func(tx repository.CatalogRepository) error
+}
+
+// accept 汇总一行的校验结果，返回该行是否通过
+func (p *catalogPlan) accept(check *rowCheck) bool {
+	if len(check.errors) == 0 {
+		return true
+	}
+	p.resp.Failed++
+	p.resp.Errors = append(p.resp.Errors, check.errors...)
+	return false
+}
+
+// add 记录一个写操作，id 为 0 表示新建
+func (p *catalogPlan) add(id uint, change func(tx repository.CatalogRepository) error) {
+	if id == 0 {
+		p.resp.Created++
+	} else {
+		p.resp.Updated++
+	}
+	p.changes = append(p.changes, change)
+}
+
+// Import 导入目录数据，按自然键新建或更新
+// 先校验全部行，任何一行出错时不写入任何数据；dryRun 时只返回校验结果
+func (s *CatalogService) Import(entity string, format catalog.Format, r io.Reader, dryRun bool) (*dto.CatalogImportResponse, error) {
+	if _, ok := catalogColumns[entity]; !ok {
+		return nil, bizErr.ErrCatalogEntityInvalid
+	}
+
+	records, err := catalog.Read(r, format)
+	if err != nil {
+		if errors.Is(err, catalog.ErrUnknownFormat) {
+			return nil, bizErr.ErrCatalogFormatInvalid
+		}
+		return nil, bizErr.Wrap(err, bizErr.CodeCatalogFileInvalid, "导入文件格式错误")
+	}
+	if len(records) > maxCatalogRows {
+		return nil, bizErr.ErrCatalogTooLarge
+	}
+
+	plan := &catalogPlan{resp: &dto.CatalogImportResponse{
+		Entity: entity,
+		DryRun: dryRun,
+		Total:  len(records),
+		Errors: []dto.CatalogRowError{},
+	}}
+	switch entity {
+	case CatalogGames:
+		err = s.planGames(plan, records)
+	case CatalogCategories:
+		err = s.planCategories(plan, records)
+	case CatalogMods:
+		err = s.planMods(plan, records)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if dryRun || plan.resp.Failed > 0 || len(plan.changes) == 0 {
+		return plan.resp, nil
+	}
+
+	err = s.repo.Transaction(func(tx repository.CatalogRepository) error {
+		for _, change := range plan.changes {
+			if err := change(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.log.Error("import catalog failed", zap.String("entity", entity), zap.Int("rows", len(records)), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "导入失败")
+	}
+	plan.resp.Applied = true
+
+	s.log.Info("catalog imported",
+		zap.String("entity", entity),
+		zap.Int("created", plan.resp.Created),
+		zap.Int("updated", plan.resp.Updated))
+	if entity == CatalogMods {
+		s.publishReindex()
+	}
+	return plan.resp, nil
+}
+
+// planGames 校验游戏数据
+func (s *CatalogService) planGames(plan *catalogPlan, records []catalog.Record) error {
+	games, err := s.repo.FindGames()
+	if err != nil {
+		return err
+	}
+	index := catalogNameIndex{}
+	for _, game := range games {
+		index.add(game.Name, game.ID)
+	}
+
+	seen := make(map[string]int, len(records))
+	for _, record := range records {
+		check := &rowCheck{record: record}
+		game := &models.Game{
+			Name:        check.text("name", 255, true),
+			EnglishName: check.text("english_name", 255, false),
+			Description: check.text("description", 0, false),
+			CoverImage:  check.text("cover_image", 500, false),
+		}
+		check.unique(seen, game.Name, "name")
+		game.ID = check.match(index, game.Name, "name", "游戏")
+		if !plan.accept(check) {
+			continue
+		}
+
+		columns := check.columns(CatalogGames, map[string]string{
+			"english_name": "english_name",
+			"description":  "description",
+			"cover_image":  "cover_image",
+		})
+		plan.add(game.ID, func(tx repository.CatalogRepository) error {
+			return tx.SaveGame(game, columns)
+		})
+	}
+	return nil
+}
+
+// planCategories 校验分类数据
+// 父分类须已存在或在文件中更早的行定义，且不能形成循环
+func (s *CatalogService) planCategories(plan *catalogPlan, records []catalog.Record) error {
+	categories, err := s.repo.FindCategories()
+	if err != nil {
+		return err
+	}
+	index := catalogNameIndex{}
+	nameByID := make(map[uint]string, len(categories))
+	for _, category := range categories {
+		index.add(category.Name, category.ID)
+		nameByID[category.ID] = category.Name
+	}
+	parentOf := make(map[string]string, len(categories)) // 分类名称 → 父分类名称
+	for _, category := range categories {
+		if category.ParentID != nil {
+			parentOf[category.Name] = nameByID[*category.ParentID]
+		}
+	}
+
+	created := make(map[string]*models.Category) // 本次新建的分类，写入后才有 ID
+	seen := make(map[string]int, len(records))
+	for _, record := range records {
+		check := &rowCheck{record: record}
+		category := &models.Category{
+			Name:        check.text("name", 100, true),
+			Description: check.text("description", 0, false),
+		}
+		parentName := check.text("parent", 100, false)
+		check.unique(seen, category.Name, "name")
+		category.ID = check.match(index, category.Name, "name", "分类")
+
+		var parent *models.Category
+		if parentName != "" && category.Name != "" {
+			if isCategoryAncestor(parentOf, category.Name, parentName) {
+				check.fail("parent", "父分类不能是自身或子孙分类")
+			} else if _, ok := index.lookup(parentName); ok {
+				if id := check.match(index, parentName, "parent", "父分类"); id != 0 {
+					parent = &models.Category{ID: id}
+				}
+			} else if parent = created[parentName]; parent == nil {
+				check.fail("parent", "父分类不存在（新建的父分类须在子分类之前的行中定义）")
+			}
+		}
+		if !plan.accept(check) {
+			continue
+		}
+
+		if record.Has("parent") {
+			if parentName == "" {
+				delete(parentOf, category.Name)
+			} else {
+				parentOf[category.Name] = parentName
+			}
+		}
+		if category.ID == 0 {
+			created[category.Name] = category
+		}
+
+		columns := check.columns(CatalogCategories, map[string]string{
+			"parent":      "parent_id",
+			"description": "description",
+		})
+		plan.add(category.ID, func(tx repository.CatalogRepository) error {
+			if parent != nil {
+				parentID := parent.ID
+				category.ParentID = &parentID
+			}
+			return tx.SaveCategory(category, columns)
+		})
+	}
+	return nil
+}
+
+// planMods 校验 Mod 数据（游戏与分类须已存在），新建的 Mod 未指定状态时为已通过
+func (s *CatalogService) planMods(plan *catalogPlan, records []catalog.Record) error {
+	games, err := s.repo.FindGames()
+	if err != nil {
+		return err
+	}
+	gameIndex := catalogNameIndex{}
+	for _, game := range games {
+		gameIndex.add(game.Name, game.ID)
+	}
+	categories, err := s.repo.FindCategories()
+	if err != nil {
+		return err
+	}
+	categoryIndex := catalogNameIndex{}
+	for _, category := range categories {
+		categoryIndex.add(category.Name, category.ID)
+	}
+	keys, err := s.repo.FindModKeys()
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]int, len(records))
+	for _, record := range records {
+		check := &rowCheck{record: record}
+		mod := &models.Mod{
+			Name:          check.text("name", 255, true),
+			Author:        check.text("author", 100, false),
+			Version:       check.text("version", 50, false),
+			Description:   check.text("description", 0, false),
+			DownloadURL:   check.text("download_url", 500, false),
+			ImageURL:      check.text("image_url", 500, false),
+			FileSize:      check.count("file_size"),
+			Rating:        check.rating("rating"),
+			DownloadCount: int(check.count("download_count")),
+			ViewCount:     int(check.count("view_count")),
+			Status:        check.text("status", 20, false),
+		}
+		if mod.Status != "" && !isModStatus(mod.Status) {
+			check.fail("status", "审核状态只能是 draft、pending、approved、rejected 或 hidden")
+		}
+
+		gameName := check.text("game", 255, true)
+		if gameName != "" {
+			if _, ok := gameIndex.lookup(gameName); !ok {
+				check.fail("game", "游戏不存在")
+			} else {
+				mod.GameID = check.match(gameIndex, gameName, "game", "游戏")
+			}
+		}
+
+		var modCategories []models.Category
+		if record.Has("categories") {
+			modCategories = []models.Category{}
+			for _, name := range record.List("categories") {
+				if _, ok := categoryIndex.lookup(name); !ok {
+					check.fail("categories", fmt.Sprintf("分类 %s 不存在", name))
+				} else if id := check.match(categoryIndex, name, "categories", "分类"); id != 0 {
+					modCategories = append(modCategories, models.Category{ID: id})
+				}
+			}
+		}
+
+		if mod.GameID != 0 && mod.Name != "" {
+			check.unique(seen, gameName+"\x00"+mod.Name, "name")
+		}
+		if !plan.accept(check) {
+			continue
+		}
+
+		mod.ID = keys[repository.ModKey{GameID: mod.GameID, Name: mod.Name}]
+		if mod.ID == 0 && mod.Status == "" {
+			mod.Status = models.ModStatusApproved
+		}
+		columns := check.columns(CatalogMods, map[string]string{
+			"author":         "author",
+			"version":        "version",
+			"description":    "description",
+			"download_url":   "download_url",
+			"image_url":      "image_url",
+			"file_size":      "file_size",
+			"rating":         "rating",
+			"download_count": "download_count",
+			"view_count":     "view_count",
+		})
+		if mod.Status != "" {
+			columns = append(columns, "status")
+		}
+		plan.add(mod.ID, func(tx repository.CatalogRepository) error {
+			return tx.SaveMod(mod, columns, modCategories)
+		})
+	}
+	return nil
+}
+
+// Export 按格式导出目录数据
+func (s *CatalogService) Export(entity string, format catalog.Format, w io.Writer) error {
+	columns, ok := catalogColumns[entity]
+	if !ok {
+		return bizErr.ErrCatalogEntityInvalid
+	}
+	writer, err := catalog.NewWriter(w, format, columns)
+	if err != nil {
+		if errors.Is(err, catalog.ErrUnknownFormat) {
+			return bizErr.ErrCatalogFormatInvalid
+		}
+		return err
+	}
+
+	switch entity {
+	case CatalogGames:
+		err = s.exportGames(writer)
+	case CatalogCategories:
+		err = s.exportCategories(writer)
+	case CatalogMods:
+		err = s.exportMods(writer)
+	}
+	if err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+func (s *CatalogService) exportGames(writer *catalog.Writer) error {
+	games, err := s.repo.FindGames()
+	if err != nil {
+		return err
+	}
+	for _, game := range games {
+		if err := writer.Write(game.Name, game.EnglishName, game.Description, game.CoverImage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *CatalogService) exportCategories(writer *catalog.Writer) error {
+	categories, err := s.repo.FindCategories()
+	if err != nil {
+		return err
+	}
+	nameByID := make(map[uint]string, len(categories))
+	for _, category := range categories {
+		nameByID[category.ID] = category.Name
+	}
+	for _, category := range categoriesParentFirst(categories) {
+		var parent string
+		if category.ParentID != nil {
+			parent = nameByID[*category.ParentID]
+		}
+		if err := writer.Write(category.Name, parent, category.Description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *CatalogService) exportMods(writer *catalog.Writer) error {
+	return s.repo.EachMod(catalogExportBatchSize, func(mods []models.Mod) error {
+		for _, mod := range mods {
+			names := make([]string, len(mod.Categories))
+			for i, category := range mod.Categories {
+				names[i] = category.Name
+			}
+			err := writer.Write(mod.Game.Name, mod.Name, mod.Author, mod.Version, mod.Description,
+				mod.DownloadURL, mod.ImageURL, mod.FileSize, mod.Rating, mod.DownloadCount, mod.ViewCount,
+				mod.Status, names)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// publishReindex 导入 Mod 后触发检索索引全量重建（失败只记录日志，可通过 cmd/reindex 修复）
+func (s *CatalogService) publishReindex() {
+	if s.events == nil {
+		return
+	}
+	if err := s.events.PublishModEvent(event.NewModEvent(event.ModReindex, 0)); err != nil {
+		s.log.Warn("publish reindex event failed", zap.Error(err))
+	}
+}
+
+// catalogNameIndex 名称 → ID 索引，重名的记录无法作为自然键匹配（ID 记为 0）
+type catalogNameIndex map[string]uint
+
+func (idx catalogNameIndex) add(name string, id uint) {
+	if _, ok := idx[name]; ok {
+		idx[name] = 0
+		return
+	}
+	idx[name] = id
+}
+
+// lookup 查询名称对应的 ID，重名时返回 0 和 true
+func (idx catalogNameIndex) lookup(name string) (uint, bool) {
+	id, ok := idx[name]
+	return id, ok
+}
+
+// rowCheck 单行校验，收集该行的所有错误
+type rowCheck struct {
+	record catalog.Record
+	errors []dto.CatalogRowError
+}
+
+func (c *rowCheck) fail(field, message string) {
+	c.errors = append(c.errors, dto.CatalogRowError{Row: c.record.Row, Field: field, Message: message})
+}
+
+// text 读取文本列并校验必填与长度（max 为 0 时不限长度）
+func (c *rowCheck) text(field string, max int, required bool) string {
+	value := c.record.Get(field)
+	if required && value == "" {
+		c.fail(field, "不能为空")
+	}
+	if max > 0 && utf8.RuneCountInString(value) > max {
+		c.fail(field, fmt.Sprintf("不能超过%d个字符", max))
+	}
+	return value
+}
+
+// count 读取非负整数列，空值视为 0
+func (c *rowCheck) count(field string) int64 {
+	value := c.record.Get(field)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		c.fail(field, "必须是非负整数")
+		return 0
+	}
+	return n
+}
+
+// rating 读取 0~5 的评分，空值视为 0
+func (c *rowCheck) rating(field string) float64 {
+	value := c.record.Get(field)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 || n > 5 {
+		c.fail(field, "必须是 0 到 5 之间的数字")
+		return 0
+	}
+	return n
+}
+
+// unique 校验自然键在文件内不重复
+func (c *rowCheck) unique(seen map[string]int, key, field string) {
+	if key == "" {
+		return
+	}
+	if row, ok := seen[key]; ok {
+		c.fail(field, fmt.Sprintf("与第 %d 行重复", row))
+		return
+	}
+	seen[key] = c.record.Row
+}
+
+// match 按名称匹配已有记录的 ID（不存在时返回 0），重名时记录错误
+func (c *rowCheck) match(index catalogNameIndex, name, field, label string) uint {
+	id, ok := index.lookup(name)
+	if ok && id == 0 {
+		c.fail(field, fmt.Sprintf("存在多个同名%s，无法按名称匹配", label))
+	}
+	return id
+}
+
+// columns 文件中提供的列对应的数据库列（按 catalogColumns 的顺序，更新时只写入这些列）
+func (c *rowCheck) columns(entity string, mapping map[string]string) []string {
+	var columns []string
+	for _, field := range catalogColumns[entity] {
+		if column, ok := mapping[field]; ok && c.record.Has(field) {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// isCategoryAncestor name 是否为 category 的祖先（或 category 本身）
+func isCategoryAncestor(parentOf map[string]string, name, category string) bool {
+	for i, current := 0, category; i <= len(parentOf); i++ {
+		if current == name {
+			return true
+		}
+		parent, ok := parentOf[current]
+		if !ok {
+			return false
+		}
+		current = parent
+	}
+	return false
+}
+
+// categoriesParentFirst 按父分类在前的顺序排列分类
+func categoriesParentFirst(categories []models.Category) []models.Category {
+	ids := make(map[uint]bool, len(categories))
+	for _, category := range categories {
+		ids[category.ID] = true
+	}
+	children := make(map[uint][]models.Category)
+	var roots []models.Category
+	for _, category := range categories {
+		if category.ParentID == nil || !ids[*category.ParentID] {
+			roots = append(roots, category)
+		} else {
+			children[*category.ParentID] = append(children[*category.ParentID], category)
+		}
+	}
+
+	sorted := make([]models.Category, 0, len(categories))
+	visited := make(map[uint]bool, len(categories))
+	var visit func(category models.Category)
+	visit = func(category models.Category) {
+		if visited[category.ID] {
+			return
+		}
+		visited[category.ID] = true
+		sorted = append(sorted, category)
+		for _, child := range children[category.ID] {
+			visit(child)
+		}
+	}
+	for _, category := range roots {
+		visit(category)
+	}
+	// 数据异常形成循环的分类追加在最后，避免丢失
+	for _, category := range categories {
+		visit(category)
+	}
+	return sorted
+}
+
+// isModStatus 是否为有效的审核状态
+func isModStatus(status string) bool {
+	switch status {
+	case models.ModStatusDraft, models.ModStatusPending, models.ModStatusApproved,
+		models.ModStatusRejected, models.ModStatusHidden:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,569 @@
+package services
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+const (
+	// maxCollectionItems 合集中的 Mod 数量上限
+	maxCollectionItems = 500
+	// CollectionManifestVersion 整合包清单格式版本，字段结构变化时递增
+	CollectionManifestVersion = 1
+)
+
+// 整合包清单中 Mod 的来源
+const (
+	ManifestSourceCollection = "collection" // 合集中的 Mod
+	ManifestSourceDependency = "dependency" // 合集中 Mod 的依赖
+)
+
+// CollectionService Mod 合集服务
+type CollectionService struct {
+	repo        repository.CollectionRepository
+	modRepo     repository.ModRepository
+	releaseRepo repository.ModReleaseRepository
+	depRepo     repository.ModDependencyRepository
+	log         *zap.Logger
+}
+
+// NewCollectionService 创建合集服务实例
+func NewCollectionService(
+	repo repository.CollectionRepository,
+	modRepo repository.ModRepository,
+	releaseRepo repository.ModReleaseRepository,
+	depRepo repository.ModDependencyRepository,
+	log *zap.Logger,
+) *CollectionService {
+	return &CollectionService{
+		repo:        repo,
+		modRepo:     modRepo,
+		releaseRepo: releaseRepo,
+		depRepo:     depRepo,
+		log:         log,
+	}
+}
+
+// ListCollections 分页查询公开合集；查询自己创建的合集时包含私有合集
+func (s *CollectionService) ListCollections(req dto.CollectionListRequest, userID uint) (*dto.CollectionListResponse, error) {
+	return s.search(repository.CollectionCriteria{
+		GameID:         req.GameID,
+		OwnerID:        req.OwnerID,
+		IncludePrivate: req.OwnerID != 0 && req.OwnerID == userID,
+		SortBy:         req.SortBy,
+	}, req.Page, req.PageSize)
+}
+
+// ListFollowed 分页查询用户关注的合集（已转为私有的合集不再显示）
+func (s *CollectionService) ListFollowed(req dto.CollectionListRequest, userID uint) (*dto.CollectionListResponse, error) {
+	return s.search(repository.CollectionCriteria{
+		GameID:     req.GameID,
+		FollowerID: userID,
+		SortBy:     repository.CollectionSortUpdated,
+	}, req.Page, req.PageSize)
+}
+
+// CreateCollection 创建合集
+func (s *CollectionService) CreateCollection(userID uint, req dto.CollectionCreateRequest) (*dto.CollectionDetailResponse, error) {
+	if _, err := s.modRepo.FindGameByID(req.GameID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrGameNotFound)
+	}
+
+	items, err := s.buildItems(req.GameID, req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := &models.Collection{
+		OwnerID:     userID,
+		GameID:      req.GameID,
+		Title:       strings.TrimSpace(req.Title),
+		Description: req.Description,
+		IsPublic:    req.IsPublic == nil || *req.IsPublic,
+		Items:       items,
+	}
+	if err := s.repo.Create(collection); err != nil {
+		s.log.Error("create collection failed", zap.Uint("user_id", userID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "创建合集失败")
+	}
+	return s.GetCollection(collection.ID, userID)
+}
+
+// GetCollection 获取合集详情（私有合集仅创建者可见）
+func (s *CollectionService) GetCollection(id, userID uint) (*dto.CollectionDetailResponse, error) {
+	collection, err := s.findVisible(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.CollectionDetailResponse{
+		CollectionResponse: toCollectionResponse(*collection),
+		Items:              make([]dto.CollectionItemResponse, len(collection.Items)),
+	}
+	for i, item := range collection.Items {
+		resp.Items[i] = toCollectionItemResponse(item)
+	}
+	if userID != 0 {
+		following, err := s.repo.IsFollowing(id, userID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Following = following
+	}
+	return resp, nil
+}
+
+// UpdateCollection 更新合集基本信息（仅创建者）
+func (s *CollectionService) UpdateCollection(id, userID uint, req dto.CollectionUpdateRequest) (*dto.CollectionDetailResponse, error) {
+	collection, err := s.findOwn(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	collection.Title = strings.TrimSpace(req.Title)
+	collection.Description = req.Description
+	if req.IsPublic != nil {
+		collection.IsPublic = *req.IsPublic
+	}
+	if err := s.repo.Update(collection); err != nil {
+		s.log.Error("update collection failed", zap.Uint("collection_id", id), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "更新合集失败")
+	}
+	return s.GetCollection(id, userID)
+}
+
+// DeleteCollection 删除合集（仅创建者）
+func (s *CollectionService) DeleteCollection(id, userID uint) error {
+	if _, err := s.findOwn(id, userID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(id); err != nil {
+		s.log.Error("delete collection failed", zap.Uint("collection_id", id), zap.Error(err))
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除合集失败")
+	}
+	return nil
+}
+
+// ReplaceItems 按给定顺序替换合集的 Mod 列表（仅创建者）
+func (s *CollectionService) ReplaceItems(id, userID uint, req dto.CollectionItemsRequest) (*dto.CollectionDetailResponse, error) {
+	collection, err := s.findOwn(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.buildItems(collection.GameID, req.Items)
+	if err != nil {
+		return nil, err
+	}
+	return s.saveItems(collection, items, userID)
+}
+
+// AddItem 将 Mod 添加到合集末尾；Mod 已在合集中时更新锁定版本与备注（仅创建者）
+func (s *CollectionService) AddItem(id, userID uint, req dto.CollectionItemAddRequest) (*dto.CollectionDetailResponse, error) {
+	collection, err := s.findOwn(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := s.buildItems(collection.GameID, []dto.CollectionItemRequest{req.CollectionItemRequest})
+	if err != nil {
+		return nil, err
+	}
+
+	items := collection.Items
+	replaced := false
+	for i := range items {
+		if items[i].ModID == req.ModID {
+			items[i].ReleaseID = added[0].ReleaseID
+			items[i].Note = added[0].Note
+			replaced = true
+		}
+	}
+	if !replaced {
+		if len(items) >= maxCollectionItems {
+			return nil, bizErr.ErrCollectionTooLarge
+		}
+		items = append(items, added[0])
+	}
+	return s.saveItems(collection, items, userID)
+}
+
+// RemoveItem 从合集中移除 Mod（仅创建者）
+func (s *CollectionService) RemoveItem(id, modID, userID uint) (*dto.CollectionDetailResponse, error) {
+	collection, err := s.findOwn(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.CollectionItem, 0, len(collection.Items))
+	for _, item := range collection.Items {
+		if item.ModID != modID {
+			items = append(items, item)
+		}
+	}
+	if len(items) == len(collection.Items) {
+		return nil, bizErr.ErrCollectionItemNotFound
+	}
+	return s.saveItems(collection, items, userID)
+}
+
+// Follow 关注合集（重复关注不报错）
+func (s *CollectionService) Follow(id, userID uint) (*dto.CollectionFollowResponse, error) {
+	collection, err := s.findVisible(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Follow(id, userID)
+	if err != nil {
+		s.log.Error("follow collection failed", zap.Uint("collection_id", id), zap.Uint("user_id", userID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "关注合集失败")
+	}
+	count := collection.FollowerCount
+	if created {
+		count++
+	}
+	return &dto.CollectionFollowResponse{Following: true, FollowerCount: count}, nil
+}
+
+// Unfollow 取消关注合集（未关注时不报错）
+func (s *CollectionService) Unfollow(id, userID uint) (*dto.CollectionFollowResponse, error) {
+	collection, err := s.find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.repo.Unfollow(id, userID)
+	if err != nil {
+		s.log.Error("unfollow collection failed", zap.Uint("collection_id", id), zap.Uint("user_id", userID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "取消关注失败")
+	}
+	count := collection.FollowerCount
+	if removed && count > 0 {
+		count--
+	}
+	return &dto.CollectionFollowResponse{Following: false, FollowerCount: count}, nil
+}
+
+// ResolveDependencies 合并合集中所有 Mod 的依赖，按拓扑序返回安装集合
+// 合集锁定的版本参与版本约束校验；冲突不会中断解析，而是在结果中列出
+func (s *CollectionService) ResolveDependencies(id, userID uint, includeOptional bool) (*dto.CollectionResolveResponse, error) {
+	collection, err := s.findVisible(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	install, err := s.resolve(collection, includeOptional)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.CollectionResolveResponse{
+		CollectionID: collection.ID,
+		InstallOrder: make([]dto.CollectionInstallItemResponse, len(install.order)),
+		Conflicts:    install.conflicts,
+		Unavailable:  install.unavailable,
+	}
+	for i, id := range install.order {
+		mod := install.graph.nodes[id]
+		_, pinned := install.graph.pinned[id]
+		resp.InstallOrder[i] = dto.CollectionInstallItemResponse{
+			ID:           id,
+			Name:         mod.Name,
+			Version:      install.graph.version(id),
+			Pinned:       pinned,
+			InCollection: install.items[id] != nil,
+			Optional:     !install.required[id],
+		}
+	}
+	if resp.Conflicts == nil {
+		resp.Conflicts = []string{}
+	}
+	if resp.Unavailable == nil {
+		resp.Unavailable = []uint{}
+	}
+	return resp, nil
+}
+
+// Manifest 生成启动器使用的整合包清单（按安装顺序，包含依赖）
+// 安装集合存在冲突时返回错误，避免启动器安装无法运行的组合
+func (s *CollectionService) Manifest(id, userID uint, includeOptional bool) (*dto.CollectionManifest, error) {
+	collection, err := s.findVisible(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	install, err := s.resolve(collection, includeOptional)
+	if err != nil {
+		return nil, err
+	}
+	if len(install.conflicts) > 0 {
+		return nil, bizErr.ErrDependencyConflict.WithParams(bizErr.Params{"conflicts": strings.Join(install.conflicts, "；")})
+	}
+
+	manifest := &dto.CollectionManifest{
+		FormatVersion: CollectionManifestVersion,
+		Collection: dto.ManifestCollection{
+			ID:        collection.ID,
+			Title:     collection.Title,
+			UpdatedAt: collection.UpdatedAt,
+		},
+		Mods: make([]dto.ManifestMod, len(install.order)),
+	}
+	if collection.Owner != nil {
+		manifest.Collection.Owner = collection.Owner.Name
+	}
+	if collection.Game != nil {
+		manifest.Game = dto.ManifestGame{ID: collection.Game.ID, Name: collection.Game.Name}
+	}
+
+	for i, id := range install.order {
+		mod := install.graph.nodes[id]
+		entry := dto.ManifestMod{
+			ID:          id,
+			Name:        mod.Name,
+			Version:     mod.Version,
+			DownloadURL: mod.DownloadURL,
+			FileSize:    mod.FileSize,
+			Source:      ManifestSourceDependency,
+			Optional:    !install.required[id],
+		}
+		if item := install.items[id]; item != nil {
+			entry.Source = ManifestSourceCollection
+			entry.Note = item.Note
+			if release := item.Release; item.ReleaseID != 0 && release != nil {
+				entry.Pinned = true
+				entry.Version = release.Version
+				entry.FileSize = release.FileSize
+				if release.DownloadURL != "" {
+					entry.DownloadURL = release.DownloadURL
+				}
+			}
+		}
+		manifest.Mods[i] = entry
+	}
+	return manifest, nil
+}
+
+// collectionInstall 合集的安装集合
+type collectionInstall struct {
+	graph       *dependencyGraph
+	order       []uint
+	required    map[uint]bool
+	items       map[uint]*models.CollectionItem // 合集中可用的 Mod
+	conflicts   []string
+	unavailable []uint
+}
+
+// resolve 以合集中仍公开的 Mod 为根合并依赖图，锁定版本覆盖 Mod 的当前版本
+func (s *CollectionService) resolve(collection *models.Collection, includeOptional bool) (*collectionInstall, error) {
+	install := &collectionInstall{items: make(map[uint]*models.CollectionItem, len(collection.Items))}
+	roots := make([]models.Mod, 0, len(collection.Items))
+	pinned := make(map[uint]string)
+	for i := range collection.Items {
+		item := &collection.Items[i]
+		if item.Mod == nil || item.Mod.Status != models.ModStatusApproved {
+			install.unavailable = append(install.unavailable, item.ModID)
+			continue
+		}
+		install.items[item.ModID] = item
+		roots = append(roots, *item.Mod)
+		if item.ReleaseID != 0 && item.Release != nil {
+			pinned[item.ModID] = item.Release.Version
+		}
+	}
+
+	graph, err := loadDependencyGraph(s.depRepo, roots, includeOptional)
+	if err != nil {
+		s.log.Error("load collection dependency graph failed", zap.Uint("collection_id", collection.ID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询依赖关系失败")
+	}
+	graph.pinned = pinned
+
+	order, cycle := graph.topoSort()
+	if cycle != nil {
+		return nil, bizErr.ErrDependencyCycle.WithParams(bizErr.Params{"path": graph.pathString(cycle)})
+	}
+
+	install.graph = graph
+	install.order = order
+	install.required = graph.requiredSet()
+	install.conflicts = graph.conflicts(order)
+	return install, nil
+}
+
+// buildItems 校验并构造合集条目：Mod 必须已公开且属于合集的游戏，锁定的发布版本必须属于该 Mod
+func (s *CollectionService) buildItems(gameID uint, reqs []dto.CollectionItemRequest) ([]models.CollectionItem, error) {
+	if len(reqs) > maxCollectionItems {
+		return nil, bizErr.ErrCollectionTooLarge
+	}
+
+	ids := make([]uint, 0, len(reqs))
+	seen := make(map[uint]bool, len(reqs))
+	for _, req := range reqs {
+		if seen[req.ModID] {
+			return nil, bizErr.ErrCollectionItemDuplicate.WithParams(bizErr.Params{"mod_id": strconv.FormatUint(uint64(req.ModID), 10)})
+		}
+		seen[req.ModID] = true
+		ids = append(ids, req.ModID)
+	}
+
+	mods, err := s.modRepo.FindByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]models.Mod, len(mods))
+	for _, mod := range mods {
+		byID[mod.ID] = mod
+	}
+
+	items := make([]models.CollectionItem, len(reqs))
+	for i, req := range reqs {
+		mod, ok := byID[req.ModID]
+		if !ok {
+			return nil, bizErr.ErrCollectionItemUnavailable.WithParams(bizErr.Params{"mod_id": strconv.FormatUint(uint64(req.ModID), 10)})
+		}
+		if mod.GameID != gameID {
+			return nil, bizErr.ErrCollectionItemGameMismatch.WithParams(bizErr.Params{"mod": mod.Name})
+		}
+		if req.ReleaseID != 0 {
+			release, err := s.releaseRepo.FindByID(req.ReleaseID)
+			if err == nil && release.ModID != mod.ID {
+				err = gorm.ErrRecordNotFound
+			}
+			if err != nil {
+				return nil, notFoundOr(err, bizErr.ErrCollectionItemReleaseInvalid.WithParams(bizErr.Params{"release_id": strconv.FormatUint(uint64(req.ReleaseID), 10), "mod": mod.Name}))
+			}
+		}
+		items[i] = models.CollectionItem{
+			ModID:     mod.ID,
+			ReleaseID: req.ReleaseID,
+			Position:  i,
+			Note:      strings.TrimSpace(req.Note),
+		}
+	}
+	return items, nil
+}
+
+// saveItems 保存合集条目并返回最新详情
+func (s *CollectionService) saveItems(collection *models.Collection, items []models.CollectionItem, userID uint) (*dto.CollectionDetailResponse, error) {
+	if err := s.repo.ReplaceItems(collection, items); err != nil {
+		s.log.Error("save collection items failed", zap.Uint("collection_id", collection.ID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "保存合集 Mod 列表失败")
+	}
+	return s.GetCollection(collection.ID, userID)
+}
+
+// search 分页查询合集
+func (s *CollectionService) search(criteria repository.CollectionCriteria, page, pageSize int) (*dto.CollectionListResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	criteria.Page = page
+	criteria.PageSize = pageSize
+
+	collections, total, err := s.repo.Search(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]dto.CollectionResponse, len(collections))
+	for i, collection := range collections {
+		list[i] = toCollectionResponse(collection)
+	}
+	return &dto.CollectionListResponse{
+		List:       list,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// find 查询合集
+func (s *CollectionService) find(id uint) (*models.Collection, error) {
+	collection, err := s.repo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bizErr.ErrCollectionNotFound
+		}
+		return nil, err
+	}
+	return collection, nil
+}
+
+// findVisible 查询用户可见的合集，私有合集对其他用户表现为不存在
+func (s *CollectionService) findVisible(id, userID uint) (*models.Collection, error) {
+	collection, err := s.find(id)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.CanView(userID) {
+		return nil, bizErr.ErrCollectionNotFound
+	}
+	return collection, nil
+}
+
+// findOwn 查询用户自己创建的合集
+func (s *CollectionService) findOwn(id, userID uint) (*models.Collection, error) {
+	collection, err := s.findVisible(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if collection.OwnerID != userID {
+		return nil, bizErr.ErrNotCollectionOwner
+	}
+	return collection, nil
+}
+
+// toCollectionResponse 转换合集基本信息
+func toCollectionResponse(collection models.Collection) dto.CollectionResponse {
+	resp := dto.CollectionResponse{
+		ID:            collection.ID,
+		Title:         collection.Title,
+		Description:   collection.Description,
+		IsPublic:      collection.IsPublic,
+		Owner:         toOwnerResponse(collection.Owner),
+		GameID:        collection.GameID,
+		ItemCount:     collection.ItemCount,
+		FollowerCount: collection.FollowerCount,
+		CreatedAt:     collection.CreatedAt,
+		UpdatedAt:     collection.UpdatedAt,
+	}
+	if collection.Game != nil {
+		resp.GameName = collection.Game.Name
+	}
+	return resp
+}
+
+// toCollectionItemResponse 转换合集条目
+func toCollectionItemResponse(item models.CollectionItem) dto.CollectionItemResponse {
+	resp := dto.CollectionItemResponse{
+		ModID:     item.ModID,
+		ReleaseID: item.ReleaseID,
+		Position:  item.Position,
+		Note:      item.Note,
+	}
+	if item.Mod != nil {
+		resp.ModName = item.Mod.Name
+		resp.Version = item.Mod.Version
+		resp.Available = item.Mod.Status == models.ModStatusApproved
+	}
+	if item.ReleaseID != 0 && item.Release != nil {
+		resp.Version = item.Release.Version
+		resp.Pinned = true
+	}
+	return resp
+}
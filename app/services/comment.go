@@ -0,0 +1,268 @@
+package services
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/ratelimit"
+)
+
+// defaultCommentPageSize 评论列表默认每页数量
+const defaultCommentPageSize = 20
+
+// CommentService Mod 评论服务
+type CommentService struct {
+	repo    repository.CommentRepository
+	modRepo repository.ModRepository
+	limiter ratelimit.Limiter
+	log     *zap.Logger
+}
+
+// NewCommentService 创建评论服务实例
+// limiter 为空时不限制发表频率
+func NewCommentService(
+	repo repository.CommentRepository,
+	modRepo repository.ModRepository,
+	limiter ratelimit.Limiter,
+	log *zap.Logger,
+) *CommentService {
+	return &CommentService{repo: repo, modRepo: modRepo, limiter: limiter, log: log}
+}
+
+// ListComments 获取 Mod 的顶层评论（最新的在前，含回复数）
+func (s *CommentService) ListComments(modID uint, req dto.CommentListRequest) (*dto.CommentListResponse, error) {
+	if _, err := s.modRepo.FindPublicByID(modID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	page, err := s.repo.FindThreads(modID, req.Cursor, commentPageSize(req.Limit))
+	if err != nil {
+		return nil, commentPageError(err)
+	}
+	return toCommentListResponse(page), nil
+}
+
+// ListReplies 获取顶层评论下的回复（最早的在前）
+func (s *CommentService) ListReplies(commentID uint, req dto.CommentListRequest) (*dto.CommentListResponse, error) {
+	root, err := s.findComment(commentID)
+	if err != nil {
+		return nil, err
+	}
+	if root.RootID != 0 {
+		return nil, bizErr.ErrCommentNotFound
+	}
+	if _, err := s.modRepo.FindPublicByID(root.ModID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	page, err := s.repo.FindReplies(root.ID, req.Cursor, commentPageSize(req.Limit))
+	if err != nil {
+		return nil, commentPageError(err)
+	}
+	return toCommentListResponse(page), nil
+}
+
+// CreateComment 发表评论或回复（按用户限制发表频率）
+func (s *CommentService) CreateComment(modID, userID uint, req dto.CommentCreateRequest) (*dto.CommentResponse, error) {
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return nil, bizErr.ErrCommentEmpty
+	}
+
+	if _, err := s.modRepo.FindPublicByID(modID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	comment := &models.Comment{ModID: modID, UserID: userID, Content: content}
+	if req.ParentID > 0 {
+		parent, err := s.findComment(req.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ModID != modID {
+			return nil, bizErr.ErrCommentNotFound
+		}
+		if parent.IsDeleted() {
+			return nil, bizErr.ErrCommentDeleted
+		}
+		comment.ParentID = parent.ID
+		comment.RootID = parent.ID
+		if parent.RootID != 0 {
+			comment.RootID = parent.RootID
+		}
+	}
+
+	if err := s.checkRate(userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(comment); err != nil {
+		s.log.Error("create comment failed", zap.Uint("mod_id", modID), zap.Uint("user_id", userID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "发表评论失败")
+	}
+
+	created, err := s.repo.FindByID(comment.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toCommentResponse(*created)
+	return &resp, nil
+}
+
+// UpdateComment 评论者编辑自己的评论
+func (s *CommentService) UpdateComment(id, userID uint, req dto.CommentUpdateRequest) (*dto.CommentResponse, error) {
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return nil, bizErr.ErrCommentEmpty
+	}
+
+	comment, err := s.findOwnComment(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Content = content
+	if err := s.repo.UpdateContent(comment); err != nil {
+		s.log.Error("update comment failed", zap.Uint("comment_id", id), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "编辑评论失败")
+	}
+	resp := toCommentResponse(*comment)
+	return &resp, nil
+}
+
+// DeleteComment 评论者删除自己的评论（软删除，回复仍然保留）
+func (s *CommentService) DeleteComment(id, userID uint) error {
+	comment, err := s.findOwnComment(id, userID)
+	if err != nil {
+		return err
+	}
+	return s.softDelete(comment, models.CommentDeletedByAuthor)
+}
+
+// RemoveComment 审核员移除评论（软删除，回复仍然保留）
+func (s *CommentService) RemoveComment(id uint) error {
+	comment, err := s.findComment(id)
+	if err != nil {
+		return err
+	}
+	if comment.IsDeleted() {
+		return bizErr.ErrCommentDeleted
+	}
+	return s.softDelete(comment, models.CommentDeletedByModerator)
+}
+
+// softDelete 标记评论为已删除
+func (s *CommentService) softDelete(comment *models.Comment, deletedBy string) error {
+	comment.DeletedBy = deletedBy
+	if err := s.repo.SoftDelete(comment); err != nil {
+		s.log.Error("delete comment failed", zap.Uint("comment_id", comment.ID), zap.Error(err))
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除评论失败")
+	}
+	return nil
+}
+
+// checkRate 检查用户发表频率（限流器故障时放行，只记录日志）
+func (s *CommentService) checkRate(userID uint) error {
+	if s.limiter == nil {
+		return nil
+	}
+	allowed, err := s.limiter.Allow(strconv.FormatUint(uint64(userID), 10))
+	if err != nil {
+		s.log.Warn("comment rate limiter failed", zap.Uint("user_id", userID), zap.Error(err))
+		return nil
+	}
+	if !allowed {
+		return bizErr.ErrCommentRateLimited
+	}
+	return nil
+}
+
+// findComment 查询评论，不存在时返回 ErrCommentNotFound
+func (s *CommentService) findComment(id uint) (*models.Comment, error) {
+	comment, err := s.repo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bizErr.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	return comment, nil
+}
+
+// findOwnComment 查询未删除的评论并校验操作者为评论者本人
+func (s *CommentService) findOwnComment(id, userID uint) (*models.Comment, error) {
+	comment, err := s.findComment(id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted() {
+		return nil, bizErr.ErrCommentDeleted
+	}
+	if comment.UserID != userID {
+		return nil, bizErr.ErrNotCommentOwner
+	}
+	return comment, nil
+}
+
+// commentPageSize 规范化每页数量
+func commentPageSize(limit int) int {
+	if limit < 1 || limit > 100 {
+		return defaultCommentPageSize
+	}
+	return limit
+}
+
+// commentPageError 转换分页查询错误
+func commentPageError(err error) error {
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return bizErr.ErrInvalidCursor
+	}
+	return err
+}
+
+// toCommentListResponse 转换评论分页结果
+func toCommentListResponse(page *repository.CommentPage) *dto.CommentListResponse {
+	list := make([]dto.CommentResponse, len(page.Comments))
+	for i, comment := range page.Comments {
+		list[i] = toCommentResponse(comment)
+	}
+	return &dto.CommentListResponse{
+		List:       list,
+		NextCursor: page.NextCursor,
+		HasMore:    page.NextCursor != "",
+	}
+}
+
+// toCommentResponse 转换为评论响应（已删除的评论隐藏内容和评论者）
+func toCommentResponse(comment models.Comment) dto.CommentResponse {
+	resp := dto.CommentResponse{
+		ID:         comment.ID,
+		ModID:      comment.ModID,
+		RootID:     comment.RootID,
+		ParentID:   comment.ParentID,
+		ReplyCount: comment.ReplyCount,
+		CreatedAt:  comment.CreatedAt,
+		UpdatedAt:  comment.UpdatedAt,
+	}
+	if comment.IsDeleted() {
+		resp.Deleted = true
+		resp.DeletedBy = comment.DeletedBy
+		return resp
+	}
+
+	resp.Content = comment.Content
+	resp.EditedAt = comment.EditedAt
+	resp.Author = toOwnerResponse(comment.User)
+	if comment.Parent != nil && !comment.Parent.IsDeleted() {
+		resp.ReplyTo = toOwnerResponse(comment.Parent.User)
+	}
+	return resp
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/version"
+)
+
+// 游戏详情中聚合列表的条目数
+const (
+	gameTopCategoryLimit = 5
+	gameNewestModLimit   = 5
+)
+
+// GameService 游戏服务
+type GameService struct {
+	repo       repository.GameRepository
+	modService *ModService
+	log        *zap.Logger
+}
+
+// NewGameService 创建游戏服务实例
+func NewGameService(repo repository.GameRepository, modService *ModService, log *zap.Logger) *GameService {
+	return &GameService{repo: repo, modService: modService, log: log}
+}
+
+// NegotiateLocale 协商响应语言（见 ModService.NegotiateLocale）
+func (s *GameService) NegotiateLocale(acceptLanguage, lang string) string {
+	return s.modService.NegotiateLocale(acceptLanguage, lang)
+}
+
+// localizer 多语言内容服务（未注入 ModService 时不替换内容）
+func (s *GameService) localizer() *Localizer {
+	if s.modService == nil {
+		return nil
+	}
+	return s.modService.localizer
+}
+
+// GetGameDetail 获取游戏详情及聚合统计（以 locale 指定的语言返回）
+func (s *GameService) GetGameDetail(id uint, locale string) (*dto.GameDetailResponse, error) {
+	game, err := s.findGame(id)
+	if err != nil {
+		return nil, err
+	}
+	games := []models.Game{*game}
+	s.localizer().Games(locale, games)
+
+	stats, err := s.repo.Stats(id)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.repo.TopCategories(id, gameTopCategoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	localized := make([]models.Category, len(categories))
+	for i, c := range categories {
+		localized[i] = models.Category{ID: c.ID, Name: c.Name}
+	}
+	s.localizer().Categories(locale, localized)
+	topCategories := make([]dto.CategoryCountResponse, len(categories))
+	for i, c := range categories {
+		topCategories[i] = dto.CategoryCountResponse{ID: c.ID, Name: localized[i].Name, ModCount: c.ModCount}
+	}
+
+	mods, err := s.repo.NewestMods(id, gameNewestModLimit)
+	if err != nil {
+		return nil, err
+	}
+	s.localizer().Mods(locale, mods)
+	newestMods := make([]dto.ModItemResponse, len(mods))
+	for i, mod := range mods {
+		newestMods[i] = toModItemResponse(mod, nil)
+	}
+
+	return &dto.GameDetailResponse{
+		Game: games[0],
+		Stats: dto.GameStatsResponse{
+			ModCount:       stats.ModCount,
+			TotalDownloads: stats.TotalDownloads,
+			TotalViews:     stats.TotalViews,
+		},
+		TopCategories: topCategories,
+		NewestMods:    newestMods,
+	}, nil
+}
+
+// SearchGameMods 在指定游戏下搜索 Mod（忽略请求中的 game_id，以 locale 指定的语言返回）
+func (s *GameService) SearchGameMods(id uint, req dto.ModSearchRequest, locale string) (*dto.ModListResponse, error) {
+	if _, err := s.findGame(id); err != nil {
+		return nil, err
+	}
+
+	req.GameID = strconv.FormatUint(uint64(id), 10)
+	return s.modService.SearchMods(req, locale)
+}
+
+// GetGameVersions 获取游戏支持的版本列表（按版本号从新到旧，无法解析的版本号排在最后）
+func (s *GameService) GetGameVersions(id uint) (*dto.GameVersionListResponse, error) {
+	if _, err := s.findGame(id); err != nil {
+		return nil, err
+	}
+
+	versions, err := s.repo.FindVersions(id)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return version.CompareStrings(versions[i].Name, versions[j].Name) > 0
+	})
+	return &dto.GameVersionListResponse{List: versions}, nil
+}
+
+// CreateGameVersion 添加游戏版本
+func (s *GameService) CreateGameVersion(id uint, req dto.GameVersionSaveRequest) (*models.GameVersion, error) {
+	game, err := s.findGame(id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range game.Versions {
+		if v.Name == req.Name {
+			return nil, bizErr.ErrGameVersionExists
+		}
+	}
+
+	version := &models.GameVersion{GameID: id, Name: req.Name, ReleasedAt: req.ReleasedAt}
+	if err := s.repo.CreateVersion(version); err != nil {
+		s.log.Error("create game version failed", zap.Uint("game_id", id), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "添加游戏版本失败")
+	}
+	return version, nil
+}
+
+// DeleteGameVersion 删除游戏版本（同时移除相关兼容性声明）
+func (s *GameService) DeleteGameVersion(id, versionID uint) error {
+	version, err := s.repo.FindVersionByID(versionID)
+	if err != nil {
+		return notFoundOr(err, bizErr.ErrGameVersionNotFound)
+	}
+	if version.GameID != id {
+		return bizErr.ErrGameVersionNotFound
+	}
+
+	if err := s.repo.DeleteVersion(versionID); err != nil {
+		s.log.Error("delete game version failed", zap.Uint("version_id", versionID), zap.Error(err))
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除游戏版本失败")
+	}
+	return nil
+}
+
+// findGame 查询游戏，不存在时返回 ErrGameNotFound
+func (s *GameService) findGame(id uint) (*models.Game, error) {
+	game, err := s.repo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bizErr.ErrGameNotFound
+		}
+		return nil, err
+	}
+	return game, nil
+}
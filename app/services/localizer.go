@@ -0,0 +1,171 @@
+package services
+
+import (
+	"go.uber.org/zap"
+
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	"gin-web/pkg/locale"
+)
+
+// Localizer 游戏、分类、Mod 的多语言内容
+// 默认语言的内容保存在主表中，其他语言读取翻译表，缺少翻译（或翻译的某个字段为空）时回退到默认语言
+type Localizer struct {
+	repo       repository.TranslationRepository
+	negotiator *locale.Negotiator
+	log        *zap.Logger
+}
+
+// NewLocalizer 创建多语言内容服务实例
+// repo 为空时只协商语言，不替换内容
+func NewLocalizer(repo repository.TranslationRepository, negotiator *locale.Negotiator, log *zap.Logger) *Localizer {
+	return &Localizer{repo: repo, negotiator: negotiator, log: log}
+}
+
+// Negotiate 协商响应语言（lang 参数优先，其次 Accept-Language），未配置时返回空字符串
+func (l *Localizer) Negotiate(acceptLanguage, lang string) string {
+	if l == nil {
+		return ""
+	}
+	return l.negotiator.Negotiate(acceptLanguage, lang)
+}
+
+// Default 默认语言
+func (l *Localizer) Default() string {
+	if l == nil {
+		return ""
+	}
+	return l.negotiator.Default()
+}
+
+// Supported 支持的语言列表（默认语言在前）
+func (l *Localizer) Supported() []string {
+	if l == nil {
+		return nil
+	}
+	return l.negotiator.Supported()
+}
+
+// IsSupported 是否为支持的语言（需为规范化后的标签）
+func (l *Localizer) IsSupported(tag string) bool {
+	return l != nil && l.negotiator.Match(tag) == tag
+}
+
+// enabled 是否需要替换为翻译内容
+func (l *Localizer) enabled(loc string) bool {
+	return l != nil && l.repo != nil && loc != "" && loc != l.negotiator.Default()
+}
+
+// find 查询翻译，失败时记录日志并回退到默认语言
+func (l *Localizer) find(entity string, ids []uint, loc string) map[uint]repository.Translation {
+	if len(ids) == 0 {
+		return nil
+	}
+	rows, err := l.repo.Find(entity, uniqueIDs(ids), loc)
+	if err != nil {
+		l.log.Warn("load translations failed, fallback to default locale",
+			zap.String("entity", entity), zap.String("locale", loc), zap.Error(err))
+		return nil
+	}
+
+	result := make(map[uint]repository.Translation, len(rows))
+	for _, row := range rows {
+		result[row.EntityID] = row
+	}
+	return result
+}
+
+// Mods 将 Mod 及其游戏、分类替换为指定语言的内容
+func (l *Localizer) Mods(loc string, mods []models.Mod) {
+	if !l.enabled(loc) || len(mods) == 0 {
+		return
+	}
+
+	var modIDs, gameIDs, categoryIDs []uint
+	for _, mod := range mods {
+		modIDs = append(modIDs, mod.ID)
+		gameIDs = append(gameIDs, mod.GameID)
+		for _, c := range mod.Categories {
+			categoryIDs = append(categoryIDs, c.ID)
+		}
+	}
+
+	modTranslations := l.find(models.TranslationEntityMod, modIDs, loc)
+	gameTranslations := l.find(models.TranslationEntityGame, gameIDs, loc)
+	categoryTranslations := l.find(models.TranslationEntityCategory, categoryIDs, loc)
+	for i := range mods {
+		mod := &mods[i]
+		t, ok := modTranslations[mod.ID]
+		applyTranslation(t, ok, &mod.Name, &mod.Description)
+		localizeGame(&mod.Game, gameTranslations, loc)
+		for j := range mod.Categories {
+			c := &mod.Categories[j]
+			t, ok := categoryTranslations[c.ID]
+			applyTranslation(t, ok, &c.Name, &c.Description)
+		}
+	}
+}
+
+// Mod 将单个 Mod 替换为指定语言的内容
+func (l *Localizer) Mod(loc string, mod *models.Mod) {
+	mods := []models.Mod{*mod}
+	l.Mods(loc, mods)
+	*mod = mods[0]
+}
+
+// Games 将游戏替换为指定语言的内容
+func (l *Localizer) Games(loc string, games []models.Game) {
+	if !l.enabled(loc) || len(games) == 0 {
+		return
+	}
+
+	ids := make([]uint, len(games))
+	for i, g := range games {
+		ids[i] = g.ID
+	}
+	translations := l.find(models.TranslationEntityGame, ids, loc)
+	for i := range games {
+		localizeGame(&games[i], translations, loc)
+	}
+}
+
+// Categories 将分类替换为指定语言的内容
+func (l *Localizer) Categories(loc string, categories []models.Category) {
+	if !l.enabled(loc) || len(categories) == 0 {
+		return
+	}
+
+	ids := make([]uint, len(categories))
+	for i, c := range categories {
+		ids[i] = c.ID
+	}
+	translations := l.find(models.TranslationEntityCategory, ids, loc)
+	for i := range categories {
+		c := &categories[i]
+		t, ok := translations[c.ID]
+		applyTranslation(t, ok, &c.Name, &c.Description)
+	}
+}
+
+// localizeGame 替换游戏名称与描述；英文没有翻译时使用游戏的英文名
+func localizeGame(game *models.Game, translations map[uint]repository.Translation, loc string) {
+	t, ok := translations[game.ID]
+	if !ok && locale.Base(loc) == "en" && game.EnglishName != "" {
+		game.Name = game.EnglishName
+		return
+	}
+	applyTranslation(t, ok, &game.Name, &game.Description)
+}
+
+// applyTranslation 用翻译中非空的字段覆盖原内容
+func applyTranslation(t repository.Translation, ok bool, name, description *string) {
+	if !ok {
+		return
+	}
+	if t.Name != "" {
+		*name = t.Name
+	}
+	if t.Description != "" {
+		*description = t.Description
+	}
+}
@@ -1,86 +1,422 @@
 package services
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"go.uber.org/zap"
 
+	"gin-web/app/amqp/event"
 	"gin-web/app/dto"
 	"gin-web/app/models"
 	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/search"
+	"gin-web/pkg/version"
 )
 
+// highlightSnippetLength 描述高亮片段长度（字符）
+const highlightSnippetLength = 120
+
+// ModEventPublisher Mod 领域事件发布接口
+type ModEventPublisher interface {
+	PublishModEvent(evt event.ModEvent) error
+}
+
 // ModService Mod服务
 type ModService struct {
-	repo repository.ModRepository
-	log  *zap.Logger
+	repo      repository.ModRepository
+	localizer *Localizer
+	events    ModEventPublisher
+	log       *zap.Logger
 }
 
 // NewModService 创建Mod服务实例
-func NewModService(repo repository.ModRepository, log *zap.Logger) *ModService {
-	return &ModService{repo: repo, log: log}
+// localizer 为空时只返回默认语言的内容，events 为空时不发布领域事件
+func NewModService(repo repository.ModRepository, localizer *Localizer, events ModEventPublisher, log *zap.Logger) *ModService {
+	return &ModService{repo: repo, localizer: localizer, events: events, log: log}
+}
+
+// NegotiateLocale 协商响应语言：lang 参数优先，其次按 Accept-Language 的权重顺序，均不支持时使用默认语言
+// 未配置多语言时返回空字符串
+func (s *ModService) NegotiateLocale(acceptLanguage, lang string) string {
+	return s.localizer.Negotiate(acceptLanguage, lang)
 }
 
-// SearchMods 搜索mod
-func (s *ModService) SearchMods(req dto.ModSearchRequest) (*dto.ModListResponse, error) {
+// SearchMods 搜索mod（关键词同时匹配各语言的翻译，结果以 locale 指定的语言返回）
+func (s *ModService) SearchMods(req dto.ModSearchRequest, locale string) (*dto.ModListResponse, error) {
+	gameIDs, err := parseIDList(req.GameID)
+	if err != nil {
+		return nil, bizErr.ErrGameIDInvalid
+	}
+	categoryIDs, err := parseIDList(req.CategoryID)
+	if err != nil {
+		return nil, bizErr.ErrCategoryIDInvalid
+	}
+	gameVersionIDs, err := parseIDList(req.GameVersionID)
+	if err != nil {
+		return nil, bizErr.ErrGameVersionIDInvalid
+	}
+	if strings.TrimSpace(req.GameVersion) != "" {
+		gameVersionIDs, err = s.matchGameVersions(gameIDs, req.GameVersion, gameVersionIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// 转换 DTO 为 Repository 查询条件
 	criteria := repository.ModSearchCriteria{
-		Keyword:    req.Keyword,
-		GameID:     req.GameID,
-		CategoryID: req.CategoryID,
-		Author:     req.Author,
-		SortBy:     req.SortBy,
-		Order:      req.Order,
-		Page:       req.Page,
-		PageSize:   req.PageSize,
+		Keyword:        req.Keyword,
+		GameIDs:        gameIDs,
+		CategoryIDs:    categoryIDs,
+		Tags:           parseTagList(req.Tag),
+		GameVersionIDs: gameVersionIDs,
+		Author:         req.Author,
+		AuthorID:       req.AuthorID,
+		SortBy:         req.SortBy,
+		Order:          req.Order,
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+		Cursor:         req.Cursor,
+		WithFacets:     req.Facets,
+		// 未显式指定时：页码分页统计总数，游标分页跳过统计
+		SkipTotal: (req.WithTotal != nil && !*req.WithTotal) || (req.WithTotal == nil && req.Cursor != ""),
 	}
 
 	// 调用 Repository 执行搜索
 	result, err := s.repo.Search(criteria)
 	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, bizErr.ErrInvalidCursor
+		}
 		return nil, err
 	}
 
-	// 转换为响应格式
+	// 转换为响应格式（高亮基于替换后的语言内容）
+	s.localizer.Mods(locale, result.Mods)
+	terms := search.Terms(req.Keyword)
 	modItems := make([]dto.ModItemResponse, len(result.Mods))
 	for i, mod := range result.Mods {
-		categoryNames := make([]string, len(mod.Categories))
-		for j, category := range mod.Categories {
-			categoryNames[j] = category.Name
-		}
-
-		modItems[i] = dto.ModItemResponse{
-			ID:            mod.ID,
-			Name:          mod.Name,
-			Author:        mod.Author,
-			Version:       mod.Version,
-			Rating:        mod.Rating,
-			DownloadCount: mod.DownloadCount,
-			FileSize:      mod.FileSize,
-			GameName:      mod.Game.Name,
-			Categories:    categoryNames,
-			CreatedAt:     mod.CreatedAt,
-			UpdatedAt:     mod.UpdatedAt,
-		}
+		modItems[i] = toModItemResponse(mod, terms)
 	}
 
-	return &dto.ModListResponse{
+	resp := &dto.ModListResponse{
 		List:       modItems,
-		Total:      result.Total,
 		Page:       result.Page,
 		PageSize:   result.PageSize,
-		TotalPages: result.TotalPages,
-	}, nil
+		NextCursor: result.NextCursor,
+		HasMore:    result.NextCursor != "",
+		Facets:     toModFacetsResponse(result.Facets),
+	}
+	if result.HasTotal {
+		resp.Total = &result.Total
+		resp.TotalPages = &result.TotalPages
+	}
+	return resp, nil
 }
 
-// GetModDetail 获取mod详情
-func (s *ModService) GetModDetail(id uint) (*dto.ModDetailResponse, error) {
-	mod, err := s.repo.FindByID(id)
+// matchGameVersions 返回所选游戏中版本号满足约束的游戏版本 ID（同时指定了游戏版本 ID 时取交集）
+// 没有满足约束的版本时返回不存在的 ID 0，使搜索结果为空
+func (s *ModService) matchGameVersions(gameIDs []uint, raw string, ids []uint) ([]uint, error) {
+	if len(gameIDs) == 0 {
+		return nil, bizErr.ErrGameVersionGameRequired
+	}
+	constraint, err := version.ParseConstraint(raw)
 	if err != nil {
-		return nil, err
+		return nil, bizErr.ErrGameVersionConstraint
+	}
+
+	versions, err := s.repo.FindGameVersionsByGames(gameIDs)
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询游戏版本失败")
+	}
+	allowed := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		allowed[id] = true
+	}
+
+	matched := []uint{}
+	for _, gv := range versions {
+		if len(ids) > 0 && !allowed[gv.ID] {
+			continue
+		}
+		if v, err := version.Parse(gv.Name); err == nil && constraint.Check(v) {
+			matched = append(matched, gv.ID)
+		}
+	}
+	if len(matched) == 0 {
+		return []uint{0}, nil
+	}
+	return matched, nil
+}
+
+// parseIDList 解析逗号分隔的 ID 列表（忽略空项并去重），空字符串返回 nil
+func parseIDList(raw string) ([]uint, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var ids []uint
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 0)
+		if err != nil || id == 0 {
+			return nil, fmt.Errorf("invalid id %q", part)
+		}
+		ids = append(ids, uint(id))
+	}
+	return uniqueIDs(ids), nil
+}
+
+// toModFacetsResponse 转换分面统计
+func toModFacetsResponse(facets *repository.ModFacets) *dto.ModFacetsResponse {
+	if facets == nil {
+		return nil
+	}
+
+	convert := func(buckets []repository.ModFacetBucket) []dto.FacetBucketResponse {
+		items := make([]dto.FacetBucketResponse, len(buckets))
+		for i, b := range buckets {
+			items[i] = dto.FacetBucketResponse{Value: b.Value, Label: b.Label, Count: b.Count}
+		}
+		return items
+	}
+
+	return &dto.ModFacetsResponse{
+		Games:      convert(facets.Games),
+		Categories: convert(facets.Categories),
+		Authors:    convert(facets.Authors),
+		Ratings:    convert(facets.Ratings),
+	}
+}
+
+// toModItemResponse 转换为列表项（terms 非空时生成高亮片段）
+func toModItemResponse(mod models.Mod, terms []string) dto.ModItemResponse {
+	categoryNames := make([]string, len(mod.Categories))
+	for i, category := range mod.Categories {
+		categoryNames[i] = category.Name
+	}
+	tagNames := make([]string, len(mod.Tags))
+	for i, tag := range mod.Tags {
+		tagNames[i] = tag.Name
+	}
+
+	return dto.ModItemResponse{
+		ID:            mod.ID,
+		Name:          mod.Name,
+		Author:        mod.Author,
+		OwnerID:       mod.OwnerID,
+		Version:       mod.Version,
+		Rating:        mod.Rating,
+		DownloadCount: mod.DownloadCount,
+		ViewCount:     mod.ViewCount,
+		TrendingScore: mod.TrendingScore,
+		FileSize:      mod.FileSize,
+		ThumbnailURL:  modThumbnailURL(mod),
+		GameName:      mod.Game.Name,
+		Categories:    categoryNames,
+		Tags:          tagNames,
+		CreatedAt:     mod.CreatedAt,
+		UpdatedAt:     mod.UpdatedAt,
+		Highlight:     buildModHighlight(mod, terms),
+	}
+}
+
+// modThumbnailURL 列表缩略图：优先使用第一张截图的缩略图，没有截图时使用封面图链接
+func modThumbnailURL(mod models.Mod) string {
+	if mod.ThumbnailURL != "" {
+		return mod.ThumbnailURL
+	}
+	return mod.ImageURL
+}
+
+// buildModHighlight 生成名称和描述的高亮片段，均未命中时返回 nil
+func buildModHighlight(mod models.Mod, terms []string) *dto.ModHighlightResponse {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	highlight := &dto.ModHighlightResponse{
+		Name:        search.Highlight(mod.Name, terms, 0),
+		Description: search.Highlight(mod.Description, terms, highlightSnippetLength),
+	}
+	if highlight.Name == "" && highlight.Description == "" {
+		return nil
+	}
+	return highlight
+}
+
+// GetModDetail 获取mod详情（仅记录浏览次数，不计入下载）
+// 只返回已通过审核的 Mod；visitor 为访客标识（见 VisitorKey），用于统计独立访客；内容以 locale 指定的语言返回
+func (s *ModService) GetModDetail(id uint, visitor, locale string) (*dto.ModDetailResponse, error) {
+	mod, err := s.repo.FindPublicByID(id)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	// 增加浏览次数
+	if err := s.repo.UpdateViewCount(mod, visitor); err != nil {
+		s.log.Warn("update view count failed", zap.Uint("mod_id", mod.ID), zap.Error(err))
+	} else {
+		mod.ViewCount++
+	}
+
+	s.localizer.Mod(locale, mod)
+	return toModDetailResponse(mod), nil
+}
+
+// GetDownloadURL 获取mod下载链接（仅记录下载次数，不计入浏览）
+// 下载链接为空时返回空字符串且不计数
+func (s *ModService) GetDownloadURL(id uint, visitor string) (string, error) {
+	mod, err := s.repo.FindPublicByID(id)
+	if err != nil {
+		return "", notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	if mod.DownloadURL == "" {
+		return "", nil
 	}
 
 	// 增加下载次数
-	_ = s.repo.UpdateDownloadCount(mod)
+	if err := s.repo.UpdateDownloadCount(mod, visitor); err != nil {
+		s.log.Warn("update download count failed", zap.Uint("mod_id", mod.ID), zap.Error(err))
+	}
+
+	return mod.DownloadURL, nil
+}
+
+// CreateMod 创建mod
+// 新建的 Mod 进入待审核状态（req.Draft 为 true 时保存为草稿），审核通过后才会公开
+func (s *ModService) CreateMod(req dto.ModSaveRequest, ownerID uint) (*dto.ModDetailResponse, error) {
+	mod := &models.Mod{Status: models.ModStatusPending, OwnerID: ownerID}
+	if req.Draft {
+		mod.Status = models.ModStatusDraft
+	}
+	if err := s.fillMod(mod, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(mod); err != nil {
+		s.log.Error("create mod failed", zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "创建 Mod 失败")
+	}
+
+	s.publish(event.ModCreated, mod.ID)
+	return toModDetailResponse(mod), nil
+}
+
+// UpdateMod 更新mod（仅作者或共同维护者）
+func (s *ModService) UpdateMod(id, userID uint, req dto.ModSaveRequest) (*dto.ModDetailResponse, error) {
+	mod, err := findEditableMod(s.repo, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.fillMod(mod, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(mod); err != nil {
+		s.log.Error("update mod failed", zap.Uint("mod_id", id), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "更新 Mod 失败")
+	}
+
+	s.publish(event.ModUpdated, mod.ID)
+	return toModDetailResponse(mod), nil
+}
+
+// DeleteMod 删除mod（仅作者或共同维护者）
+func (s *ModService) DeleteMod(id, userID uint) error {
+	if _, err := findEditableMod(s.repo, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(id); err != nil {
+		s.log.Error("delete mod failed", zap.Uint("mod_id", id), zap.Error(err))
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除 Mod 失败")
+	}
+
+	s.publish(event.ModDeleted, id)
+	return nil
+}
+
+// fillMod 校验关联数据并将请求内容写入模型
+func (s *ModService) fillMod(mod *models.Mod, req dto.ModSaveRequest) error {
+	game, err := s.repo.FindGameByID(req.GameID)
+	if err != nil {
+		return notFoundOr(err, bizErr.ErrGameNotFound)
+	}
+
+	categoryIDs := uniqueIDs(req.CategoryIDs)
+	categories, err := s.repo.FindCategoriesByIDs(categoryIDs)
+	if err != nil {
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "查询分类失败")
+	}
+	if len(categories) != len(categoryIDs) {
+		return bizErr.ErrCategoryNotFound
+	}
 
+	gameVersions, err := findGameVersions(s.repo, game.ID, req.GameVersionIDs)
+	if err != nil {
+		return err
+	}
+
+	mod.Name = req.Name
+	mod.Description = req.Description
+	mod.Author = req.Author
+	mod.Version = req.Version
+	mod.DownloadURL = req.DownloadURL
+	mod.ImageURL = req.ImageURL
+	mod.FileSize = req.FileSize
+	mod.GameID = game.ID
+	mod.Game = *game
+	mod.Categories = categories
+	mod.GameVersions = gameVersions
+	return nil
+}
+
+// findGameVersions 查询并校验游戏版本均属于指定游戏
+func findGameVersions(repo repository.ModRepository, gameID uint, ids []uint) ([]models.GameVersion, error) {
+	ids = uniqueIDs(ids)
+	if len(ids) == 0 {
+		return []models.GameVersion{}, nil
+	}
+
+	versions, err := repo.FindGameVersionsByIDs(ids)
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询游戏版本失败")
+	}
+	if len(versions) != len(ids) {
+		return nil, bizErr.ErrGameVersionNotFound
+	}
+	for _, v := range versions {
+		if v.GameID != gameID {
+			return nil, bizErr.ErrGameVersionNotFound
+		}
+	}
+	return versions, nil
+}
+
+// publish 发布领域事件（失败只记录日志，不影响主流程；索引可通过全量重建修复）
+func (s *ModService) publish(eventType string, modID uint) {
+	if s.events == nil {
+		return
+	}
+	if err := s.events.PublishModEvent(event.NewModEvent(eventType, modID)); err != nil {
+		s.log.Warn("publish mod event failed",
+			zap.String("type", eventType),
+			zap.Uint("mod_id", modID),
+			zap.Error(err))
+	}
+}
+
+// toModDetailResponse 转换为详情响应
+func toModDetailResponse(mod *models.Mod) *dto.ModDetailResponse {
 	// 转换分类为数组格式
 	categories := make([]models.Category, len(mod.Categories))
 	copy(categories, mod.Categories)
@@ -93,35 +429,106 @@ func (s *ModService) GetModDetail(id uint) (*dto.ModDetailResponse, error) {
 		Version:       mod.Version,
 		DownloadURL:   mod.DownloadURL,
 		Rating:        mod.Rating,
-		DownloadCount: mod.DownloadCount + 1,
+		DownloadCount: mod.DownloadCount,
+		ViewCount:     mod.ViewCount,
 		FileSize:      mod.FileSize,
 		Game:          mod.Game,
 		Categories:    categories,
+		Tags:          append([]models.Tag{}, mod.Tags...),
+		GameVersions:  append([]models.GameVersion{}, mod.GameVersions...),
+		Status:        mod.Status,
+		OwnerID:       mod.OwnerID,
+		Owner:         toOwnerResponse(mod.Owner),
+		Maintainers:   toAuthorResponses(mod.Maintainers),
+		Images:        toModImageResponses(mod.Images),
 		CreatedAt:     mod.CreatedAt,
 		UpdatedAt:     mod.UpdatedAt,
-	}, nil
+	}
 }
 
-// GetGames 获取游戏列表
-func (s *ModService) GetGames() (*dto.GameListResponse, error) {
+// uniqueIDs ID 去重（保持原有顺序）
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]struct{}, len(ids))
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+// GetGames 获取游戏列表（以 locale 指定的语言返回）
+func (s *ModService) GetGames(locale string) (*dto.GameListResponse, error) {
 	games, err := s.repo.FindAllGames()
 	if err != nil {
 		return nil, err
 	}
+	s.localizer.Games(locale, games)
 
 	return &dto.GameListResponse{
 		List: games,
 	}, nil
 }
 
-// GetCategories 获取分类列表
-func (s *ModService) GetCategories() (*dto.CategoryListResponse, error) {
+// GetCategories 获取分类列表（以 locale 指定的语言返回）
+func (s *ModService) GetCategories(locale string) (*dto.CategoryListResponse, error) {
 	categories, err := s.repo.FindAllCategories()
 	if err != nil {
 		return nil, err
 	}
+	s.localizer.Categories(locale, categories)
 
 	return &dto.CategoryListResponse{
 		List: categories,
 	}, nil
 }
+
+// GetCategoryTree 获取分类树（父分类不存在的分类视为顶级分类，以 locale 指定的语言返回）
+func (s *ModService) GetCategoryTree(locale string) (*dto.CategoryTreeResponse, error) {
+	categories, err := s.repo.FindAllCategories()
+	if err != nil {
+		return nil, err
+	}
+	s.localizer.Categories(locale, categories)
+
+	exists := make(map[uint]bool, len(categories))
+	for _, c := range categories {
+		exists[c.ID] = true
+	}
+
+	children := make(map[uint][]models.Category)
+	var roots []models.Category
+	for _, c := range categories {
+		if c.ParentID == nil || !exists[*c.ParentID] || *c.ParentID == c.ID {
+			roots = append(roots, c)
+			continue
+		}
+		children[*c.ParentID] = append(children[*c.ParentID], c)
+	}
+
+	// visited 防止异常数据中的循环引用导致无限递归
+	visited := make(map[uint]bool, len(categories))
+	var build func(list []models.Category) []dto.CategoryTreeNode
+	build = func(list []models.Category) []dto.CategoryTreeNode {
+		nodes := make([]dto.CategoryTreeNode, 0, len(list))
+		for _, c := range list {
+			if visited[c.ID] {
+				continue
+			}
+			visited[c.ID] = true
+			nodes = append(nodes, dto.CategoryTreeNode{
+				ID:          c.ID,
+				Name:        c.Name,
+				Description: c.Description,
+				ParentID:    c.ParentID,
+				Children:    build(children[c.ID]),
+			})
+		}
+		return nodes
+	}
+
+	return &dto.CategoryTreeResponse{List: build(roots)}, nil
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/trending"
+)
+
+// 统计粒度
+const (
+	StatsIntervalDay  = "day"
+	StatsIntervalWeek = "week"
+)
+
+const (
+	statsDateLayout = "2006-01-02"
+	// defaultStatsDays 未指定开始日期时的统计天数
+	defaultStatsDays = 30
+	// maxStatsDays 单次查询的最大天数
+	maxStatsDays = 366
+	// statsTopLimit 看板排行的条目数
+	statsTopLimit = 10
+	// defaultStatsLookbackDays 汇总任务默认重新汇总的天数（今天之外再往前的天数）
+	defaultStatsLookbackDays = 1
+	// defaultStatsRetentionDays 统计事件默认保留天数
+	defaultStatsRetentionDays = 90
+)
+
+// ModAnalyticsService Mod 下载 / 浏览统计服务
+type ModAnalyticsService struct {
+	repo          repository.ModAnalyticsRepository
+	modRepo       repository.ModRepository
+	lookbackDays  int
+	retentionDays int
+	log           *zap.Logger
+}
+
+// NewModAnalyticsService 创建统计服务实例
+// lookbackDays 为每次汇总时重新汇总的历史天数（覆盖跨零点延迟写入的事件），retentionDays 为原始事件保留天数；
+// 为 0 时使用默认值，retentionDays 为负数时不清理事件
+func NewModAnalyticsService(
+	repo repository.ModAnalyticsRepository,
+	modRepo repository.ModRepository,
+	lookbackDays int,
+	retentionDays int,
+	log *zap.Logger,
+) *ModAnalyticsService {
+	if lookbackDays <= 0 {
+		lookbackDays = defaultStatsLookbackDays
+	}
+	if retentionDays == 0 {
+		retentionDays = defaultStatsRetentionDays
+	}
+	return &ModAnalyticsService{
+		repo:          repo,
+		modRepo:       modRepo,
+		lookbackDays:  lookbackDays,
+		retentionDays: retentionDays,
+		log:           log,
+	}
+}
+
+// VisitorKey 生成访客标识摘要：登录用户按用户 ID，匿名访客按 IP 与 User-Agent（不保存原始 IP）
+func VisitorKey(userID uint, ip, userAgent string) string {
+	raw := "ip:" + ip + "|" + userAgent
+	if userID != 0 {
+		raw = "user:" + strconv.FormatUint(uint64(userID), 10)
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// Rollup 将最近几天的统计事件汇总为每日计数，并清理超过保留期的事件
+// 保留期不会短于汇总窗口，避免清理尚未汇总的事件
+func (s *ModAnalyticsService) Rollup(now time.Time) error {
+	today := trending.Day(now)
+	since := today.AddDate(0, 0, -s.lookbackDays)
+	rows, err := s.repo.Rollup(since)
+	if err != nil {
+		return err
+	}
+
+	var purged int64
+	if s.retentionDays > 0 {
+		before := today.AddDate(0, 0, -s.retentionDays)
+		if before.After(since) {
+			before = since
+		}
+		if purged, err = s.repo.PurgeEvents(before); err != nil {
+			return err
+		}
+	}
+
+	s.log.Info("mod stats rolled up",
+		zap.Time("since", since),
+		zap.Int64("rows", rows),
+		zap.Int64("purged_events", purged),
+	)
+	return nil
+}
+
+// ModStats Mod 的下载 / 浏览时间序列及各版本下载量（仅作者或共同维护者）
+func (s *ModAnalyticsService) ModStats(modID, userID uint, req dto.StatsRangeRequest) (*dto.ModStatsResponse, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+
+	r, err := parseStatsRange(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.repo.FindDailyStats(modID, r.from, r.to)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.repo.FindVersionDownloads(modID, r.from, r.to)
+	if err != nil {
+		return nil, err
+	}
+
+	points, totals := r.series(stats)
+	resp := &dto.ModStatsResponse{
+		ModID:    modID,
+		Interval: r.interval,
+		From:     r.from.Format(statsDateLayout),
+		To:       r.to.Format(statsDateLayout),
+		Totals:   totals,
+		Points:   points,
+		Versions: make([]dto.VersionStatsResponse, len(versions)),
+	}
+	for i, v := range versions {
+		resp.Versions[i] = dto.VersionStatsResponse{Version: v.Version, Downloads: v.Downloads}
+	}
+	return resp, nil
+}
+
+// Dashboard 全站统计看板：时间序列、下载量最多的 Mod 与游戏
+func (s *ModAnalyticsService) Dashboard(req dto.StatsRangeRequest) (*dto.StatsDashboardResponse, error) {
+	r, err := parseStatsRange(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.repo.SumDailyStats(r.from, r.to)
+	if err != nil {
+		return nil, err
+	}
+	mods, err := s.repo.TopMods(r.from, r.to, statsTopLimit)
+	if err != nil {
+		return nil, err
+	}
+	games, err := s.repo.TopGames(r.from, r.to, statsTopLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	points, totals := r.series(stats)
+	resp := &dto.StatsDashboardResponse{
+		Interval: r.interval,
+		From:     r.from.Format(statsDateLayout),
+		To:       r.to.Format(statsDateLayout),
+		Totals:   totals,
+		Points:   points,
+		TopMods:  make([]dto.ModStatsRankResponse, len(mods)),
+		TopGames: make([]dto.GameStatsRankResponse, len(games)),
+	}
+	for i, m := range mods {
+		resp.TopMods[i] = dto.ModStatsRankResponse{ModID: m.ModID, Name: m.Name, Downloads: m.Downloads, Views: m.Views}
+	}
+	for i, g := range games {
+		resp.TopGames[i] = dto.GameStatsRankResponse{GameID: g.GameID, Name: g.Name, Downloads: g.Downloads, Views: g.Views}
+	}
+	return resp, nil
+}
+
+// statsRange 统计时间范围（闭区间，均为零点）
+type statsRange struct {
+	from     time.Time
+	to       time.Time
+	interval string
+}
+
+// parseStatsRange 解析统计时间范围，默认统计截至今天的最近 30 天
+func parseStatsRange(req dto.StatsRangeRequest, now time.Time) (*statsRange, error) {
+	r := &statsRange{to: trending.Day(now), interval: req.Interval}
+	if r.interval == "" {
+		r.interval = StatsIntervalDay
+	}
+
+	if req.To != "" {
+		to, err := time.ParseInLocation(statsDateLayout, req.To, now.Location())
+		if err != nil {
+			return nil, bizErr.ErrStatsRangeInvalid
+		}
+		r.to = to
+	}
+	r.from = r.to.AddDate(0, 0, -(defaultStatsDays - 1))
+	if req.From != "" {
+		from, err := time.ParseInLocation(statsDateLayout, req.From, now.Location())
+		if err != nil {
+			return nil, bizErr.ErrStatsRangeInvalid
+		}
+		r.from = from
+	}
+
+	if r.from.After(r.to) {
+		return nil, bizErr.ErrStatsRangeInvalid
+	}
+	if r.to.After(r.from.AddDate(0, 0, maxStatsDays-1)) {
+		return nil, bizErr.ErrStatsRangeTooLong
+	}
+	return r, nil
+}
+
+// bucket 日期所在的统计桶（week 粒度为所在周的周一）
+func (r *statsRange) bucket(day time.Time) time.Time {
+	if r.interval != StatsIntervalWeek {
+		return day
+	}
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// series 按粒度聚合每日计数，区间内没有数据的桶计为 0
+func (r *statsRange) series(stats []models.ModDailyStat) ([]dto.StatsPointResponse, dto.StatsCountResponse) {
+	var points []dto.StatsPointResponse
+	index := make(map[string]int)
+	for day := r.from; !day.After(r.to); day = day.AddDate(0, 0, 1) {
+		key := r.bucket(day).Format(statsDateLayout)
+		if _, ok := index[key]; !ok {
+			index[key] = len(points)
+			points = append(points, dto.StatsPointResponse{Date: key})
+		}
+	}
+
+	var totals dto.StatsCountResponse
+	for _, st := range stats {
+		day := time.Date(st.Date.Year(), st.Date.Month(), st.Date.Day(), 0, 0, 0, 0, r.from.Location())
+		i, ok := index[r.bucket(day).Format(statsDateLayout)]
+		if !ok {
+			continue
+		}
+		points[i].Downloads += st.Downloads
+		points[i].Views += st.Views
+		points[i].UniqueUsers += st.UniqueUsers
+		totals.Downloads += st.Downloads
+		totals.Views += st.Views
+		totals.UniqueUsers += st.UniqueUsers
+	}
+	return points, totals
+}
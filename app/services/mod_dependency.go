@@ -0,0 +1,448 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/version"
+)
+
+// ModDependencyService Mod 依赖服务
+type ModDependencyService struct {
+	modRepo repository.ModRepository
+	depRepo repository.ModDependencyRepository
+	log     *zap.Logger
+}
+
+// NewModDependencyService 创建 Mod 依赖服务实例
+func NewModDependencyService(
+	modRepo repository.ModRepository,
+	depRepo repository.ModDependencyRepository,
+	log *zap.Logger,
+) *ModDependencyService {
+	return &ModDependencyService{modRepo: modRepo, depRepo: depRepo, log: log}
+}
+
+// CreateDependency 为 Mod 添加依赖关系（仅作者或共同维护者）
+func (s *ModDependencyService) CreateDependency(modID, userID uint, req dto.ModDependencySaveRequest) (*dto.ModDependencyResponse, error) {
+	mod, err := findEditableMod(s.modRepo, modID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureNotDeclared(modID, req.DependsOnID); err != nil {
+		return nil, err
+	}
+
+	dep := &models.ModDependency{ModID: modID}
+	if err := s.fillDependency(dep, mod, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.depRepo.Create(dep); err != nil {
+		s.log.Error("create mod dependency failed", zap.Uint("mod_id", modID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "创建依赖关系失败")
+	}
+	return toModDependencyResponse(*dep), nil
+}
+
+// UpdateDependency 更新 Mod 依赖关系（仅作者或共同维护者）
+func (s *ModDependencyService) UpdateDependency(modID, depID, userID uint, req dto.ModDependencySaveRequest) (*dto.ModDependencyResponse, error) {
+	mod, err := findEditableMod(s.modRepo, modID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dep, err := s.findDependency(modID, depID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DependsOnID != dep.DependsOnID {
+		if err := s.ensureNotDeclared(modID, req.DependsOnID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.fillDependency(dep, mod, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.depRepo.Update(dep); err != nil {
+		s.log.Error("update mod dependency failed", zap.Uint("dependency_id", depID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "更新依赖关系失败")
+	}
+	return toModDependencyResponse(*dep), nil
+}
+
+// DeleteDependency 删除 Mod 依赖关系（仅作者或共同维护者）
+func (s *ModDependencyService) DeleteDependency(modID, depID, userID uint) error {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return err
+	}
+	if _, err := s.findDependency(modID, depID); err != nil {
+		return err
+	}
+
+	if err := s.depRepo.Delete(depID); err != nil {
+		s.log.Error("delete mod dependency failed", zap.Uint("dependency_id", depID), zap.Error(err))
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除依赖关系失败")
+	}
+	return nil
+}
+
+// ResolveDependencies 解析 Mod 的完整安装集合
+// 沿必需依赖（includeOptional 时包括可选依赖）展开依赖图，按拓扑序返回（依赖在前，Mod 自身在最后）；
+// 存在循环依赖、不兼容的 Mod 或版本约束不满足时返回错误
+func (s *ModDependencyService) ResolveDependencies(modID uint, includeOptional bool) (*dto.ModDependencyResolveResponse, error) {
+	root, err := s.modRepo.FindByID(modID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	graph, err := s.loadGraph(root, includeOptional)
+	if err != nil {
+		s.log.Error("load mod dependency graph failed", zap.Uint("mod_id", modID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询依赖关系失败")
+	}
+
+	order, cycle := graph.topoSort()
+	if cycle != nil {
+		return nil, bizErr.ErrDependencyCycle.WithParams(bizErr.Params{"path": graph.pathString(cycle)})
+	}
+
+	if conflicts := graph.conflicts(order); len(conflicts) > 0 {
+		return nil, bizErr.ErrDependencyConflict.WithParams(bizErr.Params{"conflicts": strings.Join(conflicts, "；")})
+	}
+
+	required := graph.requiredSet()
+	install := make([]dto.ModInstallItemResponse, len(order))
+	for i, id := range order {
+		mod := graph.nodes[id]
+		install[i] = dto.ModInstallItemResponse{
+			ID:       mod.ID,
+			Name:     mod.Name,
+			Version:  mod.Version,
+			Optional: !required[id],
+		}
+	}
+
+	direct := graph.edges[root.ID]
+	dependencies := make([]dto.ModDependencyResponse, len(direct))
+	for i, dep := range direct {
+		dependencies[i] = *toModDependencyResponse(dep)
+	}
+
+	return &dto.ModDependencyResolveResponse{
+		ModID:        root.ID,
+		Dependencies: dependencies,
+		InstallOrder: install,
+	}, nil
+}
+
+// findDependency 查询属于指定 Mod 的依赖关系
+func (s *ModDependencyService) findDependency(modID, depID uint) (*models.ModDependency, error) {
+	dep, err := s.depRepo.FindByID(depID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrDependencyNotFound)
+	}
+	if dep.ModID != modID {
+		return nil, bizErr.ErrDependencyNotFound
+	}
+	return dep, nil
+}
+
+// ensureNotDeclared 确认 Mod 尚未声明与目标 Mod 的依赖关系
+func (s *ModDependencyService) ensureNotDeclared(modID, dependsOnID uint) error {
+	deps, err := s.depRepo.FindByModIDs([]uint{modID})
+	if err != nil {
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "查询依赖关系失败")
+	}
+	for _, dep := range deps {
+		if dep.DependsOnID == dependsOnID {
+			return bizErr.ErrDependencyExists
+		}
+	}
+	return nil
+}
+
+// fillDependency 校验请求并写入依赖关系
+func (s *ModDependencyService) fillDependency(dep *models.ModDependency, mod *models.Mod, req dto.ModDependencySaveRequest) error {
+	if req.DependsOnID == mod.ID {
+		return bizErr.ErrDependencySelf
+	}
+
+	constraint, err := version.ParseConstraint(req.VersionConstraint)
+	if err != nil {
+		return bizErr.ErrVersionConstraint
+	}
+
+	target, err := s.modRepo.FindByID(req.DependsOnID)
+	if err != nil {
+		return notFoundOr(err, bizErr.ErrDependencyTarget)
+	}
+
+	// 必需/可选依赖不能形成环：目标 Mod 不能（间接）依赖当前 Mod
+	if req.Type != models.DependencyIncompatible {
+		path, err := s.dependencyPath(target, mod.ID)
+		if err != nil {
+			return bizErr.Wrap(err, bizErr.CodeInternalError, "查询依赖关系失败")
+		}
+		if path != nil {
+			return bizErr.ErrDependencyWouldCycle.WithParams(bizErr.Params{"path": mod.Name + " -> " + path.String()})
+		}
+	}
+
+	dep.DependsOnID = target.ID
+	dep.DependsOn = *target
+	dep.Type = req.Type
+	dep.VersionConstraint = constraint.String()
+	return nil
+}
+
+// dependencyPath 查找 from 沿必需/可选依赖到达 to 的路径，不可达时返回 nil
+func (s *ModDependencyService) dependencyPath(from *models.Mod, to uint) (dependencyPath, error) {
+	parent := map[uint]uint{from.ID: 0}
+	names := map[uint]string{from.ID: from.Name}
+	frontier := []uint{from.ID}
+
+	for len(frontier) > 0 {
+		deps, err := s.depRepo.FindByModIDs(frontier)
+		if err != nil {
+			return nil, err
+		}
+
+		frontier = nil
+		for _, dep := range deps {
+			if dep.Type == models.DependencyIncompatible {
+				continue
+			}
+			if _, seen := parent[dep.DependsOnID]; seen {
+				continue
+			}
+			parent[dep.DependsOnID] = dep.ModID
+			names[dep.DependsOnID] = dep.DependsOn.Name
+
+			if dep.DependsOnID == to {
+				var path dependencyPath
+				for id := to; id != 0; id = parent[id] {
+					path = append(dependencyPath{names[id]}, path...)
+				}
+				return path, nil
+			}
+			frontier = append(frontier, dep.DependsOnID)
+		}
+	}
+	return nil, nil
+}
+
+// loadGraph 从根 Mod 逐层加载依赖图
+func (s *ModDependencyService) loadGraph(root *models.Mod, includeOptional bool) (*dependencyGraph, error) {
+	return loadDependencyGraph(s.depRepo, []models.Mod{*root}, includeOptional)
+}
+
+// loadDependencyGraph 从一组根 Mod 逐层加载合并的依赖图
+func loadDependencyGraph(repo repository.ModDependencyRepository, roots []models.Mod, includeOptional bool) (*dependencyGraph, error) {
+	g := &dependencyGraph{
+		nodes:           make(map[uint]models.Mod, len(roots)),
+		edges:           make(map[uint][]models.ModDependency),
+		includeOptional: includeOptional,
+	}
+
+	var frontier []uint
+	for _, root := range roots {
+		if _, ok := g.nodes[root.ID]; ok {
+			continue
+		}
+		g.roots = append(g.roots, root.ID)
+		g.nodes[root.ID] = root
+		frontier = append(frontier, root.ID)
+	}
+
+	for len(frontier) > 0 {
+		deps, err := repo.FindByModIDs(frontier)
+		if err != nil {
+			return nil, err
+		}
+
+		frontier = nil
+		for _, dep := range deps {
+			g.edges[dep.ModID] = append(g.edges[dep.ModID], dep)
+			if !g.follows(dep) {
+				continue
+			}
+			if _, ok := g.nodes[dep.DependsOnID]; ok {
+				continue
+			}
+			g.nodes[dep.DependsOnID] = dep.DependsOn
+			frontier = append(frontier, dep.DependsOnID)
+		}
+	}
+	return g, nil
+}
+
+// dependencyPath 依赖路径（Mod 名称）
+type dependencyPath []string
+
+func (p dependencyPath) String() string {
+	return strings.Join(p, " -> ")
+}
+
+// dependencyGraph 依赖图（nodes 为安装集合）
+type dependencyGraph struct {
+	roots           []uint
+	nodes           map[uint]models.Mod
+	edges           map[uint][]models.ModDependency
+	includeOptional bool
+	// pinned 锁定的版本（如合集中指定的发布版本），校验版本约束时优先于 Mod 的当前版本
+	pinned map[uint]string
+}
+
+// version 安装集合中 Mod 的版本
+func (g *dependencyGraph) version(id uint) string {
+	if v, ok := g.pinned[id]; ok {
+		return v
+	}
+	return g.nodes[id].Version
+}
+
+// follows 是否沿该依赖关系展开
+func (g *dependencyGraph) follows(dep models.ModDependency) bool {
+	return dep.Type == models.DependencyRequired ||
+		(dep.Type == models.DependencyOptional && g.includeOptional)
+}
+
+// topoSort 深度优先拓扑排序，存在环时返回环上的 Mod ID（首尾相同）
+func (g *dependencyGraph) topoSort() (order []uint, cycle []uint) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[uint]int, len(g.nodes))
+	var stack []uint
+
+	var visit func(id uint) bool
+	visit = func(id uint) bool {
+		switch state[id] {
+		case done:
+			return true
+		case visiting:
+			for i, v := range stack {
+				if v == id {
+					cycle = append(append([]uint{}, stack[i:]...), id)
+					break
+				}
+			}
+			return false
+		}
+
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, dep := range g.edges[id] {
+			if g.follows(dep) && !visit(dep.DependsOnID) {
+				return false
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = done
+		order = append(order, id)
+		return true
+	}
+
+	for _, root := range g.roots {
+		if !visit(root) {
+			return nil, cycle
+		}
+	}
+	return order, nil
+}
+
+// conflicts 检查安装集合中的不兼容关系和版本约束
+func (g *dependencyGraph) conflicts(order []uint) []string {
+	var conflicts []string
+	seen := make(map[string]bool)
+	add := func(msg string) {
+		if !seen[msg] {
+			seen[msg] = true
+			conflicts = append(conflicts, msg)
+		}
+	}
+
+	for _, id := range order {
+		mod := g.nodes[id]
+		for _, dep := range g.edges[id] {
+			target, installed := g.nodes[dep.DependsOnID]
+			if !installed {
+				continue
+			}
+
+			if dep.Type == models.DependencyIncompatible {
+				add(fmt.Sprintf("%s 与 %s 不兼容", mod.Name, target.Name))
+				continue
+			}
+			if !g.follows(dep) || dep.VersionConstraint == "" {
+				continue
+			}
+
+			constraint, err := version.ParseConstraint(dep.VersionConstraint)
+			if err != nil {
+				add(fmt.Sprintf("%s 对 %s 的版本约束 %q 无法识别", mod.Name, target.Name, dep.VersionConstraint))
+				continue
+			}
+			targetVersion := g.version(target.ID)
+			v, err := version.Parse(targetVersion)
+			if err != nil || !constraint.Check(v) {
+				add(fmt.Sprintf("%s 需要 %s %s，当前版本为 %s", mod.Name, target.Name, dep.VersionConstraint, targetVersion))
+			}
+		}
+	}
+	return conflicts
+}
+
+// requiredSet 根 Mod 及仅沿必需依赖可达的 Mod
+func (g *dependencyGraph) requiredSet() map[uint]bool {
+	set := make(map[uint]bool, len(g.nodes))
+	queue := append([]uint{}, g.roots...)
+	for _, id := range g.roots {
+		set[id] = true
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, dep := range g.edges[id] {
+			if dep.Type == models.DependencyRequired && !set[dep.DependsOnID] {
+				set[dep.DependsOnID] = true
+				queue = append(queue, dep.DependsOnID)
+			}
+		}
+	}
+	return set
+}
+
+// pathString 将 Mod ID 路径转换为名称路径
+func (g *dependencyGraph) pathString(ids []uint) string {
+	path := make(dependencyPath, len(ids))
+	for i, id := range ids {
+		path[i] = g.nodes[id].Name
+	}
+	return path.String()
+}
+
+// toModDependencyResponse 转换依赖关系响应
+func toModDependencyResponse(dep models.ModDependency) *dto.ModDependencyResponse {
+	return &dto.ModDependencyResponse{
+		ID:                dep.ID,
+		ModID:             dep.ModID,
+		DependsOnID:       dep.DependsOnID,
+		DependsOnName:     dep.DependsOn.Name,
+		Type:              dep.Type,
+		VersionConstraint: dep.VersionConstraint,
+		CreatedAt:         dep.CreatedAt,
+	}
+}
@@ -0,0 +1,518 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/imaging"
+	"gin-web/pkg/storage"
+)
+
+const (
+	defaultImageMaxUploadSize = 10 << 20
+	defaultImageMaxPixels     = 40_000_000
+	defaultImageMaxPerMod     = 20
+	defaultImageMaxDimension  = 1920
+	defaultThumbnailWidth     = 400
+	defaultThumbnailHeight    = 300
+	defaultJPEGQuality        = 85
+	defaultImageRetryAfter    = 10 * time.Minute
+	// imageMaintenanceBatch 维护任务每次处理的截图数量
+	imageMaintenanceBatch = 100
+)
+
+// 截图各尺寸文件在截图目录下的文件名
+const (
+	thumbnailJPEGName = "thumb.jpg"
+	thumbnailWebPName = "thumb.webp"
+	largeWebPName     = "large.webp"
+	coverJPEGName     = "cover.jpg"
+	coverWebPName     = "cover.webp"
+)
+
+// ImageOptions 图片上传与处理参数（零值使用默认值）
+type ImageOptions struct {
+	MaxUploadSize   int64         // 单张图片大小上限（字节）
+	MaxPixels       int           // 单张图片像素数上限
+	MaxPerMod       int           // 每个 Mod 的截图数量上限
+	MaxDimension    int           // 大图及游戏封面的最长边
+	ThumbnailWidth  int           // 缩略图最大宽度
+	ThumbnailHeight int           // 缩略图最大高度
+	JPEGQuality     int           // JPEG 质量
+	RetryAfter      time.Duration // 待处理超过该时长的截图会被重新投递
+}
+
+func (o ImageOptions) withDefaults() ImageOptions {
+	if o.MaxUploadSize <= 0 {
+		o.MaxUploadSize = defaultImageMaxUploadSize
+	}
+	if o.MaxPixels <= 0 {
+		o.MaxPixels = defaultImageMaxPixels
+	}
+	if o.MaxPerMod <= 0 {
+		o.MaxPerMod = defaultImageMaxPerMod
+	}
+	if o.MaxDimension <= 0 {
+		o.MaxDimension = defaultImageMaxDimension
+	}
+	if o.ThumbnailWidth <= 0 {
+		o.ThumbnailWidth = defaultThumbnailWidth
+	}
+	if o.ThumbnailHeight <= 0 {
+		o.ThumbnailHeight = defaultThumbnailHeight
+	}
+	if o.JPEGQuality <= 0 || o.JPEGQuality > 100 {
+		o.JPEGQuality = defaultJPEGQuality
+	}
+	if o.RetryAfter <= 0 {
+		o.RetryAfter = defaultImageRetryAfter
+	}
+	return o
+}
+
+// ModImageQueue 截图处理任务队列
+type ModImageQueue interface {
+	EnqueueModImage(imageID uint) error
+}
+
+// ModImageService Mod 截图与游戏封面上传服务
+// 截图原图上传后立即返回，缩略图与 WebP 由后台任务（ImageProcessor）生成；游戏封面上传时同步处理
+type ModImageService struct {
+	repo     repository.ModImageRepository
+	modRepo  repository.ModRepository
+	gameRepo repository.GameRepository
+	storage  storage.Storage
+	queue    ModImageQueue
+	opts     ImageOptions
+	log      *zap.Logger
+}
+
+// NewModImageService 创建截图服务实例
+// queue 为空时不投递处理任务，截图由维护任务定期重新投递
+func NewModImageService(
+	repo repository.ModImageRepository,
+	modRepo repository.ModRepository,
+	gameRepo repository.GameRepository,
+	store storage.Storage,
+	queue ModImageQueue,
+	opts ImageOptions,
+	log *zap.Logger,
+) *ModImageService {
+	return &ModImageService{
+		repo:     repo,
+		modRepo:  modRepo,
+		gameRepo: gameRepo,
+		storage:  store,
+		queue:    queue,
+		opts:     opts.withDefaults(),
+		log:      log,
+	}
+}
+
+// MaxUploadSize 单张图片大小上限（字节）
+func (s *ModImageService) MaxUploadSize() int64 {
+	return s.opts.MaxUploadSize
+}
+
+// List 查询 Mod 的全部截图，包括处理中和处理失败的截图（仅作者或共同维护者）
+func (s *ModImageService) List(modID, userID uint) (*dto.ModImageListResponse, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+	return s.list(modID)
+}
+
+// Upload 上传截图（仅作者或共同维护者），保存原图后投递处理任务
+func (s *ModImageService) Upload(modID, userID uint, r io.Reader, caption string) (*dto.ModImageResponse, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountByMod(modID)
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询截图失败")
+	}
+	if count >= int64(s.opts.MaxPerMod) {
+		return nil, bizErr.ErrModImageLimit
+	}
+
+	data, info, err := s.readImage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := fmt.Sprintf("mods/%d/images/%s", modID, randomName())
+	image := &models.ModImage{
+		ModID:       modID,
+		Caption:     caption,
+		Status:      models.ModImageStatusPending,
+		StorageKey:  dir,
+		OriginalKey: dir + "/original." + imageExtension(info.Format),
+		ContentType: info.ContentType,
+		Size:        int64(len(data)),
+		Width:       info.Width,
+		Height:      info.Height,
+		UploadedBy:  userID,
+	}
+	image.URL = s.storage.URL(image.OriginalKey)
+
+	ctx := context.Background()
+	if err := s.storage.Put(ctx, image.OriginalKey, bytes.NewReader(data), info.ContentType); err != nil {
+		s.log.Error("store mod image failed", zap.Uint("mod_id", modID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "保存图片失败")
+	}
+	if err := s.repo.Create(image); err != nil {
+		s.deleteFiles(image)
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "保存截图失败")
+	}
+
+	s.enqueue(image.ID)
+	result := toModImageResponse(*image)
+	return &result, nil
+}
+
+// UpdateCaption 修改截图说明（仅作者或共同维护者）
+func (s *ModImageService) UpdateCaption(modID, imageID, userID uint, caption string) (*dto.ModImageResponse, error) {
+	image, err := s.findImage(modID, imageID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateCaption(image.ID, caption); err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "修改截图说明失败")
+	}
+	image.Caption = caption
+	result := toModImageResponse(*image)
+	return &result, nil
+}
+
+// Reorder 调整截图顺序（仅作者或共同维护者），imageIDs 需包含该 Mod 的全部截图
+func (s *ModImageService) Reorder(modID, userID uint, imageIDs []uint) (*dto.ModImageListResponse, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+
+	images, err := s.repo.ListByMod(modID)
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询截图失败")
+	}
+	if len(imageIDs) != len(images) || len(uniqueIDs(imageIDs)) != len(imageIDs) {
+		return nil, bizErr.ErrModImageOrderInvalid
+	}
+	existing := make(map[uint]struct{}, len(images))
+	for _, image := range images {
+		existing[image.ID] = struct{}{}
+	}
+	for _, id := range imageIDs {
+		if _, ok := existing[id]; !ok {
+			return nil, bizErr.ErrModImageOrderInvalid
+		}
+	}
+
+	if err := s.repo.Reorder(modID, imageIDs); err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "调整截图顺序失败")
+	}
+	return s.list(modID)
+}
+
+// Delete 删除截图及其文件（仅作者或共同维护者）
+func (s *ModImageService) Delete(modID, imageID, userID uint) error {
+	image, err := s.findImage(modID, imageID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(image); err != nil {
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除截图失败")
+	}
+	s.deleteFiles(image)
+	return nil
+}
+
+// UploadGameCover 上传游戏封面（管理员），同步生成 JPEG 与 WebP 并更新游戏的封面地址
+func (s *ModImageService) UploadGameCover(gameID uint, r io.Reader) (*dto.GameCoverResponse, error) {
+	if _, err := s.gameRepo.FindByID(gameID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bizErr.ErrGameNotFound
+		}
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询游戏失败")
+	}
+
+	data, _, err := s.readImage(r)
+	if err != nil {
+		return nil, err
+	}
+	img, err := imaging.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, bizErr.ErrImageFormat
+	}
+	img = imaging.Fit(img, s.opts.MaxDimension, s.opts.MaxDimension)
+
+	dir := fmt.Sprintf("games/%d/cover/%s", gameID, randomName())
+	ctx := context.Background()
+	jpegKey, webpKey := dir+"/"+coverJPEGName, dir+"/"+coverWebPName
+	if err := putJPEG(ctx, s.storage, jpegKey, img, s.opts.JPEGQuality); err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "保存封面失败")
+	}
+	if err := putWebP(ctx, s.storage, webpKey, img); err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "保存封面失败")
+	}
+
+	result := &dto.GameCoverResponse{CoverImage: s.storage.URL(jpegKey), CoverWebP: s.storage.URL(webpKey)}
+	if err := s.gameRepo.UpdateCover(gameID, result.CoverImage); err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "更新游戏封面失败")
+	}
+	return result, nil
+}
+
+// Maintain 重新投递积压的待处理截图，并清理所属 Mod 已删除的截图及其文件（由定时任务调用）
+func (s *ModImageService) Maintain(now time.Time) error {
+	stale, err := s.repo.FindStale(now.Add(-s.opts.RetryAfter), imageMaintenanceBatch)
+	if err != nil {
+		return err
+	}
+	for _, image := range stale {
+		s.enqueue(image.ID)
+	}
+
+	orphans, err := s.repo.FindOrphans(imageMaintenanceBatch)
+	if err != nil {
+		return err
+	}
+	for i := range orphans {
+		if err := s.repo.Delete(&orphans[i]); err != nil {
+			return err
+		}
+		s.deleteFiles(&orphans[i])
+	}
+
+	if len(stale) > 0 || len(orphans) > 0 {
+		s.log.Info("mod images maintained", zap.Int("requeued", len(stale)), zap.Int("purged", len(orphans)))
+	}
+	return nil
+}
+
+func (s *ModImageService) list(modID uint) (*dto.ModImageListResponse, error) {
+	images, err := s.repo.ListByMod(modID)
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询截图失败")
+	}
+	return &dto.ModImageListResponse{List: toModImageResponses(images)}, nil
+}
+
+// findImage 校验编辑权限并查询属于该 Mod 的截图
+func (s *ModImageService) findImage(modID, imageID, userID uint) (*models.ModImage, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+	image, err := s.repo.FindByID(imageID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, bizErr.ErrModImageNotFound
+	}
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询截图失败")
+	}
+	if image.ModID != modID {
+		return nil, bizErr.ErrModImageNotFound
+	}
+	return image, nil
+}
+
+// readImage 读取上传内容并校验大小、格式与像素数（只解析图片头部）
+func (s *ModImageService) readImage(r io.Reader) ([]byte, *imaging.Info, error) {
+	data, err := io.ReadAll(io.LimitReader(r, s.opts.MaxUploadSize+1))
+	if err != nil {
+		return nil, nil, bizErr.Wrap(err, bizErr.CodeImageInvalid, "读取图片失败")
+	}
+	if len(data) == 0 {
+		return nil, nil, bizErr.ErrImageRequired
+	}
+	if int64(len(data)) > s.opts.MaxUploadSize {
+		return nil, nil, bizErr.ErrImageTooLarge
+	}
+
+	info, err := imaging.Inspect(data, s.opts.MaxPixels)
+	if errors.Is(err, imaging.ErrImageTooLarge) {
+		return nil, nil, bizErr.ErrImageDimensions
+	}
+	if err != nil {
+		return nil, nil, bizErr.ErrImageFormat
+	}
+	return data, info, nil
+}
+
+// enqueue 投递处理任务（失败只记录日志，由维护任务重新投递）
+func (s *ModImageService) enqueue(imageID uint) {
+	if s.queue == nil {
+		return
+	}
+	if err := s.queue.EnqueueModImage(imageID); err != nil {
+		s.log.Warn("enqueue mod image failed", zap.Uint("image_id", imageID), zap.Error(err))
+	}
+}
+
+// deleteFiles 删除截图的原图及生成的文件（失败只记录日志）
+func (s *ModImageService) deleteFiles(image *models.ModImage) {
+	ctx := context.Background()
+	keys := []string{
+		image.OriginalKey,
+		image.StorageKey + "/" + thumbnailJPEGName,
+		image.StorageKey + "/" + thumbnailWebPName,
+		image.StorageKey + "/" + largeWebPName,
+	}
+	for _, key := range keys {
+		if err := s.storage.Delete(ctx, key); err != nil {
+			s.log.Warn("delete image file failed", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// ImageProcessor 截图处理任务：生成缩略图（JPEG / WebP）与大图（WebP）
+type ImageProcessor struct {
+	repo    repository.ModImageRepository
+	storage storage.Storage
+	opts    ImageOptions
+	log     *zap.Logger
+}
+
+// NewImageProcessor 创建截图处理任务实例
+func NewImageProcessor(repo repository.ModImageRepository, store storage.Storage, opts ImageOptions, log *zap.Logger) *ImageProcessor {
+	return &ImageProcessor{repo: repo, storage: store, opts: opts.withDefaults(), log: log}
+}
+
+// Process 处理截图，重复投递时跳过已处理的截图
+// 原图无法解码时标记为处理失败；存储或数据库错误返回 error，由队列或维护任务重试
+func (p *ImageProcessor) Process(imageID uint) error {
+	image, err := p.repo.FindByID(imageID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if image.Status != models.ModImageStatusPending {
+		return nil
+	}
+
+	ctx := context.Background()
+	rc, err := p.storage.Get(ctx, image.OriginalKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return p.fail(image, "原图不存在")
+	}
+	if err != nil {
+		return err
+	}
+	img, err := imaging.Decode(rc)
+	rc.Close()
+	if err != nil {
+		return p.fail(image, "无法解码图片")
+	}
+
+	thumb := imaging.Fit(img, p.opts.ThumbnailWidth, p.opts.ThumbnailHeight)
+	large := imaging.Fit(img, p.opts.MaxDimension, p.opts.MaxDimension)
+	thumbJPEG := image.StorageKey + "/" + thumbnailJPEGName
+	thumbWebP := image.StorageKey + "/" + thumbnailWebPName
+	largeWebP := image.StorageKey + "/" + largeWebPName
+	if err := putJPEG(ctx, p.storage, thumbJPEG, thumb, p.opts.JPEGQuality); err != nil {
+		return err
+	}
+	if err := putWebP(ctx, p.storage, thumbWebP, thumb); err != nil {
+		return err
+	}
+	if err := putWebP(ctx, p.storage, largeWebP, large); err != nil {
+		return err
+	}
+
+	image.Status = models.ModImageStatusReady
+	image.Error = ""
+	image.ThumbnailURL = p.storage.URL(thumbJPEG)
+	image.ThumbnailWebPURL = p.storage.URL(thumbWebP)
+	image.WebPURL = p.storage.URL(largeWebP)
+	if err := p.repo.Update(image); err != nil {
+		return err
+	}
+
+	p.log.Info("mod image processed", zap.Uint("image_id", image.ID), zap.Uint("mod_id", image.ModID))
+	return nil
+}
+
+// fail 标记截图处理失败（不再重试）
+func (p *ImageProcessor) fail(image *models.ModImage, reason string) error {
+	p.log.Warn("mod image processing failed", zap.Uint("image_id", image.ID), zap.String("reason", reason))
+	image.Status = models.ModImageStatusFailed
+	image.Error = reason
+	return p.repo.Update(image)
+}
+
+func putJPEG(ctx context.Context, store storage.Storage, key string, img *image.NRGBA, quality int) error {
+	var buf bytes.Buffer
+	if err := imaging.EncodeJPEG(&buf, img, quality); err != nil {
+		return err
+	}
+	return store.Put(ctx, key, &buf, "image/jpeg")
+}
+
+func putWebP(ctx context.Context, store storage.Storage, key string, img *image.NRGBA) error {
+	var buf bytes.Buffer
+	if err := imaging.EncodeWebP(&buf, img); err != nil {
+		return err
+	}
+	return store.Put(ctx, key, &buf, "image/webp")
+}
+
+// imageExtension 原图文件扩展名
+func imageExtension(format string) string {
+	if format == "jpeg" {
+		return "jpg"
+	}
+	return format
+}
+
+// randomName 生成随机目录名，避免覆盖旧文件导致 CDN 缓存不一致
+func randomName() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// toModImageResponse 转换为截图响应
+func toModImageResponse(image models.ModImage) dto.ModImageResponse {
+	return dto.ModImageResponse{
+		ID:               image.ID,
+		Position:         image.Position,
+		Caption:          image.Caption,
+		Status:           image.Status,
+		Error:            image.Error,
+		Width:            image.Width,
+		Height:           image.Height,
+		URL:              image.URL,
+		ThumbnailURL:     image.ThumbnailURL,
+		ThumbnailWebPURL: image.ThumbnailWebPURL,
+		WebPURL:          image.WebPURL,
+		CreatedAt:        image.CreatedAt,
+	}
+}
+
+func toModImageResponses(images []models.ModImage) []dto.ModImageResponse {
+	result := make([]dto.ModImageResponse, len(images))
+	for i, image := range images {
+		result[i] = toModImageResponse(image)
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"sort"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/version"
+)
+
+// ModReleaseService Mod 发布版本服务
+type ModReleaseService struct {
+	modRepo     repository.ModRepository
+	releaseRepo repository.ModReleaseRepository
+	log         *zap.Logger
+}
+
+// NewModReleaseService 创建 Mod 发布版本服务实例
+func NewModReleaseService(modRepo repository.ModRepository, releaseRepo repository.ModReleaseRepository, log *zap.Logger) *ModReleaseService {
+	return &ModReleaseService{modRepo: modRepo, releaseRepo: releaseRepo, log: log}
+}
+
+// ListReleases 获取 Mod 的发布版本列表（按版本号从新到旧，版本号相同或无法解析时新发布的在前）
+func (s *ModReleaseService) ListReleases(modID uint) (*dto.ModReleaseListResponse, error) {
+	if _, err := s.modRepo.FindByID(modID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	releases, err := s.releaseRepo.FindByModID(modID)
+	if err != nil {
+		return nil, err
+	}
+	sortReleases(releases)
+	return &dto.ModReleaseListResponse{List: releases, Latest: latestRelease(releases)}, nil
+}
+
+// CreateRelease 发布 Mod 版本并声明兼容的游戏版本（仅作者或共同维护者）
+func (s *ModReleaseService) CreateRelease(modID, userID uint, req dto.ModReleaseSaveRequest) (*models.ModRelease, error) {
+	mod, err := findEditableMod(s.modRepo, modID, userID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := version.Parse(req.Version)
+	if err != nil {
+		return nil, bizErr.ErrReleaseVersionInvalid
+	}
+
+	releases, err := s.releaseRepo.FindByModID(modID)
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询发布版本失败")
+	}
+	// 规范化后相同的版本号视为重复（如 1.2 与 1.2.0）
+	for _, r := range releases {
+		existing, err := version.Parse(r.Version)
+		if r.Version == req.Version || (err == nil && existing.Normalized() == v.Normalized()) {
+			return nil, bizErr.ErrReleaseExists
+		}
+	}
+
+	gameVersions, err := findGameVersions(s.modRepo, mod.GameID, req.GameVersionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	release := &models.ModRelease{
+		ModID:        modID,
+		Version:      req.Version,
+		Changelog:    req.Changelog,
+		DownloadURL:  req.DownloadURL,
+		FileSize:     req.FileSize,
+		GameVersions: gameVersions,
+	}
+	if err := s.releaseRepo.Create(release); err != nil {
+		s.log.Error("create mod release failed", zap.Uint("mod_id", modID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "发布版本失败")
+	}
+	return release, nil
+}
+
+// DeleteRelease 删除发布版本（仅作者或共同维护者）
+func (s *ModReleaseService) DeleteRelease(modID, releaseID, userID uint) error {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return err
+	}
+	release, err := s.releaseRepo.FindByID(releaseID)
+	if err != nil {
+		return notFoundOr(err, bizErr.ErrReleaseNotFound)
+	}
+	if release.ModID != modID {
+		return bizErr.ErrReleaseNotFound
+	}
+
+	if err := s.releaseRepo.Delete(releaseID); err != nil {
+		s.log.Error("delete mod release failed", zap.Uint("release_id", releaseID), zap.Error(err))
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除发布版本失败")
+	}
+	return nil
+}
+
+// sortReleases 按版本号从新到旧排序，无法解析的版本号排在最后（保持仓储返回的发布时间顺序）
+func sortReleases(releases []models.ModRelease) {
+	sort.SliceStable(releases, func(i, j int) bool {
+		return version.CompareStrings(releases[i].Version, releases[j].Version) > 0
+	})
+}
+
+// latestRelease 返回最新的正式版本号，没有正式版本时返回最新的预发布版本号（releases 需已排序）
+func latestRelease(releases []models.ModRelease) string {
+	latest := ""
+	for _, r := range releases {
+		v, err := version.Parse(r.Version)
+		if err != nil {
+			continue
+		}
+		if !v.IsPrerelease() {
+			return r.Version
+		}
+		if latest == "" {
+			latest = r.Version
+		}
+	}
+	return latest
+}
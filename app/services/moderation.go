@@ -0,0 +1,196 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// reviewTransitions 审核操作允许的起始状态及目标状态
+var reviewTransitions = map[string]struct {
+	from []string
+	to   string
+}{
+	models.ReviewActionSubmit:  {from: []string{models.ModStatusDraft, models.ModStatusRejected}, to: models.ModStatusPending},
+	models.ReviewActionApprove: {from: []string{models.ModStatusPending, models.ModStatusRejected, models.ModStatusHidden}, to: models.ModStatusApproved},
+	models.ReviewActionReject:  {from: []string{models.ModStatusPending}, to: models.ModStatusRejected},
+	models.ReviewActionHide:    {from: []string{models.ModStatusApproved}, to: models.ModStatusHidden},
+}
+
+// ModerationService Mod 审核服务
+type ModerationService struct {
+	repo     repository.ModRepository
+	notifier *NotificationService
+	log      *zap.Logger
+}
+
+// NewModerationService 创建 Mod 审核服务实例
+// notifier 为空时不通知作者
+func NewModerationService(repo repository.ModRepository, notifier *NotificationService, log *zap.Logger) *ModerationService {
+	return &ModerationService{repo: repo, notifier: notifier, log: log}
+}
+
+// GetQueue 按审核状态获取 Mod 列表（默认待审核），先提交的在前
+func (s *ModerationService) GetQueue(req dto.ModerationQueueRequest) (*dto.ModerationQueueResponse, error) {
+	status := req.Status
+	if status == "" {
+		status = models.ModStatusPending
+	}
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	mods, total, err := s.repo.FindByStatus(status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.ModerationItemResponse, len(mods))
+	for i, mod := range mods {
+		items[i] = dto.ModerationItemResponse{
+			ModItemResponse: toModItemResponse(mod, nil),
+			Status:          mod.Status,
+		}
+	}
+	return &dto.ModerationQueueResponse{
+		List:       items,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// SubmitMod 作者或共同维护者将草稿或被驳回的 Mod 提交审核
+func (s *ModerationService) SubmitMod(modID, userID uint) (*dto.ModStatusResponse, error) {
+	mod, err := s.repo.FindByID(modID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+	if !mod.CanEdit(userID) {
+		return nil, bizErr.ErrNotModEditor
+	}
+
+	if _, err := s.transition(mod, userID, models.ReviewActionSubmit, ""); err != nil {
+		return nil, err
+	}
+	return toModStatusResponse(mod), nil
+}
+
+// ReviewMod 审核员通过、驳回或下架 Mod，并通知提交者
+func (s *ModerationService) ReviewMod(modID, reviewerID uint, req dto.ModReviewRequest) (*dto.ModStatusResponse, error) {
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" && req.Action != models.ReviewActionApprove {
+		return nil, bizErr.ErrReviewReasonRequired
+	}
+
+	mod, err := s.repo.FindByID(modID)
+	if err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	review, err := s.transition(mod, reviewerID, req.Action, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyOwner(mod, review)
+	return toModStatusResponse(mod), nil
+}
+
+// HideMod 下架已公开的 Mod 并通知作者（operatorID 为 0 表示系统自动下架）
+func (s *ModerationService) HideMod(modID, operatorID uint, reason string) error {
+	mod, err := s.repo.FindByID(modID)
+	if err != nil {
+		return notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	review, err := s.transition(mod, operatorID, models.ReviewActionHide, reason)
+	if err != nil {
+		return err
+	}
+	s.notifyOwner(mod, review)
+	return nil
+}
+
+// GetReviews 获取 Mod 的审核记录
+func (s *ModerationService) GetReviews(modID uint) (*dto.ModReviewListResponse, error) {
+	if _, err := s.repo.FindByID(modID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	reviews, err := s.repo.FindReviews(modID)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ModReviewListResponse{List: reviews}, nil
+}
+
+// transition 校验状态流转并写入新状态及审核记录
+func (s *ModerationService) transition(mod *models.Mod, operatorID uint, action, reason string) (*models.ModReview, error) {
+	rule, ok := reviewTransitions[action]
+	if !ok {
+		return nil, bizErr.ErrModStatusInvalid
+	}
+	allowed := false
+	for _, from := range rule.from {
+		if mod.Status == from {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, bizErr.ErrModStatusInvalid
+	}
+
+	review := &models.ModReview{
+		ModID:      mod.ID,
+		OperatorID: operatorID,
+		Action:     action,
+		FromStatus: mod.Status,
+		ToStatus:   rule.to,
+		Reason:     reason,
+	}
+	mod.Status = rule.to
+	if err := s.repo.UpdateStatus(mod, review); err != nil {
+		s.log.Error("update mod status failed", zap.Uint("mod_id", mod.ID), zap.String("action", action), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "更新审核状态失败")
+	}
+	return review, nil
+}
+
+// notifyOwner 通知提交者审核结果（通知失败不影响审核本身）
+func (s *ModerationService) notifyOwner(mod *models.Mod, review *models.ModReview) {
+	if s.notifier == nil || mod.OwnerID == 0 {
+		return
+	}
+
+	var title string
+	switch review.ToStatus {
+	case models.ModStatusApproved:
+		title = fmt.Sprintf("你的 Mod「%s」已通过审核", mod.Name)
+	case models.ModStatusRejected:
+		title = fmt.Sprintf("你的 Mod「%s」未通过审核", mod.Name)
+	case models.ModStatusHidden:
+		title = fmt.Sprintf("你的 Mod「%s」已被下架", mod.Name)
+	}
+
+	if err := s.notifier.Notify(mod.OwnerID, models.NotificationModStatus, title, review.Reason, mod.ID); err != nil {
+		s.log.Warn("notify mod owner failed", zap.Uint("mod_id", mod.ID), zap.Error(err))
+	}
+}
+
+// toModStatusResponse 转换为审核状态响应
+func toModStatusResponse(mod *models.Mod) *dto.ModStatusResponse {
+	return &dto.ModStatusResponse{ID: mod.ID, Status: mod.Status, UpdatedAt: mod.UpdatedAt}
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	"gin-web/pkg/websocket"
+)
+
+// NotificationPusher 实时推送接口（由 WebSocket 管理器实现）
+type NotificationPusher interface {
+	SendToUser(userID string, message *websocket.Message)
+}
+
+// NotificationService 站内通知服务
+type NotificationService struct {
+	repo   repository.NotificationRepository
+	pusher NotificationPusher
+	log    *zap.Logger
+}
+
+// NewNotificationService 创建站内通知服务实例
+// pusher 为空时只保存通知，不做实时推送
+func NewNotificationService(repo repository.NotificationRepository, pusher NotificationPusher, log *zap.Logger) *NotificationService {
+	return &NotificationService{repo: repo, pusher: pusher, log: log}
+}
+
+// Notify 给用户发送通知，保存后尝试通过 WebSocket 推送给在线用户
+func (s *NotificationService) Notify(userID uint, typ, title, content string, relatedID uint) error {
+	notification := &models.Notification{
+		UserID:    userID,
+		Type:      typ,
+		Title:     title,
+		Content:   content,
+		RelatedID: relatedID,
+	}
+	if err := s.repo.Create(notification); err != nil {
+		return err
+	}
+
+	if s.pusher != nil {
+		s.pusher.SendToUser(strconv.FormatUint(uint64(userID), 10), &websocket.Message{
+			Type:    "notification",
+			Content: notification,
+		})
+	}
+	return nil
+}
+
+// ListNotifications 分页获取用户的通知
+func (s *NotificationService) ListNotifications(userID uint, req dto.NotificationListRequest) (*dto.NotificationListResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	notifications, total, err := s.repo.FindByUser(userID, req.Unread, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.repo.CountUnread(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.NotificationListResponse{
+		List:        notifications,
+		Total:       total,
+		UnreadCount: unread,
+		Page:        page,
+		PageSize:    pageSize,
+		TotalPages:  int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// MarkRead 将通知标记为已读
+func (s *NotificationService) MarkRead(userID, id uint) error {
+	return s.repo.MarkRead(userID, id)
+}
+
+// MarkAllRead 将用户的全部通知标记为已读
+func (s *NotificationService) MarkAllRead(userID uint) error {
+	return s.repo.MarkAllRead(userID)
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// reportTarget 可举报对象的处理方式
+type reportTarget struct {
+	// visible 对象是否存在且公开可见
+	visible func(id uint) bool
+	// hide 隐藏对象，operatorID 为 0 表示达到举报阈值后系统自动隐藏
+	hide func(id, operatorID uint, reason string) error
+}
+
+// ReportService 内容举报服务
+type ReportService struct {
+	repo          repository.ReportRepository
+	targets       map[string]reportTarget
+	hideThreshold int64
+	log           *zap.Logger
+}
+
+// NewReportService 创建内容举报服务实例
+// hideThreshold 为对象待处理举报数达到多少时自动隐藏，小于 1 时不自动隐藏
+func NewReportService(
+	repo repository.ReportRepository,
+	modRepo repository.ModRepository,
+	commentRepo repository.CommentRepository,
+	moderation *ModerationService,
+	hideThreshold int,
+	log *zap.Logger,
+) *ReportService {
+	s := &ReportService{repo: repo, hideThreshold: int64(hideThreshold), log: log}
+	s.targets = map[string]reportTarget{
+		models.ReportTargetMod: {
+			visible: func(id uint) bool {
+				_, err := modRepo.FindPublicByID(id)
+				return err == nil
+			},
+			hide: func(id, operatorID uint, reason string) error {
+				err := moderation.HideMod(id, operatorID, reason)
+				if errors.Is(err, bizErr.ErrModStatusInvalid) {
+					return nil // 已被下架或不再公开
+				}
+				return err
+			},
+		},
+		models.ReportTargetComment: {
+			visible: func(id uint) bool {
+				comment, err := commentRepo.FindByID(id)
+				return err == nil && !comment.IsDeleted()
+			},
+			hide: func(id, operatorID uint, _ string) error {
+				comment, err := commentRepo.FindByID(id)
+				if err != nil {
+					return err
+				}
+				if comment.IsDeleted() {
+					return nil
+				}
+				comment.DeletedBy = models.CommentDeletedByModerator
+				if operatorID == 0 {
+					comment.DeletedBy = models.CommentDeletedByReports
+				}
+				return commentRepo.SoftDelete(comment)
+			},
+		},
+	}
+	return s
+}
+
+// SubmitReport 用户举报 Mod 或评论，待处理举报数达到阈值时自动隐藏对象
+func (s *ReportService) SubmitReport(userID uint, req dto.ReportCreateRequest) (*dto.ReportResponse, error) {
+	target, ok := s.targets[req.TargetType]
+	if !ok || !target.visible(req.TargetID) {
+		return nil, bizErr.ErrReportTargetInvalid
+	}
+
+	exists, err := s.repo.ExistsByReporter(req.TargetType, req.TargetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, bizErr.ErrReportExists
+	}
+
+	report := &models.Report{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		ReporterID: userID,
+		Reason:     req.Reason,
+		Details:    strings.TrimSpace(req.Details),
+		Status:     models.ReportStatusPending,
+	}
+	if err := s.repo.Create(report); err != nil {
+		s.log.Error("create report failed", zap.String("target_type", req.TargetType), zap.Uint("target_id", req.TargetID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "提交举报失败")
+	}
+
+	s.autoHide(target, report)
+	resp := toReportResponse(*report)
+	return &resp, nil
+}
+
+// GetQueue 按处理状态获取举报列表（默认待处理），先提交的在前
+func (s *ReportService) GetQueue(req dto.ReportQueueRequest) (*dto.ReportQueueResponse, error) {
+	status := req.Status
+	if status == "" {
+		status = models.ReportStatusPending
+	}
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	reports, total, err := s.repo.FindByStatus(status, req.TargetType, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.ReportResponse, len(reports))
+	for i, report := range reports {
+		items[i] = toReportResponse(report)
+	}
+	return &dto.ReportQueueResponse{
+		List:       items,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// ResolveReport 确认举报：隐藏被举报对象，并将该对象的所有待处理举报标记为已处理
+func (s *ReportService) ResolveReport(id, handlerID uint, req dto.ReportHandleRequest) (*dto.ReportHandleResponse, error) {
+	report, target, err := s.findPending(id)
+	if err != nil {
+		return nil, err
+	}
+
+	note := strings.TrimSpace(req.Note)
+	if err := target.hide(report.TargetID, handlerID, note); err != nil {
+		s.log.Error("hide reported target failed", zap.Uint("report_id", id), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "隐藏被举报内容失败")
+	}
+	return s.handle(report, models.ReportStatusResolved, handlerID, note)
+}
+
+// DismissReport 驳回举报：将该对象的所有待处理举报标记为已驳回
+// 已被自动隐藏的对象不会恢复，需要审核员另行处理
+func (s *ReportService) DismissReport(id, handlerID uint, req dto.ReportHandleRequest) (*dto.ReportHandleResponse, error) {
+	report, _, err := s.findPending(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.handle(report, models.ReportStatusDismissed, handlerID, strings.TrimSpace(req.Note))
+}
+
+// autoHide 待处理举报数达到阈值时自动隐藏对象（失败只记录日志，不影响举报提交）
+func (s *ReportService) autoHide(target reportTarget, report *models.Report) {
+	if s.hideThreshold < 1 {
+		return
+	}
+	count, err := s.repo.CountPending(report.TargetType, report.TargetID)
+	if err != nil {
+		s.log.Warn("count pending reports failed", zap.Uint("report_id", report.ID), zap.Error(err))
+		return
+	}
+	if count < s.hideThreshold {
+		return
+	}
+
+	reason := fmt.Sprintf("举报数达到 %d，已自动隐藏，等待审核员处理", count)
+	if err := target.hide(report.TargetID, 0, reason); err != nil {
+		s.log.Warn("auto hide reported target failed",
+			zap.String("target_type", report.TargetType), zap.Uint("target_id", report.TargetID), zap.Error(err))
+	}
+}
+
+// findPending 查询待处理的举报及其对象类型
+func (s *ReportService) findPending(id uint) (*models.Report, reportTarget, error) {
+	report, err := s.repo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, reportTarget{}, bizErr.ErrReportNotFound
+		}
+		return nil, reportTarget{}, err
+	}
+	if report.Status != models.ReportStatusPending {
+		return nil, reportTarget{}, bizErr.ErrReportHandled
+	}
+	target, ok := s.targets[report.TargetType]
+	if !ok {
+		return nil, reportTarget{}, bizErr.ErrReportTargetInvalid
+	}
+	return report, target, nil
+}
+
+// handle 批量更新对象的待处理举报
+func (s *ReportService) handle(report *models.Report, status string, handlerID uint, note string) (*dto.ReportHandleResponse, error) {
+	handled, err := s.repo.HandlePending(report.TargetType, report.TargetID, status, handlerID, note)
+	if err != nil {
+		s.log.Error("handle reports failed", zap.Uint("report_id", report.ID), zap.String("status", status), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "处理举报失败")
+	}
+	return &dto.ReportHandleResponse{
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID,
+		Status:     status,
+		Handled:    handled,
+	}, nil
+}
+
+// toReportResponse 转换为举报响应
+func toReportResponse(report models.Report) dto.ReportResponse {
+	return dto.ReportResponse{
+		ID:         report.ID,
+		TargetType: report.TargetType,
+		TargetID:   report.TargetID,
+		ReporterID: report.ReporterID,
+		Reason:     report.Reason,
+		Details:    report.Details,
+		Status:     report.Status,
+		HandlerID:  report.HandlerID,
+		Note:       report.Note,
+		HandledAt:  report.HandledAt,
+		CreatedAt:  report.CreatedAt,
+	}
+}
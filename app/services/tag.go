@@ -0,0 +1,149 @@
+package services
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// defaultTagAutocompleteLimit 标签补全默认返回数量
+const defaultTagAutocompleteLimit = 10
+
+// TagService 标签服务
+type TagService struct {
+	tagRepo repository.TagRepository
+	modRepo repository.ModRepository
+	log     *zap.Logger
+}
+
+// NewTagService 创建标签服务实例
+func NewTagService(tagRepo repository.TagRepository, modRepo repository.ModRepository, log *zap.Logger) *TagService {
+	return &TagService{tagRepo: tagRepo, modRepo: modRepo, log: log}
+}
+
+// SuggestTag 用户为 Mod 建议标签（标签不存在时创建，已关联时忽略）
+func (s *TagService) SuggestTag(modID, userID uint, req dto.TagSuggestRequest) (*dto.ModTagsResponse, error) {
+	name, ok := normalizeTagName(req.Name)
+	if !ok {
+		return nil, bizErr.ErrTagInvalid
+	}
+
+	if _, err := s.modRepo.FindByID(modID); err != nil {
+		return nil, notFoundOr(err, bizErr.ErrModNotFound)
+	}
+
+	tag, err := s.tagRepo.FirstOrCreate(name, userID)
+	if err != nil {
+		s.log.Error("create tag failed", zap.String("name", name), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "创建标签失败")
+	}
+
+	if _, err := s.tagRepo.AttachToMod(modID, tag.ID); err != nil {
+		s.log.Error("attach tag failed", zap.Uint("mod_id", modID), zap.Uint("tag_id", tag.ID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "添加标签失败")
+	}
+
+	return s.modTags(modID)
+}
+
+// RemoveTag 移除 Mod 的标签（仅作者或共同维护者）
+func (s *TagService) RemoveTag(modID, tagID, userID uint) (*dto.ModTagsResponse, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+
+	detached, err := s.tagRepo.DetachFromMod(modID, tagID)
+	if err != nil {
+		s.log.Error("detach tag failed", zap.Uint("mod_id", modID), zap.Uint("tag_id", tagID), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "移除标签失败")
+	}
+	if !detached {
+		return nil, bizErr.ErrTagNotFound
+	}
+
+	return s.modTags(modID)
+}
+
+// SearchTags 搜索标签
+func (s *TagService) SearchTags(req dto.TagSearchRequest) (*dto.TagListResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	keyword, _ := normalizeTagName(req.Keyword)
+	tags, total, err := s.tagRepo.Search(keyword, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TagListResponse{
+		List:       tags,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// AutocompleteTags 按前缀补全标签
+func (s *TagService) AutocompleteTags(req dto.TagAutocompleteRequest) (*dto.TagSuggestionResponse, error) {
+	prefix, ok := normalizeTagName(req.Prefix)
+	if !ok {
+		return &dto.TagSuggestionResponse{List: []models.Tag{}}, nil
+	}
+
+	limit := req.Limit
+	if limit < 1 {
+		limit = defaultTagAutocompleteLimit
+	}
+
+	tags, err := s.tagRepo.Autocomplete(prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TagSuggestionResponse{List: tags}, nil
+}
+
+// modTags 查询 Mod 当前的标签
+func (s *TagService) modTags(modID uint) (*dto.ModTagsResponse, error) {
+	tags, err := s.tagRepo.FindByModID(modID)
+	if err != nil {
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "查询标签失败")
+	}
+	return &dto.ModTagsResponse{ModID: modID, Tags: tags}, nil
+}
+
+// normalizeTagName 规范化标签名称（去除首尾空白、转小写、合并连续空白）
+// 名称为空或包含逗号（筛选参数的分隔符）时返回 false
+func normalizeTagName(name string) (string, bool) {
+	name = strings.ToLower(strings.Join(strings.Fields(name), " "))
+	if name == "" || strings.Contains(name, ",") {
+		return "", false
+	}
+	return name, true
+}
+
+// parseTagList 解析逗号分隔的标签列表（规范化并去重），空字符串返回 nil
+func parseTagList(raw string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(raw, ",") {
+		name, ok := normalizeTagName(part)
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		tags = append(tags, name)
+	}
+	return tags
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/amqp/event"
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/locale"
+)
+
+// TranslationService 游戏、分类、Mod 翻译管理服务
+type TranslationService struct {
+	repo      repository.TranslationRepository
+	modRepo   repository.ModRepository
+	localizer *Localizer
+	events    ModEventPublisher
+	log       *zap.Logger
+}
+
+// NewTranslationService 创建翻译管理服务实例
+// events 用于在 Mod 翻译变更后更新检索索引，为空时不发布事件
+func NewTranslationService(
+	repo repository.TranslationRepository,
+	modRepo repository.ModRepository,
+	localizer *Localizer,
+	events ModEventPublisher,
+	log *zap.Logger,
+) *TranslationService {
+	return &TranslationService{repo: repo, modRepo: modRepo, localizer: localizer, events: events, log: log}
+}
+
+// ListModTranslations 查询 Mod 的全部翻译（仅作者或共同维护者）
+func (s *TranslationService) ListModTranslations(modID, userID uint) (*dto.TranslationListResponse, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+	return s.list(models.TranslationEntityMod, modID)
+}
+
+// SaveModTranslation 新增或覆盖 Mod 的翻译（仅作者或共同维护者）
+func (s *TranslationService) SaveModTranslation(modID, userID uint, loc string, req dto.TranslationSaveRequest) (*dto.TranslationResponse, error) {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return nil, err
+	}
+	return s.save(models.TranslationEntityMod, modID, loc, req)
+}
+
+// DeleteModTranslation 删除 Mod 的翻译（仅作者或共同维护者）
+func (s *TranslationService) DeleteModTranslation(modID, userID uint, loc string) error {
+	if _, err := findEditableMod(s.modRepo, modID, userID); err != nil {
+		return err
+	}
+	return s.delete(models.TranslationEntityMod, modID, loc)
+}
+
+// List 查询游戏、分类或 Mod 的全部翻译（管理员）
+func (s *TranslationService) List(entity string, id uint) (*dto.TranslationListResponse, error) {
+	if err := s.checkEntity(entity, id); err != nil {
+		return nil, err
+	}
+	return s.list(entity, id)
+}
+
+// Save 新增或覆盖游戏、分类或 Mod 的翻译（管理员）
+func (s *TranslationService) Save(entity string, id uint, loc string, req dto.TranslationSaveRequest) (*dto.TranslationResponse, error) {
+	if err := s.checkEntity(entity, id); err != nil {
+		return nil, err
+	}
+	return s.save(entity, id, loc, req)
+}
+
+// Delete 删除游戏、分类或 Mod 的翻译（管理员）
+func (s *TranslationService) Delete(entity string, id uint, loc string) error {
+	if err := s.checkEntity(entity, id); err != nil {
+		return err
+	}
+	return s.delete(entity, id, loc)
+}
+
+// checkEntity 校验翻译对象存在
+func (s *TranslationService) checkEntity(entity string, id uint) error {
+	switch entity {
+	case models.TranslationEntityGame:
+		if _, err := s.modRepo.FindGameByID(id); err != nil {
+			return notFoundOr(err, bizErr.ErrGameNotFound)
+		}
+	case models.TranslationEntityCategory:
+		categories, err := s.modRepo.FindCategoriesByIDs([]uint{id})
+		if err != nil {
+			return err
+		}
+		if len(categories) == 0 {
+			return bizErr.ErrCategoryNotFound
+		}
+	case models.TranslationEntityMod:
+		if _, err := s.modRepo.FindByID(id); err != nil {
+			return notFoundOr(err, bizErr.ErrModNotFound)
+		}
+	default:
+		return bizErr.ErrCatalogEntityInvalid
+	}
+	return nil
+}
+
+// notFoundOr 记录不存在时返回 notFound，其他错误原样返回
+func notFoundOr(err error, notFound error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return err
+}
+
+// checkLocale 规范化并校验翻译语言（默认语言的内容保存在原数据中，不能添加翻译）
+func (s *TranslationService) checkLocale(raw string) (string, error) {
+	loc := locale.Normalize(raw)
+	if loc == "" || !s.localizer.IsSupported(loc) {
+		return "", bizErr.ErrLocaleNotSupported
+	}
+	if loc == s.localizer.Default() {
+		return "", bizErr.ErrDefaultLocaleTranslation
+	}
+	return loc, nil
+}
+
+func (s *TranslationService) list(entity string, id uint) (*dto.TranslationListResponse, error) {
+	rows, err := s.repo.List(entity, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.TranslationListResponse{
+		Entity:        entity,
+		ID:            id,
+		DefaultLocale: s.localizer.Default(),
+		Supported:     s.localizer.Supported(),
+		List:          make([]dto.TranslationResponse, len(rows)),
+	}
+	for i, row := range rows {
+		resp.List[i] = toTranslationResponse(row)
+	}
+	return resp, nil
+}
+
+func (s *TranslationService) save(entity string, id uint, raw string, req dto.TranslationSaveRequest) (*dto.TranslationResponse, error) {
+	loc, err := s.checkLocale(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &repository.Translation{EntityID: id, Locale: loc, Name: req.Name, Description: req.Description}
+	if err := s.repo.Save(entity, t); err != nil {
+		s.log.Error("save translation failed",
+			zap.String("entity", entity), zap.Uint("id", id), zap.String("locale", loc), zap.Error(err))
+		return nil, bizErr.Wrap(err, bizErr.CodeInternalError, "保存翻译失败")
+	}
+
+	s.reindex(entity, id)
+	resp := toTranslationResponse(*t)
+	return &resp, nil
+}
+
+func (s *TranslationService) delete(entity string, id uint, raw string) error {
+	loc, err := s.checkLocale(raw)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.repo.Delete(entity, id, loc)
+	if err != nil {
+		s.log.Error("delete translation failed",
+			zap.String("entity", entity), zap.Uint("id", id), zap.String("locale", loc), zap.Error(err))
+		return bizErr.Wrap(err, bizErr.CodeInternalError, "删除翻译失败")
+	}
+	if !deleted {
+		return bizErr.ErrTranslationNotFound
+	}
+
+	s.reindex(entity, id)
+	return nil
+}
+
+// reindex Mod 翻译变更后更新检索索引（失败只记录日志）
+func (s *TranslationService) reindex(entity string, id uint) {
+	if entity != models.TranslationEntityMod || s.events == nil {
+		return
+	}
+	if err := s.events.PublishModEvent(event.NewModEvent(event.ModUpdated, id)); err != nil {
+		s.log.Warn("publish mod event failed", zap.String("type", event.ModUpdated), zap.Uint("mod_id", id), zap.Error(err))
+	}
+}
+
+// toTranslationResponse 转换翻译响应
+func toTranslationResponse(t repository.Translation) dto.TranslationResponse {
+	return dto.TranslationResponse{
+		Locale:      t.Locale,
+		Name:        t.Name,
+		Description: t.Description,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
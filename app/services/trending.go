@@ -0,0 +1,225 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/internal/repository"
+	"gin-web/pkg/trending"
+)
+
+// defaultTrendingRankingSize 每个榜单默认保留的条目数
+const defaultTrendingRankingSize = 1000
+
+// TrendingService 热度排行服务
+type TrendingService struct {
+	statRepo    repository.ModStatRepository
+	modRepo     repository.ModRepository
+	ranking     repository.TrendingRanking
+	opts        trending.Options
+	rankingSize int
+	log         *zap.Logger
+}
+
+// NewTrendingService 创建热度排行服务实例
+// ranking 为空时不维护榜单，热门列表直接按 trending_score 列查询
+func NewTrendingService(
+	statRepo repository.ModStatRepository,
+	modRepo repository.ModRepository,
+	ranking repository.TrendingRanking,
+	opts trending.Options,
+	rankingSize int,
+	log *zap.Logger,
+) *TrendingService {
+	if rankingSize <= 0 {
+		rankingSize = defaultTrendingRankingSize
+	}
+	return &TrendingService{
+		statRepo:    statRepo,
+		modRepo:     modRepo,
+		ranking:     ranking,
+		opts:        opts.WithDefaults(),
+		rankingSize: rankingSize,
+		log:         log,
+	}
+}
+
+// Recompute 根据统计窗口内的每日计数重新计算热度分，并重建全站、游戏、分类榜单
+func (s *TrendingService) Recompute(now time.Time) error {
+	stats, err := s.statRepo.FindSince(s.opts.Since(now))
+	if err != nil {
+		return err
+	}
+
+	buckets := make(map[uint][]trending.Bucket)
+	for _, st := range stats {
+		buckets[st.ModID] = append(buckets[st.ModID], trending.Bucket{Date: st.Date, Downloads: st.Downloads, Views: st.Views})
+	}
+	scores := make(map[uint]float64, len(buckets))
+	for modID, b := range buckets {
+		if score := s.opts.Score(b, now); score > 0 {
+			scores[modID] = score
+		}
+	}
+
+	if err := s.statRepo.UpdateTrendingScores(scores); err != nil {
+		return err
+	}
+	if s.ranking == nil {
+		return nil
+	}
+
+	rankings, err := s.buildRankings(scores)
+	if err != nil {
+		return err
+	}
+	return s.ranking.Replace(rankings)
+}
+
+// buildRankings 按游戏、分类（Mod 同时计入所有祖先分类）拆分榜单，每个榜单只保留前 rankingSize 条
+func (s *TrendingService) buildRankings(scores map[uint]float64) (map[string]map[uint]float64, error) {
+	ids := make([]uint, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	memberships, err := s.statRepo.FindMemberships(ids)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.modRepo.FindAllCategories()
+	if err != nil {
+		return nil, err
+	}
+	parents := make(map[uint]uint, len(categories))
+	for _, c := range categories {
+		if c.ParentID != nil {
+			parents[c.ID] = *c.ParentID
+		}
+	}
+
+	// 只有已通过审核的 Mod 会出现在 memberships 中，全站榜单同样以此为准
+	rankings := map[string]map[uint]float64{repository.TrendingRankingKey(0, 0): {}}
+	add := func(key string, modID uint) {
+		if rankings[key] == nil {
+			rankings[key] = make(map[uint]float64)
+		}
+		rankings[key][modID] = scores[modID]
+	}
+	for _, m := range memberships {
+		add(repository.TrendingRankingKey(0, 0), m.ModID)
+		add(repository.TrendingRankingKey(m.GameID, 0), m.ModID)
+		// 沿父链向上，visited 防止异常数据中的环
+		visited := make(map[uint]bool)
+		for id := m.CategoryID; id > 0 && !visited[id]; id = parents[id] {
+			visited[id] = true
+			add(repository.TrendingRankingKey(0, id), m.ModID)
+		}
+	}
+
+	for key, ranking := range rankings {
+		rankings[key] = topScores(ranking, s.rankingSize)
+	}
+	return rankings, nil
+}
+
+// topScores 保留热度最高的 n 条（同分时 ID 小的优先）
+func topScores(scores map[uint]float64, n int) map[uint]float64 {
+	if len(scores) <= n {
+		return scores
+	}
+	ids := make([]uint, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	top := make(map[uint]float64, n)
+	for _, id := range ids[:n] {
+		top[id] = scores[id]
+	}
+	return top
+}
+
+// GetTrending 获取热门 Mod
+// 优先读取榜单；同时按游戏和分类筛选、榜单尚未生成或读取失败时按 trending_score 列查询
+func (s *TrendingService) GetTrending(req dto.TrendingRequest) (*dto.ModListResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	if s.ranking != nil && (req.GameID == 0 || req.CategoryID == 0) {
+		resp, err := s.fromRanking(repository.TrendingRankingKey(req.GameID, req.CategoryID), page, pageSize)
+		if err == nil {
+			return resp, nil
+		}
+		s.log.Warn("read trending ranking failed, falling back to database", zap.Error(err))
+	}
+
+	criteria := repository.ModSearchCriteria{
+		SortBy:   repository.SortByTrending,
+		Order:    "desc",
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if req.GameID > 0 {
+		criteria.GameIDs = []uint{req.GameID}
+	}
+	if req.CategoryID > 0 {
+		criteria.CategoryIDs = []uint{req.CategoryID}
+	}
+	result, err := s.modRepo.Search(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.ModItemResponse, len(result.Mods))
+	for i, mod := range result.Mods {
+		items[i] = toModItemResponse(mod, nil)
+	}
+	return &dto.ModListResponse{
+		List:       items,
+		Total:      &result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: &result.TotalPages,
+		HasMore:    result.Page < result.TotalPages,
+	}, nil
+}
+
+// fromRanking 从榜单读取一页热门 Mod
+func (s *TrendingService) fromRanking(key string, page, pageSize int) (*dto.ModListResponse, error) {
+	ids, total, err := s.ranking.Top(key, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	mods, err := s.modRepo.FindByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.ModItemResponse, len(mods))
+	for i, mod := range mods {
+		items[i] = toModItemResponse(mod, nil)
+	}
+	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
+	return &dto.ModListResponse{
+		List:       items,
+		Total:      &total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: &totalPages,
+		HasMore:    page < totalPages,
+	}, nil
+}
@@ -48,7 +48,7 @@ func (s *UserService) Register(params dto.RegisterRequest) (*models.User, error)
 func (s *UserService) Login(params dto.LoginRequest) (*models.User, error) {
 	user, err := s.repo.FindByMobile(params.Mobile)
 	if err != nil {
-		return nil, bizErr.ErrUserNotFound
+		return nil, notFoundOr(err, bizErr.ErrUserNotFound)
 	}
 	if !utils.BcryptMakeCheck([]byte(params.Password), user.Password) {
 		return nil, bizErr.ErrPassword
@@ -64,7 +64,7 @@ func (s *UserService) GetUserInfo(id string) (*models.User, error) {
 	}
 	user, err := s.repo.FindByID(uint(intId))
 	if err != nil {
-		return nil, bizErr.ErrUserNotFound
+		return nil, notFoundOr(err, bizErr.ErrUserNotFound)
 	}
 	return user, nil
 }
@@ -127,8 +127,25 @@ func initMySqlTables(db *gorm.DB) {
 	err := db.AutoMigrate(
 		models.User{},
 		models.Game{},
+		models.GameVersion{},
 		models.Category{},
+		models.Tag{},
 		models.Mod{},
+		models.ModDependency{},
+		models.ModRelease{},
+		models.ModDailyStat{},
+		models.ModVersionDailyStat{},
+		models.ModStatEvent{},
+		models.GameTranslation{},
+		models.CategoryTranslation{},
+		models.ModTranslation{},
+		models.ModImage{},
+		models.ModReview{},
+		models.Notification{},
+		models.Comment{},
+		models.Report{},
+		models.Collection{},
+		models.CollectionItem{},
 	)
 	if err != nil {
 		global.App.Log.Error("migrate table failed", zap.Any("err", err))
@@ -1,26 +1,48 @@
 package bootstrap
 
 import (
-	"gin-web/utils"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/validator/v10"
+	"errors"
 	"reflect"
 	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"gin-web/pkg/validation"
+	"gin-web/utils"
 )
 
-func InitializeValidator() {
-	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-		// 注册自定义验证器
-		_ = v.RegisterValidation("mobile", utils.ValidateMobile)
-		_ = v.RegisterValidation("email", utils.ValidateEmail)
+// DefaultValidationRules 内置的自定义验证规则
+func DefaultValidationRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Field("mobile", utils.ValidateMobile),
+		validation.Field("email", utils.ValidateEmail),
+	}
+}
 
-		// 注册自定义 json tag 函数
-		v.RegisterTagNameFunc(func(fld reflect.StructField) string {
-			name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
-			if name == "-" {
-				return ""
-			}
-			return name
-		})
+// InitializeValidator 初始化 gin 的参数验证器：注册内置规则、rules 中的自定义规则与字段命名函数
+func InitializeValidator(rules ...validation.Rule) (*validator.Validate, error) {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return nil, errors.New("binding validator engine is not go-playground/validator")
 	}
+
+	// 注册自定义验证器
+	for _, rule := range append(DefaultValidationRules(), rules...) {
+		if err := rule.Register(v); err != nil {
+			return nil, err
+		}
+	}
+
+	// 注册自定义 tag 名称函数：验证错误中的字段名依次取 json、form、uri 标签（都没有时使用结构体字段名）
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		for _, key := range []string{"json", "form", "uri"} {
+			name := strings.SplitN(fld.Tag.Get(key), ",", 2)[0]
+			if name != "" && name != "-" {
+				return name
+			}
+		}
+		return ""
+	})
+	return v, nil
 }
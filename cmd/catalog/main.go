@@ -0,0 +1,23 @@
+package main
+
+import (
+	"flag"
+
+	fxmodule "gin-web/internal/fx"
+)
+
+func main() {
+	var opts fxmodule.CatalogOptions
+	flag.StringVar(&opts.Entity, "entity", "", "数据类型：games、categories 或 mods")
+	flag.StringVar(&opts.Import, "import", "", "导入文件路径")
+	flag.StringVar(&opts.Export, "export", "", "导出文件路径")
+	flag.StringVar(&opts.Format, "format", "", "文件格式：csv、json 或 ndjson（为空时按扩展名推断）")
+	flag.BoolVar(&opts.DryRun, "dry-run", false, "只校验导入文件，不写入数据")
+	flag.Parse()
+
+	// 使用 fx 执行目录数据导入或导出，完成后自动退出：
+	// - 配置加载
+	// - 数据库连接（自动迁移表结构）
+	// - 按自然键新建或更新游戏、分类、Mod（-import），或导出为文件（-export）
+	fxmodule.NewCatalogApp(opts).Run()
+}
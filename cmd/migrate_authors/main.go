@@ -0,0 +1,13 @@
+package main
+
+import (
+	fxmodule "gin-web/internal/fx"
+)
+
+func main() {
+	// 使用 fx 执行 Mod 作者关联迁移，完成后自动退出：
+	// - 配置加载
+	// - 数据库连接（自动迁移表结构）
+	// - 按作者名称为存量 Mod 关联唯一匹配的用户账号
+	fxmodule.NewAuthorMigrationApp().Run()
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	fxmodule "gin-web/internal/fx"
+)
+
+func main() {
+	// 使用 fx 执行检索索引全量重建，完成后自动退出：
+	// - 配置加载
+	// - 数据库连接
+	// - 检索后端初始化
+	// - 从 ModRepository 重建索引（内嵌索引通过 RabbitMQ 广播重建事件）
+	fxmodule.NewReindexApp().Run()
+}
@@ -0,0 +1,11 @@
+package config
+
+// Cache 仓储缓存配置（Redis）
+type Cache struct {
+	Enable        bool   `mapstructure:"enable" json:"enable" yaml:"enable"`                         // 是否缓存游戏、分类列表及公开的 Mod 详情
+	Prefix        string `mapstructure:"prefix" json:"prefix" yaml:"prefix"`                         // Redis 键名前缀（默认 cache:mods:）
+	GamesTTL      int    `mapstructure:"games_ttl" json:"games_ttl" yaml:"games_ttl"`                // 游戏列表缓存时间（秒，默认 600，小于 0 不缓存）
+	CategoriesTTL int    `mapstructure:"categories_ttl" json:"categories_ttl" yaml:"categories_ttl"` // 分类列表缓存时间（秒，默认 600，小于 0 不缓存）
+	GameTTL       int    `mapstructure:"game_ttl" json:"game_ttl" yaml:"game_ttl"`                   // 单个游戏缓存时间（秒，默认 600，小于 0 不缓存）
+	ModTTL        int    `mapstructure:"mod_ttl" json:"mod_ttl" yaml:"mod_ttl"`                      // 公开的 Mod 详情缓存时间（秒，默认 60，小于 0 不缓存）
+}
@@ -0,0 +1,7 @@
+package config
+
+// Comment 评论配置
+type Comment struct {
+	RateLimit  int `mapstructure:"rate_limit" json:"rate_limit" yaml:"rate_limit"`    // 每个用户在一个窗口内最多发表的评论数
+	RateWindow int `mapstructure:"rate_window" json:"rate_window" yaml:"rate_window"` // 限流窗口（秒）
+}
@@ -11,4 +11,13 @@ type Configuration struct {
 	Cron      Cron      `mapstructure:"cron" json:"cron" yaml:"cron"`
 	WebSocket WebSocket `mapstructure:"websocket" json:"websocket" yaml:"websocket"`
 	ApiUrls   ApiUrls   `mapstructure:"api_url" json:"api_url" yaml:"api_url"`
+	Search    Search    `mapstructure:"search" json:"search" yaml:"search"`
+	Trending  Trending  `mapstructure:"trending" json:"trending" yaml:"trending"`
+	Comment   Comment   `mapstructure:"comment" json:"comment" yaml:"comment"`
+	Report    Report    `mapstructure:"report" json:"report" yaml:"report"`
+	Stats     Stats     `mapstructure:"stats" json:"stats" yaml:"stats"`
+	Locale    Locale    `mapstructure:"locale" json:"locale" yaml:"locale"`
+	Storage   Storage   `mapstructure:"storage" json:"storage" yaml:"storage"`
+	Image     Image     `mapstructure:"image" json:"image" yaml:"image"`
+	Cache     Cache     `mapstructure:"cache" json:"cache" yaml:"cache"`
 }
@@ -0,0 +1,15 @@
+package config
+
+// Image 图片上传与处理配置
+type Image struct {
+	MaxUploadSize   int64  `mapstructure:"max_upload_size" json:"max_upload_size" yaml:"max_upload_size"`    // 单张图片大小上限（字节，默认 10MB）
+	MaxPixels       int    `mapstructure:"max_pixels" json:"max_pixels" yaml:"max_pixels"`                   // 单张图片像素数上限（默认 4000 万）
+	MaxPerMod       int    `mapstructure:"max_per_mod" json:"max_per_mod" yaml:"max_per_mod"`                // 每个 Mod 的截图数量上限（默认 20）
+	MaxDimension    int    `mapstructure:"max_dimension" json:"max_dimension" yaml:"max_dimension"`          // 大图（WebP）最长边（默认 1920）
+	ThumbnailWidth  int    `mapstructure:"thumbnail_width" json:"thumbnail_width" yaml:"thumbnail_width"`    // 缩略图最大宽度（默认 400）
+	ThumbnailHeight int    `mapstructure:"thumbnail_height" json:"thumbnail_height" yaml:"thumbnail_height"` // 缩略图最大高度（默认 300）
+	JPEGQuality     int    `mapstructure:"jpeg_quality" json:"jpeg_quality" yaml:"jpeg_quality"`             // 缩略图 JPEG 质量（1-100，默认 85）
+	Workers         int    `mapstructure:"workers" json:"workers" yaml:"workers"`                            // 未启用 RabbitMQ 时进程内处理图片的并发数（默认 2）
+	RetrySpec       string `mapstructure:"retry_spec" json:"retry_spec" yaml:"retry_spec"`                   // 重新处理积压图片的 cron 表达式（支持秒）
+	RetryAfter      int    `mapstructure:"retry_after" json:"retry_after" yaml:"retry_after"`                // 图片处于待处理状态超过该秒数后重新投递（默认 600）
+}
@@ -0,0 +1,8 @@
+package config
+
+// Locale 多语言配置
+type Locale struct {
+	Default     string   `mapstructure:"default" json:"default" yaml:"default"`                // 默认语言（游戏、分类、Mod 主表中的名称与描述使用该语言，默认 zh-CN）
+	Supported   []string `mapstructure:"supported" json:"supported" yaml:"supported"`          // 支持的语言（默认语言始终支持）
+	MessagesDir string   `mapstructure:"messages_dir" json:"messages_dir" yaml:"messages_dir"` // 额外的错误信息目录（每种语言一个 YAML/JSON 文件，如 en.yaml），覆盖内置消息
+}
@@ -25,6 +25,8 @@ type RabbitMQConfig struct {
 
 type ConsumerConfig struct {
 	Queue       string `yaml:"queue"`
+	Exchange    string `yaml:"exchange"`
+	RoutingKey  string `yaml:"routing_key"`
 	Concurrency int    `yaml:"concurrency"`
 	Handler     string `yaml:"handler"`
 }
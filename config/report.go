@@ -0,0 +1,6 @@
+package config
+
+// Report 内容举报配置
+type Report struct {
+	HideThreshold int `mapstructure:"hide_threshold" json:"hide_threshold" yaml:"hide_threshold"` // 待处理举报数达到该值时自动隐藏被举报内容（0 使用默认值 5，负数关闭自动隐藏）
+}
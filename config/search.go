@@ -0,0 +1,10 @@
+package config
+
+// Search 搜索配置
+type Search struct {
+	Driver            string  `mapstructure:"driver" json:"driver" yaml:"driver"`                                     // 搜索后端：mysql（FULLTEXT 索引）、memory（内存倒排索引）、like（不使用索引）
+	NameWeight        float64 `mapstructure:"name_weight" json:"name_weight" yaml:"name_weight"`                      // 名称相关度权重
+	DescriptionWeight float64 `mapstructure:"description_weight" json:"description_weight" yaml:"description_weight"` // 描述相关度权重
+	AuthorWeight      float64 `mapstructure:"author_weight" json:"author_weight" yaml:"author_weight"`                // 作者相关度权重
+	MaxHits           int     `mapstructure:"max_hits" json:"max_hits" yaml:"max_hits"`                               // 单次检索最大命中数
+}
@@ -0,0 +1,8 @@
+package config
+
+// Stats 下载 / 浏览统计配置
+type Stats struct {
+	RollupSpec    string `mapstructure:"rollup_spec" json:"rollup_spec" yaml:"rollup_spec"`          // 汇总统计事件的 cron 表达式（支持秒）
+	LookbackDays  int    `mapstructure:"lookback_days" json:"lookback_days" yaml:"lookback_days"`    // 每次汇总时重新汇总的历史天数（默认 1）
+	RetentionDays int    `mapstructure:"retention_days" json:"retention_days" yaml:"retention_days"` // 原始统计事件保留天数（0 使用默认值 90，负数不清理）
+}
@@ -0,0 +1,8 @@
+package config
+
+// Storage 文件存储配置
+type Storage struct {
+	Driver  string `mapstructure:"driver" json:"driver" yaml:"driver"`       // 存储驱动：local（本地磁盘，默认）、memory（内存，仅用于测试）
+	Root    string `mapstructure:"root" json:"root" yaml:"root"`             // 本地磁盘存储根目录（默认 storage/public）
+	BaseURL string `mapstructure:"base_url" json:"base_url" yaml:"base_url"` // 文件公开访问地址前缀（默认 /uploads；以 / 开头的路径由应用自身提供静态文件服务）
+}
@@ -0,0 +1,11 @@
+package config
+
+// Trending 热度排行配置
+type Trending struct {
+	Spec           string  `mapstructure:"spec" json:"spec" yaml:"spec"`                                  // 重新计算热度的 cron 表达式（支持秒）
+	Window         int     `mapstructure:"window" json:"window" yaml:"window"`                            // 统计窗口（天）
+	HalfLife       float64 `mapstructure:"half_life" json:"half_life" yaml:"half_life"`                   // 半衰期（天）
+	DownloadWeight float64 `mapstructure:"download_weight" json:"download_weight" yaml:"download_weight"` // 单次下载权重
+	ViewWeight     float64 `mapstructure:"view_weight" json:"view_weight" yaml:"view_weight"`             // 单次浏览权重
+	RankingSize    int     `mapstructure:"ranking_size" json:"ranking_size" yaml:"ranking_size"`          // 每个榜单保留的条目数
+}
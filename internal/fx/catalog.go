@@ -0,0 +1,94 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+)
+
+// CatalogOptions 目录导入导出命令参数
+type CatalogOptions struct {
+	Entity string // games、categories 或 mods
+	Import string // 导入文件路径
+	Export string // 导出文件路径
+	Format string // 文件格式，为空时按扩展名推断
+	DryRun bool   // 导入时只校验不写入
+}
+
+// RunCatalog 执行目录数据导入或导出，完成后退出应用
+// 导入结果（含逐行错误）以 JSON 输出到标准输出，存在错误时退出码为 1
+func RunCatalog(
+	lc fx.Lifecycle,
+	shutdowner fx.Shutdowner,
+	opts CatalogOptions,
+	repo repository.CatalogRepository,
+	svc *services.CatalogService,
+	log *zap.Logger,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			exitCode := 0
+			if err := runCatalog(opts, repo, svc, log); err != nil {
+				log.Error("catalog command failed", zap.Error(err))
+				exitCode = 1
+			}
+			return shutdowner.Shutdown(fx.ExitCode(exitCode))
+		},
+	})
+}
+
+// runCatalog 根据参数执行导入或导出
+func runCatalog(opts CatalogOptions, repo repository.CatalogRepository, svc *services.CatalogService, log *zap.Logger) error {
+	if repo == nil {
+		return errors.New("database not configured")
+	}
+	if (opts.Import == "") == (opts.Export == "") {
+		return errors.New("exactly one of -import or -export is required")
+	}
+
+	if opts.Export != "" {
+		format, err := services.CatalogFormat(opts.Format, opts.Export)
+		if err != nil {
+			return err
+		}
+		file, err := os.Create(opts.Export)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		if err := svc.Export(opts.Entity, format, file); err != nil {
+			return err
+		}
+		log.Info("catalog exported", zap.String("entity", opts.Entity), zap.String("file", opts.Export))
+		return nil
+	}
+
+	format, err := services.CatalogFormat(opts.Format, opts.Import)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(opts.Import)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := svc.Import(opts.Entity, format, file, opts.DryRun)
+	if err != nil {
+		return err
+	}
+	output, _ := json.MarshalIndent(result, "", "  ")
+	fmt.Println(string(output))
+	if result.Failed > 0 {
+		return fmt.Errorf("%d rows failed validation, nothing imported", result.Failed)
+	}
+	return nil
+}
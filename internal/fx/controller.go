@@ -20,6 +20,66 @@ var ControllerModule = fx.Module("controller",
 			NewModController,
 			fx.ResultTags(`group:"controllers"`),
 		),
+		fx.Annotate(
+			NewModDependencyController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewTagController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewGameController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewModReleaseController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewTrendingController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewModerationController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewNotificationController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewAuthorController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewCommentController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewReportController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewCatalogController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewCollectionController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewStatsController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewTranslationController,
+			fx.ResultTags(`group:"controllers"`),
+		),
+		fx.Annotate(
+			NewModImageController,
+			fx.ResultTags(`group:"controllers"`),
+		),
 	),
 )
 
@@ -35,6 +95,132 @@ func NewAuthController(
 // NewModController 创建 Mod 控制器
 func NewModController(
 	modSvc *services.ModService,
+	jwtMw *middleware.JwtMiddleware,
+) controllers.Controller {
+	return controllers.NewModController(modSvc, jwtMw)
+}
+
+// NewModDependencyController 创建 Mod 依赖控制器
+func NewModDependencyController(
+	depSvc *services.ModDependencyService,
+	jwtMw *middleware.JwtMiddleware,
+) controllers.Controller {
+	return controllers.NewModDependencyController(depSvc, jwtMw)
+}
+
+// NewTagController 创建标签控制器
+func NewTagController(
+	tagSvc *services.TagService,
+	jwtMw *middleware.JwtMiddleware,
+) controllers.Controller {
+	return controllers.NewTagController(tagSvc, jwtMw)
+}
+
+// NewGameController 创建游戏控制器
+func NewGameController(
+	gameSvc *services.GameService,
+	jwtMw *middleware.JwtMiddleware,
+) controllers.Controller {
+	return controllers.NewGameController(gameSvc, jwtMw)
+}
+
+// NewModReleaseController 创建 Mod 发布版本控制器
+func NewModReleaseController(
+	releaseSvc *services.ModReleaseService,
+	jwtMw *middleware.JwtMiddleware,
+) controllers.Controller {
+	return controllers.NewModReleaseController(releaseSvc, jwtMw)
+}
+
+// NewTrendingController 创建热门 Mod 控制器
+func NewTrendingController(trendingSvc *services.TrendingService) controllers.Controller {
+	return controllers.NewTrendingController(trendingSvc)
+}
+
+// NewModerationController 创建 Mod 审核控制器
+func NewModerationController(
+	moderationSvc *services.ModerationService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
+) controllers.Controller {
+	return controllers.NewModerationController(moderationSvc, jwtMw, roleMw)
+}
+
+// NewNotificationController 创建站内通知控制器
+func NewNotificationController(
+	notificationSvc *services.NotificationService,
+	jwtMw *middleware.JwtMiddleware,
+) controllers.Controller {
+	return controllers.NewNotificationController(notificationSvc, jwtMw)
+}
+
+// NewAuthorController 创建作者控制器
+func NewAuthorController(
+	authorSvc *services.AuthorService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
+) controllers.Controller {
+	return controllers.NewAuthorController(authorSvc, jwtMw, roleMw)
+}
+
+// NewCommentController 创建评论控制器
+func NewCommentController(
+	commentSvc *services.CommentService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
+) controllers.Controller {
+	return controllers.NewCommentController(commentSvc, jwtMw, roleMw)
+}
+
+// NewReportController 创建内容举报控制器
+func NewReportController(
+	reportSvc *services.ReportService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
+) controllers.Controller {
+	return controllers.NewReportController(reportSvc, jwtMw, roleMw)
+}
+
+// NewCatalogController 创建目录数据导入导出控制器
+func NewCatalogController(
+	catalogSvc *services.CatalogService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
+) controllers.Controller {
+	return controllers.NewCatalogController(catalogSvc, jwtMw, roleMw)
+}
+
+// NewCollectionController 创建 Mod 合集控制器
+func NewCollectionController(
+	collectionSvc *services.CollectionService,
+	jwtMw *middleware.JwtMiddleware,
+) controllers.Controller {
+	return controllers.NewCollectionController(collectionSvc, jwtMw)
+}
+
+// NewStatsController 创建下载 / 浏览统计控制器
+func NewStatsController(
+	analyticsSvc *services.ModAnalyticsService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
+) controllers.Controller {
+	return controllers.NewStatsController(analyticsSvc, jwtMw, roleMw)
+}
+
+// NewTranslationController 创建翻译管理控制器
+func NewTranslationController(
+	translationSvc *services.TranslationService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
+) controllers.Controller {
+	return controllers.NewTranslationController(translationSvc, jwtMw, roleMw)
+}
+
+// NewModImageController 创建截图控制器
+func NewModImageController(
+	imageSvc *services.ModImageService,
+	jwtMw *middleware.JwtMiddleware,
+	roleMw *middleware.RoleMiddleware,
 ) controllers.Controller {
-	return controllers.NewModController(modSvc)
+	return controllers.NewModImageController(imageSvc, jwtMw, roleMw)
 }
@@ -9,6 +9,7 @@ import (
 	"gorm.io/gorm"
 
 	appCron "gin-web/app/cron"
+	"gin-web/app/services"
 	"gin-web/config"
 	"gin-web/pkg/cron"
 )
@@ -31,6 +32,9 @@ func ProvideCronManager(
 	cfg *config.Configuration,
 	db *gorm.DB,
 	redis *redis.Client,
+	trendingSvc *services.TrendingService,
+	analyticsSvc *services.ModAnalyticsService,
+	imageSvc *services.ModImageService,
 	log *zap.Logger,
 ) *cron.Manager {
 	manager := cron.NewManager(log)
@@ -38,6 +42,11 @@ func ProvideCronManager(
 	// 注册定时任务（通过构造函数注入依赖，已移除 global.App）
 	manager.Register(appCron.NewCleanupJob(db, redis, log))
 	manager.Register(appCron.NewHealthCheckJob(db, redis, log))
+	if db != nil {
+		manager.Register(appCron.NewStatsRollupJob(analyticsSvc, cfg.Stats.RollupSpec, log))
+		manager.Register(appCron.NewTrendingJob(trendingSvc, cfg.Trending.Spec, log))
+		manager.Register(appCron.NewImageMaintenanceJob(imageSvc, cfg.Image.RetrySpec, log))
+	}
 
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
@@ -0,0 +1,28 @@
+package fx
+
+import (
+	"go.uber.org/fx"
+
+	"gin-web/app/messages"
+	"gin-web/config"
+	"gin-web/pkg/i18n"
+)
+
+// I18nModule 错误信息与验证消息多语言模块
+var I18nModule = fx.Module("i18n",
+	fx.Provide(ProvideMessageBundle),
+)
+
+// ProvideMessageBundle 提供消息目录：先加载内置目录（app/messages），再加载 locale.messages_dir 中的文件（同键覆盖内置消息）
+func ProvideMessageBundle(cfg *config.Configuration) (*i18n.Bundle, error) {
+	bundle := i18n.NewBundle(messages.Source, cfg.Locale.Default, cfg.Locale.Supported)
+	if err := bundle.LoadFS(messages.FS, "."); err != nil {
+		return nil, err
+	}
+	if cfg.Locale.MessagesDir != "" {
+		if err := bundle.LoadDir(cfg.Locale.MessagesDir); err != nil {
+			return nil, err
+		}
+	}
+	return bundle, nil
+}
@@ -0,0 +1,214 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"gin-web/app/amqp/producer"
+	"gin-web/app/services"
+	"gin-web/config"
+	"gin-web/internal/repository"
+	"gin-web/pkg/storage"
+)
+
+const (
+	defaultStorageRoot    = "storage/public"
+	defaultStorageBaseURL = "/uploads"
+	defaultImageWorkers   = 2
+	// localImageQueueSize 进程内截图处理队列容量
+	localImageQueueSize = 1024
+)
+
+// errImageQueueUnavailable 进程内截图处理队列已满或已停止
+var errImageQueueUnavailable = errors.New("image queue is full or stopped")
+
+// ProvideStorage 按配置选择文件存储驱动
+func ProvideStorage(cfg *config.Configuration) (storage.Storage, error) {
+	baseURL := cfg.Storage.BaseURL
+	if baseURL == "" {
+		baseURL = defaultStorageBaseURL
+	}
+
+	switch cfg.Storage.Driver {
+	case "", "local":
+		root := cfg.Storage.Root
+		if root == "" {
+			root = defaultStorageRoot
+		}
+		return storage.NewLocalStorage(root, baseURL), nil
+	case "memory":
+		return storage.NewMemoryStorage(baseURL), nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.Storage.Driver)
+	}
+}
+
+// ServeLocalStorage 本地磁盘存储且访问地址为路径时，由应用提供静态文件服务
+func ServeLocalStorage(engine *gin.Engine, cfg *config.Configuration, store storage.Storage) {
+	local, ok := store.(*storage.LocalStorage)
+	baseURL := cfg.Storage.BaseURL
+	if baseURL == "" {
+		baseURL = defaultStorageBaseURL
+	}
+	if !ok || !strings.HasPrefix(baseURL, "/") {
+		return
+	}
+	engine.Static(strings.TrimRight(baseURL, "/"), local.Root())
+}
+
+// imageOptions 图片上传与处理参数
+func imageOptions(cfg *config.Configuration) services.ImageOptions {
+	return services.ImageOptions{
+		MaxUploadSize:   cfg.Image.MaxUploadSize,
+		MaxPixels:       cfg.Image.MaxPixels,
+		MaxPerMod:       cfg.Image.MaxPerMod,
+		MaxDimension:    cfg.Image.MaxDimension,
+		ThumbnailWidth:  cfg.Image.ThumbnailWidth,
+		ThumbnailHeight: cfg.Image.ThumbnailHeight,
+		JPEGQuality:     cfg.Image.JPEGQuality,
+		RetryAfter:      time.Duration(cfg.Image.RetryAfter) * time.Second,
+	}
+}
+
+// ProvideImageProcessor 提供截图处理任务
+func ProvideImageProcessor(
+	cfg *config.Configuration,
+	repo repository.ModImageRepository,
+	store storage.Storage,
+	log *zap.Logger,
+) *services.ImageProcessor {
+	if repo == nil {
+		return nil
+	}
+	return services.NewImageProcessor(repo, store, imageOptions(cfg), log)
+}
+
+// ProvideModImageQueue 提供截图处理任务队列
+// 启用 RabbitMQ 时投递到持久化队列，由消费者处理；未启用或连接失败时在进程内用固定数量的协程处理
+func ProvideModImageQueue(
+	lc fx.Lifecycle,
+	cfg *config.Configuration,
+	processor *services.ImageProcessor,
+	log *zap.Logger,
+) services.ModImageQueue {
+	if processor == nil {
+		return nil
+	}
+
+	if cfg.RabbitMQ.Enable {
+		p, err := producer.NewModImageProducer(cfg.RabbitMQ)
+		if err == nil {
+			lc.Append(fx.Hook{
+				OnStop: func(ctx context.Context) error {
+					return p.Close()
+				},
+			})
+			return p
+		}
+		log.Warn("create mod image producer failed, falling back to local workers", zap.Error(err))
+	}
+
+	workers := cfg.Image.Workers
+	if workers <= 0 {
+		workers = defaultImageWorkers
+	}
+	q := newLocalModImageQueue(processor, log)
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			q.start(workers)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			q.stop()
+			return nil
+		},
+	})
+	return q
+}
+
+// ProvideModImageService 提供截图服务
+func ProvideModImageService(
+	cfg *config.Configuration,
+	repo repository.ModImageRepository,
+	modRepo repository.ModRepository,
+	gameRepo repository.GameRepository,
+	store storage.Storage,
+	queue services.ModImageQueue,
+	log *zap.Logger,
+) *services.ModImageService {
+	return services.NewModImageService(repo, modRepo, gameRepo, store, queue, imageOptions(cfg), log)
+}
+
+// localModImageQueue 进程内截图处理队列
+// 队列已满或已停止时投递失败，截图由维护任务稍后重新投递
+type localModImageQueue struct {
+	processor *services.ImageProcessor
+	log       *zap.Logger
+	tasks     chan uint
+	done      chan struct{}
+	mu        sync.RWMutex
+	stopped   bool
+	wg        sync.WaitGroup
+}
+
+func newLocalModImageQueue(processor *services.ImageProcessor, log *zap.Logger) *localModImageQueue {
+	return &localModImageQueue{
+		processor: processor,
+		log:       log,
+		tasks:     make(chan uint, localImageQueueSize),
+		done:      make(chan struct{}),
+	}
+}
+
+func (q *localModImageQueue) EnqueueModImage(imageID uint) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.stopped {
+		return errImageQueueUnavailable
+	}
+	select {
+	case q.tasks <- imageID:
+		return nil
+	default:
+		return errImageQueueUnavailable
+	}
+}
+
+func (q *localModImageQueue) start(workers int) {
+	for i := 0; i < workers; i++ {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			for {
+				select {
+				case <-q.done:
+					return
+				case id := <-q.tasks:
+					if err := q.processor.Process(id); err != nil {
+						q.log.Warn("process mod image failed", zap.Uint("image_id", id), zap.Error(err))
+					}
+				}
+			}
+		}()
+	}
+}
+
+// stop 停止接收任务并等待正在处理的任务完成
+// 队列中尚未处理的截图保持待处理状态，由维护任务重新投递
+func (q *localModImageQueue) stop() {
+	q.mu.Lock()
+	if !q.stopped {
+		q.stopped = true
+		close(q.done)
+	}
+	q.mu.Unlock()
+	q.wg.Wait()
+}
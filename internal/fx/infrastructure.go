@@ -33,6 +33,7 @@ var InfrastructureModule = fx.Module("infrastructure",
 		ProvideLogger,
 		ProvideDatabase,
 		ProvideRedis,
+		ProvideStorage,
 	),
 )
 
@@ -189,8 +190,25 @@ func ProvideDatabase(lc fx.Lifecycle, cfg *config.Configuration, log *zap.Logger
 	if err := db.AutoMigrate(
 		models.User{},
 		models.Game{},
+		models.GameVersion{},
 		models.Category{},
+		models.Tag{},
 		models.Mod{},
+		models.ModDependency{},
+		models.ModRelease{},
+		models.ModDailyStat{},
+		models.ModVersionDailyStat{},
+		models.ModStatEvent{},
+		models.GameTranslation{},
+		models.CategoryTranslation{},
+		models.ModTranslation{},
+		models.ModImage{},
+		models.ModReview{},
+		models.Notification{},
+		models.Comment{},
+		models.Report{},
+		models.Collection{},
+		models.CollectionItem{},
 	); err != nil {
 		return nil, fmt.Errorf("migrate table failed: %w", err)
 	}
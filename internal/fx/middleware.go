@@ -11,6 +11,7 @@ import (
 var MiddlewareModule = fx.Module("middleware",
 	fx.Provide(
 		ProvideJwtMiddleware,
+		ProvideRoleMiddleware,
 	),
 )
 
@@ -18,3 +19,8 @@ var MiddlewareModule = fx.Module("middleware",
 func ProvideJwtMiddleware(jwtSvc *services.JwtService) *middleware.JwtMiddleware {
 	return middleware.NewJwtMiddleware(jwtSvc)
 }
+
+// ProvideRoleMiddleware 提供角色校验中间件
+func ProvideRoleMiddleware(userSvc *services.UserService) *middleware.RoleMiddleware {
+	return middleware.NewRoleMiddleware(userSvc)
+}
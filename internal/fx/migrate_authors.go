@@ -0,0 +1,46 @@
+package fx
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"gin-web/internal/repository"
+)
+
+// RunAuthorMigration 按作者名称为存量 Mod 关联作者账号，完成后退出应用
+// 只处理尚未关联作者且作者名称与唯一一个用户名完全一致的 Mod，可重复执行；
+// 其余 Mod 需由管理员通过 PUT /api/mods/:id/owner 手动指定
+func RunAuthorMigration(
+	lc fx.Lifecycle,
+	shutdowner fx.Shutdowner,
+	repo repository.AuthorRepository,
+	log *zap.Logger,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			exitCode := 0
+			if err := migrateAuthors(repo, log); err != nil {
+				log.Error("migrate mod authors failed", zap.Error(err))
+				exitCode = 1
+			}
+			return shutdowner.Shutdown(fx.ExitCode(exitCode))
+		},
+	})
+}
+
+// migrateAuthors 执行作者关联
+func migrateAuthors(repo repository.AuthorRepository, log *zap.Logger) error {
+	if repo == nil {
+		return errors.New("database not configured")
+	}
+
+	linked, err := repo.LinkOwnersByAuthorName()
+	if err != nil {
+		return err
+	}
+	log.Info("mod authors linked", zap.Int64("mods", linked))
+	return nil
+}
@@ -25,6 +25,12 @@ func NewApp() *fx.App {
 		MiddlewareModule,
 		ControllerModule,
 
+		// 错误信息与验证消息多语言
+		I18nModule,
+
+		// 参数验证（注册自定义规则并检查请求结构体）
+		ValidatorModule,
+
 		// HTTP 路由
 		RouterModule,
 
@@ -49,6 +55,9 @@ func NewConsumerApp() *fx.App {
 		// 基础设施
 		InfrastructureModule,
 
+		// 数据访问（消费者处理器需要）
+		RepositoryModule,
+
 		// RabbitMQ 消费者（强制启用）
 		RabbitMQModule(true),
 
@@ -93,6 +102,12 @@ func NewWebSocketApp() *fx.App {
 		// WebSocket 模块（强制启用）
 		WebSocketModule(true),
 
+		// 错误信息与验证消息多语言
+		I18nModule,
+
+		// 参数验证
+		ValidatorModule,
+
 		// HTTP 路由
 		RouterModule,
 
@@ -110,3 +125,64 @@ func NewWebSocketApp() *fx.App {
 		}),
 	)
 }
+
+// NewReindexApp 创建检索索引重建应用（执行完成后退出）
+func NewReindexApp() *fx.App {
+	return fx.New(
+		// 基础设施
+		InfrastructureModule,
+
+		// 数据访问
+		RepositoryModule,
+
+		// 执行重建
+		fx.Invoke(RunReindex),
+
+		// 禁用 fx 的 verbose 日志
+		fx.WithLogger(func() fxevent.Logger {
+			return fxevent.NopLogger
+		}),
+	)
+}
+
+// NewAuthorMigrationApp 创建 Mod 作者关联迁移应用（执行完成后退出）
+func NewAuthorMigrationApp() *fx.App {
+	return fx.New(
+		// 基础设施（连接数据库时自动迁移表结构）
+		InfrastructureModule,
+
+		// 数据访问
+		RepositoryModule,
+
+		// 执行迁移
+		fx.Invoke(RunAuthorMigration),
+
+		// 禁用 fx 的 verbose 日志
+		fx.WithLogger(func() fxevent.Logger {
+			return fxevent.NopLogger
+		}),
+	)
+}
+
+// NewCatalogApp 创建目录数据导入导出应用（执行完成后退出）
+func NewCatalogApp(opts CatalogOptions) *fx.App {
+	return fx.New(
+		// 基础设施（连接数据库时自动迁移表结构）
+		InfrastructureModule,
+
+		// 数据访问
+		RepositoryModule,
+
+		// 导入导出服务（导入 Mod 后通过领域事件触发检索索引重建）
+		fx.Supply(opts),
+		fx.Provide(ProvideModEventPublisher, ProvideCatalogService),
+
+		// 执行导入或导出
+		fx.Invoke(RunCatalog),
+
+		// 禁用 fx 的 verbose 日志
+		fx.WithLogger(func() fxevent.Logger {
+			return fxevent.NopLogger
+		}),
+	)
+}
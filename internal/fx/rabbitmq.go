@@ -8,7 +8,9 @@ import (
 
 	"gin-web/app/amqp/consumer"
 	appConfig "gin-web/config"
+	"gin-web/internal/repository"
 	"gin-web/pkg/rabbitmq"
+	"gin-web/pkg/storage"
 )
 
 // RabbitMQModule RabbitMQ 模块（条件加载）
@@ -25,11 +27,30 @@ func RabbitMQModule(enabled bool) fx.Option {
 }
 
 // ProvideConsumerHandlers 提供消费者处理器
-func ProvideConsumerHandlers() map[string]consumer.ConsumerHandler {
-	return map[string]consumer.ConsumerHandler{
+func ProvideConsumerHandlers(
+	repo repository.ModRepository,
+	backend repository.ModSearchBackend,
+	cfg *appConfig.Configuration,
+	imageRepo repository.ModImageRepository,
+	store storage.Storage,
+	log *zap.Logger,
+) map[string]consumer.ConsumerHandler {
+	handlers := map[string]consumer.ConsumerHandler{
 		"LogConsumer": &consumer.LogConsumer{},
 		// 在这里注册更多消费者处理器
 	}
+
+	// 检索索引同步（需要数据库和检索后端）
+	if indexer, ok := backend.(repository.ModSearchIndexer); ok && repo != nil {
+		handlers["ModSearchIndexConsumer"] = consumer.NewModSearchIndexConsumer(repo, indexer, log)
+	}
+
+	// 截图处理（需要数据库）
+	if processor := ProvideImageProcessor(cfg, imageRepo, store, log); processor != nil {
+		handlers["ModImageConsumer"] = consumer.NewModImageConsumer(processor, log)
+	}
+
+	return handlers
 }
 
 // ProvideRabbitMQManager 提供 RabbitMQ 管理器
@@ -53,6 +74,8 @@ func ProvideRabbitMQManager(
 	for i, c := range consumerCfg.Consumers {
 		consumers[i] = rabbitmq.ConsumerConfig{
 			Queue:       c.Queue,
+			Exchange:    c.Exchange,
+			RoutingKey:  c.RoutingKey,
 			Handler:     c.Handler,
 			Concurrency: c.Concurrency,
 		}
@@ -0,0 +1,78 @@
+package fx
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"gin-web/app/amqp/event"
+	"gin-web/app/amqp/producer"
+	"gin-web/config"
+	"gin-web/internal/repository"
+)
+
+// RunReindex 执行检索索引全量重建，完成后退出应用
+//   - 内嵌索引（memory）存在于各 API 实例进程内，通过广播 mod.reindex 事件让每个实例自行重建
+//   - 其他索引（如 MySQL FULLTEXT）直接从 ModRepository 重建
+func RunReindex(
+	lc fx.Lifecycle,
+	shutdowner fx.Shutdowner,
+	cfg *config.Configuration,
+	repo repository.ModRepository,
+	backend repository.ModSearchBackend,
+	log *zap.Logger,
+) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			exitCode := 0
+			if err := reindex(cfg, repo, backend, log); err != nil {
+				log.Error("reindex failed", zap.Error(err))
+				exitCode = 1
+			}
+			return shutdowner.Shutdown(fx.ExitCode(exitCode))
+		},
+	})
+}
+
+// reindex 根据检索后端类型执行重建
+func reindex(
+	cfg *config.Configuration,
+	repo repository.ModRepository,
+	backend repository.ModSearchBackend,
+	log *zap.Logger,
+) error {
+	if repo == nil {
+		return errors.New("database not configured")
+	}
+
+	if _, ok := backend.(*repository.MemoryModSearch); ok {
+		if !cfg.RabbitMQ.Enable {
+			return errors.New("memory search index lives in API processes and is rebuilt on startup; enable rabbitmq to rebuild running instances")
+		}
+		p, err := producer.NewModEventProducer(cfg.RabbitMQ)
+		if err != nil {
+			return err
+		}
+		defer p.Close()
+		if err := p.PublishModEvent(event.NewModEvent(event.ModReindex, 0)); err != nil {
+			return err
+		}
+		log.Info("reindex event published", zap.String("exchange", event.ModEventExchange))
+		return nil
+	}
+
+	indexer, ok := backend.(repository.ModSearchIndexer)
+	if !ok {
+		log.Info("search backend does not maintain an index, nothing to rebuild")
+		return nil
+	}
+
+	count, err := repository.ReindexMods(repo, indexer)
+	if err != nil {
+		return err
+	}
+	log.Info("search index rebuilt", zap.Int("mods", count))
+	return nil
+}
@@ -1,18 +1,47 @@
 package fx
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"gin-web/config"
 	"gin-web/internal/repository"
+	"gin-web/pkg/cache"
 )
 
+// defaultModCachePrefix Mod 仓储缓存的 Redis 键名前缀
+const defaultModCachePrefix = "cache:mods:"
+
 // RepositoryModule 仓储模块
 var RepositoryModule = fx.Module("repository",
 	fx.Provide(
 		ProvideUserRepository,
+		ProvideModCache,
+		ProvideModSearchBackend,
 		ProvideModRepository,
+		ProvideModDependencyRepository,
+		ProvideTagRepository,
+		ProvideGameRepository,
+		ProvideModReleaseRepository,
+		ProvideModStatRepository,
+		ProvideTrendingRanking,
+		ProvideNotificationRepository,
+		ProvideAuthorRepository,
+		ProvideCommentRepository,
+		ProvideReportRepository,
+		ProvideCatalogRepository,
+		ProvideCollectionRepository,
+		ProvideModAnalyticsRepository,
+		ProvideTranslationRepository,
+		ProvideModImageRepository,
 	),
+	fx.Invoke(WarmUpSearchIndex),
 )
 
 // ProvideUserRepository 提供用户仓储
@@ -23,10 +52,227 @@ func ProvideUserRepository(db *gorm.DB) repository.UserRepository {
 	return repository.NewUserRepository(db)
 }
 
-// ProvideModRepository 提供 Mod 仓储
-func ProvideModRepository(db *gorm.DB) repository.ModRepository {
+// ProvideModCache 提供 Mod 仓储缓存（未启用时返回 nil）
+func ProvideModCache(cfg *config.Configuration, client *redis.Client, log *zap.Logger) *repository.ModCache {
+	if !cfg.Cache.Enable || client == nil {
+		return nil
+	}
+
+	prefix := cfg.Cache.Prefix
+	if prefix == "" {
+		prefix = defaultModCachePrefix
+	}
+	return repository.NewModCache(cache.NewRedisStore(client, prefix), repository.ModCacheOptions{
+		GamesTTL:      time.Duration(cfg.Cache.GamesTTL) * time.Second,
+		CategoriesTTL: time.Duration(cfg.Cache.CategoriesTTL) * time.Second,
+		GameTTL:       time.Duration(cfg.Cache.GameTTL) * time.Second,
+		ModTTL:        time.Duration(cfg.Cache.ModTTL) * time.Second,
+	}, log)
+}
+
+// ProvideModSearchBackend 提供 Mod 检索后端（根据 search.driver 选择）
+func ProvideModSearchBackend(cfg *config.Configuration, db *gorm.DB) (repository.ModSearchBackend, error) {
+	if db == nil {
+		return nil, nil
+	}
+
+	opts := repository.SearchOptions{
+		NameWeight:        cfg.Search.NameWeight,
+		DescriptionWeight: cfg.Search.DescriptionWeight,
+		AuthorWeight:      cfg.Search.AuthorWeight,
+		MaxHits:           cfg.Search.MaxHits,
+	}
+
+	switch cfg.Search.Driver {
+	case "", "mysql":
+		backend, err := repository.NewFulltextModSearch(db, opts)
+		if err != nil {
+			return nil, fmt.Errorf("init fulltext search failed: %w", err)
+		}
+		return backend, nil
+	case "memory":
+		// 索引数据在 WarmUpSearchIndex 中加载
+		return repository.NewMemoryModSearch(opts), nil
+	case "like":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown search driver: %s", cfg.Search.Driver)
+	}
+}
+
+// ProvideModRepository 提供 Mod 仓储（启用缓存时包装为读穿缓存）
+func ProvideModRepository(db *gorm.DB, search repository.ModSearchBackend, modCache *repository.ModCache) repository.ModRepository {
+	if db == nil {
+		return nil
+	}
+	repo := repository.NewModRepository(db, search)
+	if modCache != nil {
+		repo = repository.NewCachedModRepository(repo, modCache)
+	}
+	return repo
+}
+
+// ProvideModDependencyRepository 提供 Mod 依赖关系仓储
+func ProvideModDependencyRepository(db *gorm.DB) repository.ModDependencyRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewModDependencyRepository(db)
+}
+
+// ProvideTagRepository 提供标签仓储（启用缓存时标签关联变更后清除 Mod 详情缓存）
+func ProvideTagRepository(db *gorm.DB, modCache *repository.ModCache) repository.TagRepository {
+	if db == nil {
+		return nil
+	}
+	repo := repository.NewTagRepository(db)
+	if modCache != nil {
+		repo = repository.NewCacheInvalidatingTagRepository(repo, modCache)
+	}
+	return repo
+}
+
+// ProvideGameRepository 提供游戏仓储（启用缓存时写操作后清除 Mod 仓储缓存）
+func ProvideGameRepository(db *gorm.DB, modCache *repository.ModCache) repository.GameRepository {
+	if db == nil {
+		return nil
+	}
+	repo := repository.NewGameRepository(db)
+	if modCache != nil {
+		repo = repository.NewCacheInvalidatingGameRepository(repo, modCache)
+	}
+	return repo
+}
+
+// ProvideModReleaseRepository 提供 Mod 发布版本仓储
+func ProvideModReleaseRepository(db *gorm.DB) repository.ModReleaseRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewModReleaseRepository(db)
+}
+
+// ProvideModStatRepository 提供 Mod 每日计数仓储
+func ProvideModStatRepository(db *gorm.DB) repository.ModStatRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewModStatRepository(db)
+}
+
+// ProvideTrendingRanking 提供热度榜单存储（Redis 有序集合）
+func ProvideTrendingRanking(client *redis.Client) repository.TrendingRanking {
+	if client == nil {
+		return nil
+	}
+	return repository.NewRedisTrendingRanking(client)
+}
+
+// ProvideNotificationRepository 提供站内通知仓储
+func ProvideNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewNotificationRepository(db)
+}
+
+// ProvideAuthorRepository 提供作者仓储（启用缓存时作者与共同维护者变更后清除 Mod 详情缓存）
+func ProvideAuthorRepository(db *gorm.DB, modCache *repository.ModCache) repository.AuthorRepository {
+	if db == nil {
+		return nil
+	}
+	repo := repository.NewAuthorRepository(db)
+	if modCache != nil {
+		repo = repository.NewCacheInvalidatingAuthorRepository(repo, modCache)
+	}
+	return repo
+}
+
+// ProvideCommentRepository 提供评论仓储
+func ProvideCommentRepository(db *gorm.DB) repository.CommentRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewCommentRepository(db)
+}
+
+// ProvideReportRepository 提供内容举报仓储
+func ProvideReportRepository(db *gorm.DB) repository.ReportRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewReportRepository(db)
+}
+
+// ProvideCatalogRepository 提供目录数据导入导出仓储（启用缓存时导入后清除 Mod 仓储缓存）
+func ProvideCatalogRepository(db *gorm.DB, modCache *repository.ModCache) repository.CatalogRepository {
+	if db == nil {
+		return nil
+	}
+	repo := repository.NewCatalogRepository(db)
+	if modCache != nil {
+		repo = repository.NewCacheInvalidatingCatalogRepository(repo, modCache)
+	}
+	return repo
+}
+
+// ProvideCollectionRepository 提供 Mod 合集仓储
+func ProvideCollectionRepository(db *gorm.DB) repository.CollectionRepository {
 	if db == nil {
 		return nil
 	}
-	return repository.NewModRepository(db)
+	return repository.NewCollectionRepository(db)
+}
+
+// ProvideModAnalyticsRepository 提供统计汇总仓储
+func ProvideModAnalyticsRepository(db *gorm.DB) repository.ModAnalyticsRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewModAnalyticsRepository(db)
+}
+
+// ProvideTranslationRepository 提供翻译仓储
+func ProvideTranslationRepository(db *gorm.DB) repository.TranslationRepository {
+	if db == nil {
+		return nil
+	}
+	return repository.NewTranslationRepository(db)
+}
+
+// ProvideModImageRepository 提供 Mod 截图仓储（启用缓存时截图变更后清除 Mod 详情缓存）
+func ProvideModImageRepository(db *gorm.DB, modCache *repository.ModCache) repository.ModImageRepository {
+	if db == nil {
+		return nil
+	}
+	repo := repository.NewModImageRepository(db)
+	if modCache != nil {
+		repo = repository.NewCacheInvalidatingModImageRepository(repo, modCache)
+	}
+	return repo
+}
+
+// WarmUpSearchIndex 启动时为内嵌检索索引加载全量数据
+// MySQL FULLTEXT 索引由数据库维护，无需预热
+func WarmUpSearchIndex(
+	lc fx.Lifecycle,
+	repo repository.ModRepository,
+	backend repository.ModSearchBackend,
+	log *zap.Logger,
+) {
+	memory, ok := backend.(*repository.MemoryModSearch)
+	if !ok || repo == nil {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			count, err := repository.ReindexMods(repo, memory)
+			if err != nil {
+				return fmt.Errorf("build memory search index failed: %w", err)
+			}
+			log.Info("memory search index built", zap.Int("mods", count))
+			return nil
+		},
+	})
 }
@@ -14,6 +14,7 @@ import (
 	"gin-web/app/middleware"
 	"gin-web/config"
 	_ "gin-web/docs" // Swagger 文档
+	"gin-web/pkg/i18n"
 	"gin-web/routes"
 )
 
@@ -22,6 +23,7 @@ var RouterModule = fx.Module("router",
 	fx.Provide(ProvideGinEngine),
 	fx.Provide(ProvideHTTPServer),
 	fx.Invoke(RegisterRoutes),
+	fx.Invoke(ServeLocalStorage),
 	fx.Invoke(StartHTTPServer), // 触发 HTTP 服务器启动
 )
 
@@ -32,7 +34,7 @@ type ControllerParams struct {
 }
 
 // ProvideGinEngine 提供 Gin 引擎
-func ProvideGinEngine(cfg *config.Configuration) *gin.Engine {
+func ProvideGinEngine(cfg *config.Configuration, log *zap.Logger, bundle *i18n.Bundle) *gin.Engine {
 	// 禁用 Gin 的 debug 日志输出
 	gin.SetMode(gin.ReleaseMode)
 
@@ -42,7 +44,9 @@ func ProvideGinEngine(cfg *config.Configuration) *gin.Engine {
 		r.Use(gin.Logger())
 	}
 	r.Use(middleware.CustomRecovery(cfg))
+	r.Use(middleware.ErrorLog(log))
 	r.Use(middleware.Cors())
+	r.Use(middleware.I18n(bundle))
 
 	// Swagger 文档 (非生产环境)
 	if cfg.App.Env != "production" {
@@ -4,13 +4,23 @@ import (
 	"context"
 	"time"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/go-redis/redis/v8"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
+	"gin-web/app/amqp/consumer"
+	"gin-web/app/amqp/event"
+	"gin-web/app/amqp/producer"
 	"gin-web/app/services"
 	"gin-web/config"
 	"gin-web/internal/repository"
+	"gin-web/pkg/locale"
+	"gin-web/pkg/ratelimit"
+	"gin-web/pkg/trending"
+	"gin-web/pkg/validation"
+	"gin-web/pkg/version"
+	"gin-web/pkg/websocket"
 )
 
 // ServiceModule 服务模块
@@ -18,7 +28,30 @@ var ServiceModule = fx.Module("service",
 	fx.Provide(
 		ProvideUserService,
 		ProvideJwtService,
+		ProvideModEventPublisher,
+		ProvideLocalizer,
 		ProvideModService,
+		ProvideModDependencyService,
+		ProvideTagService,
+		ProvideGameService,
+		ProvideModReleaseService,
+		ProvideTrendingService,
+		ProvideNotificationService,
+		ProvideModerationService,
+		ProvideAuthorService,
+		ProvideCommentService,
+		ProvideReportService,
+		ProvideCatalogService,
+		ProvideCollectionService,
+		ProvideModAnalyticsService,
+		ProvideTranslationService,
+		ProvideImageProcessor,
+		ProvideModImageQueue,
+		ProvideModImageService,
+		fx.Annotate(
+			ProvideVersionValidationRules,
+			fx.ResultTags(`group:"validation_rules,flatten"`),
+		),
 	),
 )
 
@@ -43,12 +76,248 @@ func ProvideJwtService(
 	)
 }
 
+// ProvideModEventPublisher 提供 Mod 领域事件发布器
+// 启用 RabbitMQ 时通过交换机广播给所有订阅者；未启用或连接失败时在进程内直接同步检索索引
+func ProvideModEventPublisher(
+	lc fx.Lifecycle,
+	cfg *config.Configuration,
+	repo repository.ModRepository,
+	backend repository.ModSearchBackend,
+	log *zap.Logger,
+) services.ModEventPublisher {
+	if cfg.RabbitMQ.Enable {
+		p, err := producer.NewModEventProducer(cfg.RabbitMQ)
+		if err == nil {
+			lc.Append(fx.Hook{
+				OnStop: func(ctx context.Context) error {
+					return p.Close()
+				},
+			})
+			return p
+		}
+		log.Warn("create mod event producer failed, falling back to local index sync", zap.Error(err))
+	}
+
+	indexer, ok := backend.(repository.ModSearchIndexer)
+	if !ok || repo == nil {
+		return nil
+	}
+	return &localModEventPublisher{consumer: consumer.NewModSearchIndexConsumer(repo, indexer, log)}
+}
+
+// ProvideLocalizer 提供多语言内容服务
+func ProvideLocalizer(
+	cfg *config.Configuration,
+	repo repository.TranslationRepository,
+	log *zap.Logger,
+) *services.Localizer {
+	return services.NewLocalizer(repo, locale.NewNegotiator(cfg.Locale.Default, cfg.Locale.Supported), log)
+}
+
 // ProvideModService 提供 Mod 服务
 func ProvideModService(
 	repo repository.ModRepository,
+	localizer *services.Localizer,
+	events services.ModEventPublisher,
 	log *zap.Logger,
 ) *services.ModService {
-	return services.NewModService(repo, log)
+	return services.NewModService(repo, localizer, events, log)
+}
+
+// ProvideModDependencyService 提供 Mod 依赖服务
+func ProvideModDependencyService(
+	modRepo repository.ModRepository,
+	depRepo repository.ModDependencyRepository,
+	log *zap.Logger,
+) *services.ModDependencyService {
+	return services.NewModDependencyService(modRepo, depRepo, log)
+}
+
+// ProvideTagService 提供标签服务
+func ProvideTagService(
+	tagRepo repository.TagRepository,
+	modRepo repository.ModRepository,
+	log *zap.Logger,
+) *services.TagService {
+	return services.NewTagService(tagRepo, modRepo, log)
+}
+
+// ProvideGameService 提供游戏服务
+func ProvideGameService(
+	repo repository.GameRepository,
+	modSvc *services.ModService,
+	log *zap.Logger,
+) *services.GameService {
+	return services.NewGameService(repo, modSvc, log)
+}
+
+// ProvideVersionValidationRules 提供版本号与版本约束的验证规则（binding:"version" / binding:"version_constraint"）
+func ProvideVersionValidationRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Field("version", func(fl validator.FieldLevel) bool {
+			_, err := version.Parse(fl.Field().String())
+			return err == nil
+		}),
+		validation.Field("version_constraint", func(fl validator.FieldLevel) bool {
+			_, err := version.ParseConstraint(fl.Field().String())
+			return err == nil
+		}),
+	}
+}
+
+// ProvideModReleaseService 提供 Mod 发布版本服务
+func ProvideModReleaseService(
+	modRepo repository.ModRepository,
+	releaseRepo repository.ModReleaseRepository,
+	log *zap.Logger,
+) *services.ModReleaseService {
+	return services.NewModReleaseService(modRepo, releaseRepo, log)
+}
+
+// ProvideTrendingService 提供热度排行服务
+func ProvideTrendingService(
+	cfg *config.Configuration,
+	statRepo repository.ModStatRepository,
+	modRepo repository.ModRepository,
+	ranking repository.TrendingRanking,
+	log *zap.Logger,
+) *services.TrendingService {
+	opts := trending.Options{
+		Window:         cfg.Trending.Window,
+		HalfLife:       cfg.Trending.HalfLife,
+		DownloadWeight: cfg.Trending.DownloadWeight,
+		ViewWeight:     cfg.Trending.ViewWeight,
+	}
+	return services.NewTrendingService(statRepo, modRepo, ranking, opts, cfg.Trending.RankingSize, log)
+}
+
+// NotificationParams 通知服务参数（WebSocket 模块未启用时不做实时推送）
+type NotificationParams struct {
+	fx.In
+	Repo repository.NotificationRepository
+	WS   *websocket.Manager `optional:"true"`
+	Log  *zap.Logger
+}
+
+// ProvideNotificationService 提供站内通知服务
+func ProvideNotificationService(p NotificationParams) *services.NotificationService {
+	var pusher services.NotificationPusher
+	if p.WS != nil {
+		pusher = p.WS
+	}
+	return services.NewNotificationService(p.Repo, pusher, p.Log)
+}
+
+// ProvideModerationService 提供 Mod 审核服务
+func ProvideModerationService(
+	repo repository.ModRepository,
+	notifier *services.NotificationService,
+	log *zap.Logger,
+) *services.ModerationService {
+	return services.NewModerationService(repo, notifier, log)
+}
+
+// ProvideAuthorService 提供作者服务
+func ProvideAuthorService(
+	repo repository.AuthorRepository,
+	modRepo repository.ModRepository,
+	modSvc *services.ModService,
+	log *zap.Logger,
+) *services.AuthorService {
+	return services.NewAuthorService(repo, modRepo, modSvc, log)
+}
+
+// 评论发表频率默认值
+const (
+	defaultCommentRateLimit  = 5
+	defaultCommentRateWindow = 60 // 秒
+)
+
+// ProvideCommentService 提供评论服务
+// 发表频率使用 Redis 计数（多实例共享），未配置 Redis 时退化为进程内计数
+func ProvideCommentService(
+	cfg *config.Configuration,
+	repo repository.CommentRepository,
+	modRepo repository.ModRepository,
+	client *redis.Client,
+	log *zap.Logger,
+) *services.CommentService {
+	limit := cfg.Comment.RateLimit
+	if limit <= 0 {
+		limit = defaultCommentRateLimit
+	}
+	window := cfg.Comment.RateWindow
+	if window <= 0 {
+		window = defaultCommentRateWindow
+	}
+
+	var limiter ratelimit.Limiter
+	if client != nil {
+		limiter = ratelimit.NewRedisLimiter(client, "ratelimit:comment:", limit, time.Duration(window)*time.Second)
+	} else {
+		limiter = ratelimit.NewMemoryLimiter(limit, time.Duration(window)*time.Second)
+	}
+	return services.NewCommentService(repo, modRepo, limiter, log)
+}
+
+// defaultReportHideThreshold 默认自动隐藏的待处理举报数
+const defaultReportHideThreshold = 5
+
+// ProvideReportService 提供内容举报服务
+func ProvideReportService(
+	cfg *config.Configuration,
+	repo repository.ReportRepository,
+	modRepo repository.ModRepository,
+	commentRepo repository.CommentRepository,
+	moderationSvc *services.ModerationService,
+	log *zap.Logger,
+) *services.ReportService {
+	threshold := cfg.Report.HideThreshold
+	if threshold == 0 {
+		threshold = defaultReportHideThreshold
+	}
+	return services.NewReportService(repo, modRepo, commentRepo, moderationSvc, threshold, log)
+}
+
+// ProvideCatalogService 提供目录数据导入导出服务
+func ProvideCatalogService(
+	repo repository.CatalogRepository,
+	events services.ModEventPublisher,
+	log *zap.Logger,
+) *services.CatalogService {
+	return services.NewCatalogService(repo, events, log)
+}
+
+// ProvideCollectionService 提供 Mod 合集服务
+func ProvideCollectionService(
+	repo repository.CollectionRepository,
+	modRepo repository.ModRepository,
+	releaseRepo repository.ModReleaseRepository,
+	depRepo repository.ModDependencyRepository,
+	log *zap.Logger,
+) *services.CollectionService {
+	return services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, log)
+}
+
+// ProvideModAnalyticsService 提供下载 / 浏览统计服务
+func ProvideModAnalyticsService(
+	cfg *config.Configuration,
+	repo repository.ModAnalyticsRepository,
+	modRepo repository.ModRepository,
+	log *zap.Logger,
+) *services.ModAnalyticsService {
+	return services.NewModAnalyticsService(repo, modRepo, cfg.Stats.LookbackDays, cfg.Stats.RetentionDays, log)
+}
+
+// ProvideTranslationService 提供翻译管理服务
+func ProvideTranslationService(
+	repo repository.TranslationRepository,
+	modRepo repository.ModRepository,
+	localizer *services.Localizer,
+	events services.ModEventPublisher,
+	log *zap.Logger,
+) *services.TranslationService {
+	return services.NewTranslationService(repo, modRepo, localizer, events, log)
 }
 
 // ========== 适配器实现 ==========
@@ -95,3 +364,12 @@ type userGetterAdapter struct {
 func (a *userGetterAdapter) GetUserInfo(id string) (services.JwtUser, error) {
 	return a.svc.GetUserInfo(id)
 }
+
+// localModEventPublisher 进程内事件发布器，直接将事件应用到本地检索索引
+type localModEventPublisher struct {
+	consumer *consumer.ModSearchIndexConsumer
+}
+
+func (p *localModEventPublisher) PublishModEvent(evt event.ModEvent) error {
+	return p.consumer.Apply(evt)
+}
@@ -0,0 +1,39 @@
+package fx
+
+import (
+	"go.uber.org/fx"
+
+	"gin-web/app/dto"
+	"gin-web/bootstrap"
+	"gin-web/pkg/i18n"
+	"gin-web/pkg/validation"
+)
+
+// ValidatorModule 参数验证模块
+// 启动时向 gin 的验证器注册内置规则与各功能通过 validation_rules 分组提供的自定义规则，
+// 并检查所有请求结构体的验证标签，使用了未注册的规则时启动失败；同时注册内置规则的多语言翻译
+var ValidatorModule = fx.Module("validator",
+	fx.Invoke(InitializeValidator),
+)
+
+// ValidatorParams 验证规则参数（分组注入）
+// 提供规则时使用 fx.ResultTags(`group:"validation_rules"`)（返回 []validation.Rule 时为 `group:"validation_rules,flatten"`）
+type ValidatorParams struct {
+	fx.In
+	Rules  []validation.Rule `group:"validation_rules"`
+	Bundle *i18n.Bundle      `optional:"true"`
+}
+
+// InitializeValidator 注册验证规则并检查请求结构体的验证标签
+func InitializeValidator(params ValidatorParams) error {
+	v, err := bootstrap.InitializeValidator(params.Rules...)
+	if err != nil {
+		return err
+	}
+	if params.Bundle != nil {
+		if err := params.Bundle.RegisterValidator(v); err != nil {
+			return err
+		}
+	}
+	return validation.CheckTags(v, dto.Requests()...)
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// AuthorStats 作者聚合统计（只计入作者拥有或共同维护的已通过审核的 Mod）
+type AuthorStats struct {
+	ModCount       int64
+	TotalDownloads int64
+	TotalViews     int64
+	AverageRating  float64
+}
+
+// AuthorRepository 作者仓储接口
+type AuthorRepository interface {
+	FindUser(id uint) (*models.User, error)
+	Stats(userID uint) (*AuthorStats, error)
+	// SetOwner 变更 Mod 作者（同时移除新作者的共同维护者身份）
+	SetOwner(modID, userID uint) error
+	AddMaintainer(modID, userID uint) error
+	RemoveMaintainer(modID, userID uint) error
+	// LinkOwnersByAuthorName 按作者文本匹配用户名，为尚未关联作者的 Mod 设置所有者
+	// 只处理用户名唯一匹配的情况，返回更新的 Mod 数量
+	LinkOwnersByAuthorName() (int64, error)
+}
+
+type authorRepository struct {
+	db *gorm.DB
+}
+
+// NewAuthorRepository 创建作者仓储实例
+func NewAuthorRepository(db *gorm.DB) AuthorRepository {
+	return &authorRepository{db: db}
+}
+
+func (r *authorRepository) FindUser(id uint) (*models.User, error) {
+	var user models.User
+	if err := r.db.First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *authorRepository) Stats(userID uint) (*AuthorStats, error) {
+	var stats AuthorStats
+	err := r.db.Model(&models.Mod{}).
+		Select("COUNT(*) AS mod_count, COALESCE(SUM(download_count), 0) AS total_downloads, "+
+			"COALESCE(SUM(view_count), 0) AS total_views, COALESCE(AVG(rating), 0) AS average_rating").
+		Where("status = ?", models.ModStatusApproved).
+		Where("owner_id = ? OR id IN (?)", userID,
+			r.db.Table("gw_mod_maintainers").Select("mod_id").Where("user_id = ?", userID)).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func (r *authorRepository) SetOwner(modID, userID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Mod{ID: modID}).UpdateColumn("owner_id", userID).Error; err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM gw_mod_maintainers WHERE mod_id = ? AND user_id = ?", modID, userID).Error
+	})
+}
+
+func (r *authorRepository) AddMaintainer(modID, userID uint) error {
+	return r.db.Exec("INSERT IGNORE INTO gw_mod_maintainers (mod_id, user_id) VALUES (?, ?)", modID, userID).Error
+}
+
+func (r *authorRepository) RemoveMaintainer(modID, userID uint) error {
+	return r.db.Exec("DELETE FROM gw_mod_maintainers WHERE mod_id = ? AND user_id = ?", modID, userID).Error
+}
+
+func (r *authorRepository) LinkOwnersByAuthorName() (int64, error) {
+	// 用户名不唯一时无法确定归属，留给管理员手动指定
+	unique := r.db.Model(&models.User{}).
+		Select("MIN(id) AS id, name").
+		Group("name").
+		Having("COUNT(*) = 1")
+
+	result := r.db.Exec(`UPDATE mods
+		JOIN (?) AS u ON u.name = mods.author
+		SET mods.owner_id = u.id
+		WHERE mods.owner_id = 0 AND mods.author <> ''`, unique)
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// ModKey Mod 的自然键（同一游戏下按名称区分）
+type ModKey struct {
+	GameID uint
+	Name   string
+}
+
+// CatalogRepository 目录数据（游戏、分类、Mod）批量导入导出仓储接口
+// 导入按自然键匹配已有记录：游戏、分类按名称，Mod 按所属游戏 + 名称
+type CatalogRepository interface {
+	FindGames() ([]models.Game, error)
+	FindCategories() ([]models.Category, error)
+	// FindModKeys 全部 Mod 的自然键 → ID
+	FindModKeys() (map[ModKey]uint, error)
+	// EachMod 按 ID 升序分批遍历全部 Mod（含游戏与分类，不限审核状态）
+	EachMod(batchSize int, fn func(mods []models.Mod) error) error
+	// Transaction 在同一事务中执行写操作，fn 返回错误时全部回滚
+	Transaction(fn func(tx CatalogRepository) error) error
+	// SaveGame ID 为 0 时新建，否则只更新 columns 指定的列
+	SaveGame(game *models.Game, columns []string) error
+	// SaveCategory ID 为 0 时新建，否则只更新 columns 指定的列
+	SaveCategory(category *models.Category, columns []string) error
+	// SaveMod ID 为 0 时新建，否则只更新 columns 指定的列；categories 非 nil 时替换分类关联
+	SaveMod(mod *models.Mod, columns []string, categories []models.Category) error
+}
+
+type catalogRepository struct {
+	db *gorm.DB
+}
+
+// NewCatalogRepository 创建目录数据仓储实例
+func NewCatalogRepository(db *gorm.DB) CatalogRepository {
+	return &catalogRepository{db: db}
+}
+
+func (r *catalogRepository) FindGames() ([]models.Game, error) {
+	var games []models.Game
+	if err := r.db.Order("id").Find(&games).Error; err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+func (r *catalogRepository) FindCategories() ([]models.Category, error) {
+	var categories []models.Category
+	if err := r.db.Order("id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (r *catalogRepository) FindModKeys() (map[ModKey]uint, error) {
+	var rows []struct {
+		ID     uint
+		GameID uint
+		Name   string
+	}
+	if err := r.db.Model(&models.Mod{}).Select("id, game_id, name").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make(map[ModKey]uint, len(rows))
+	for _, row := range rows {
+		keys[ModKey{GameID: row.GameID, Name: row.Name}] = row.ID
+	}
+	return keys, nil
+}
+
+func (r *catalogRepository) EachMod(batchSize int, fn func(mods []models.Mod) error) error {
+	var mods []models.Mod
+	return r.db.Preload("Game").Preload("Categories").
+		Order("id").
+		FindInBatches(&mods, batchSize, func(tx *gorm.DB, batch int) error {
+			return fn(mods)
+		}).Error
+}
+
+func (r *catalogRepository) Transaction(fn func(tx CatalogRepository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&catalogRepository{db: tx})
+	})
+}
+
+func (r *catalogRepository) SaveGame(game *models.Game, columns []string) error {
+	if game.ID == 0 {
+		return r.db.Omit("Versions").Create(game).Error
+	}
+	return r.updateColumns(game, columns)
+}
+
+func (r *catalogRepository) SaveCategory(category *models.Category, columns []string) error {
+	if category.ID == 0 {
+		return r.db.Create(category).Error
+	}
+	return r.updateColumns(category, columns)
+}
+
+func (r *catalogRepository) SaveMod(mod *models.Mod, columns []string, categories []models.Category) error {
+	if mod.ID == 0 {
+		err := r.db.Omit("Game", "Categories", "Tags", "GameVersions", "Releases", "Owner", "Maintainers").Create(mod).Error
+		if err != nil {
+			return err
+		}
+	} else if err := r.updateColumns(mod, columns); err != nil {
+		return err
+	}
+
+	if categories == nil {
+		return nil
+	}
+	return r.db.Model(mod).Omit("Categories.*").Association("Categories").Replace(categories)
+}
+
+// updateColumns 只更新指定列（包括零值）
+func (r *catalogRepository) updateColumns(model interface{}, columns []string) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	return r.db.Model(model).Select(columns).Updates(model).Error
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// 合集排序方式
+const (
+	CollectionSortFollowers = "followers"  // 关注数
+	CollectionSortUpdated   = "updated_at" // 最近更新
+	CollectionSortCreated   = "created_at" // 最新创建
+)
+
+// CollectionCriteria 合集查询条件
+type CollectionCriteria struct {
+	GameID         uint
+	OwnerID        uint
+	FollowerID     uint // 只查询该用户关注的合集
+	IncludePrivate bool // 是否包含私有合集（仅查询自己的合集时使用）
+	SortBy         string
+	Page           int
+	PageSize       int
+}
+
+// CollectionRepository Mod 合集仓储接口
+type CollectionRepository interface {
+	// Create 创建合集及其 Mod 列表
+	Create(collection *models.Collection) error
+	// FindByID 查询合集（含创建者、游戏及按顺序排列的 Mod 与锁定的发布版本）
+	FindByID(id uint) (*models.Collection, error)
+	// Search 分页查询合集（含创建者、游戏）
+	Search(criteria CollectionCriteria) ([]models.Collection, int64, error)
+	// Update 更新合集基本信息（标题、描述、是否公开）
+	Update(collection *models.Collection) error
+	// Delete 删除合集及其 Mod 列表、关注关系
+	Delete(id uint) error
+	// ReplaceItems 按给定顺序替换合集的 Mod 列表
+	ReplaceItems(collection *models.Collection, items []models.CollectionItem) error
+	// Follow 关注合集，返回是否新增了关注
+	Follow(collectionID, userID uint) (bool, error)
+	// Unfollow 取消关注，返回是否删除了关注
+	Unfollow(collectionID, userID uint) (bool, error)
+	IsFollowing(collectionID, userID uint) (bool, error)
+}
+
+type collectionRepository struct {
+	db *gorm.DB
+}
+
+// NewCollectionRepository 创建合集仓储实例
+func NewCollectionRepository(db *gorm.DB) CollectionRepository {
+	return &collectionRepository{db: db}
+}
+
+func (r *collectionRepository) Create(collection *models.Collection) error {
+	collection.ItemCount = len(collection.Items)
+	return r.db.Omit("Owner", "Game", "Followers").Create(collection).Error
+}
+
+func (r *collectionRepository) FindByID(id uint) (*models.Collection, error) {
+	var collection models.Collection
+	err := r.db.Preload("Owner").Preload("Game").
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, id")
+		}).
+		Preload("Items.Mod").Preload("Items.Release").
+		First(&collection, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+func (r *collectionRepository) Search(criteria CollectionCriteria) ([]models.Collection, int64, error) {
+	db := r.db.Model(&models.Collection{})
+	if criteria.GameID > 0 {
+		db = db.Where("collections.game_id = ?", criteria.GameID)
+	}
+	if criteria.OwnerID > 0 {
+		db = db.Where("collections.owner_id = ?", criteria.OwnerID)
+	}
+	if criteria.FollowerID > 0 {
+		db = db.Where("collections.id IN (?)",
+			r.db.Table("gw_collection_followers").Select("collection_id").Where("user_id = ?", criteria.FollowerID))
+	}
+	if !criteria.IncludePrivate {
+		db = db.Where("collections.is_public = ?", true)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "collections.follower_count DESC, collections.id DESC"
+	switch criteria.SortBy {
+	case CollectionSortUpdated:
+		order = "collections.updated_at DESC, collections.id DESC"
+	case CollectionSortCreated:
+		order = "collections.id DESC"
+	}
+
+	var collections []models.Collection
+	err := db.Preload("Owner").Preload("Game").
+		Order(order).
+		Offset((criteria.Page - 1) * criteria.PageSize).
+		Limit(criteria.PageSize).
+		Find(&collections).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return collections, total, nil
+}
+
+func (r *collectionRepository) Update(collection *models.Collection) error {
+	return r.db.Model(collection).Select("title", "description", "is_public").Updates(collection).Error
+}
+
+func (r *collectionRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", id).Delete(&models.CollectionItem{}).Error; err != nil {
+			return err
+		}
+		collection := models.Collection{ID: id}
+		return tx.Select("Followers").Delete(&collection).Error
+	})
+}
+
+func (r *collectionRepository) ReplaceItems(collection *models.Collection, items []models.CollectionItem) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", collection.ID).Delete(&models.CollectionItem{}).Error; err != nil {
+			return err
+		}
+		for i := range items {
+			items[i].ID = 0
+			items[i].CollectionID = collection.ID
+			items[i].Position = i
+		}
+		if len(items) > 0 {
+			if err := tx.Omit("Mod", "Release").Create(&items).Error; err != nil {
+				return err
+			}
+		}
+		// 更新 Mod 数量，同时刷新 updated_at 便于按最近更新排序
+		return tx.Model(&models.Collection{ID: collection.ID}).Update("item_count", len(items)).Error
+	})
+}
+
+func (r *collectionRepository) Follow(collectionID, userID uint) (bool, error) {
+	var created bool
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("INSERT IGNORE INTO gw_collection_followers (collection_id, user_id) VALUES (?, ?)", collectionID, userID)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		created = true
+		return tx.Model(&models.Collection{}).Where("id = ?", collectionID).
+			UpdateColumn("follower_count", gorm.Expr("follower_count + ?", 1)).Error
+	})
+	return created, err
+}
+
+func (r *collectionRepository) Unfollow(collectionID, userID uint) (bool, error) {
+	var removed bool
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec("DELETE FROM gw_collection_followers WHERE collection_id = ? AND user_id = ?", collectionID, userID)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		removed = true
+		return tx.Model(&models.Collection{}).Where("id = ? AND follower_count > 0", collectionID).
+			UpdateColumn("follower_count", gorm.Expr("follower_count - ?", 1)).Error
+	})
+	return removed, err
+}
+
+func (r *collectionRepository) IsFollowing(collectionID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Table("gw_collection_followers").
+		Where("collection_id = ? AND user_id = ?", collectionID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// CommentPage 评论分页结果
+type CommentPage struct {
+	Comments   []models.Comment
+	NextCursor string // 下一页游标，没有更多数据时为空
+}
+
+// CommentRepository 评论仓储接口
+type CommentRepository interface {
+	// Create 创建评论（回复时同时累加所属顶层评论的回复数）
+	Create(comment *models.Comment) error
+	// FindByID 查询评论（含评论者）
+	FindByID(id uint) (*models.Comment, error)
+	// FindThreads 键集分页查询 Mod 的顶层评论（最新的在前）
+	FindThreads(modID uint, cursor string, limit int) (*CommentPage, error)
+	// FindReplies 键集分页查询顶层评论下的回复（最早的在前，含被回复的评论及其作者）
+	FindReplies(rootID uint, cursor string, limit int) (*CommentPage, error)
+	UpdateContent(comment *models.Comment) error
+	// SoftDelete 标记评论为已删除（保留记录以维持线程结构）
+	SoftDelete(comment *models.Comment) error
+}
+
+// commentCursor 评论分页游标（base64url 编码，对客户端不透明）
+// Scope 为顶层评论所属 Mod 或回复所属顶层评论，防止游标被用于其他线程
+type commentCursor struct {
+	Scope uint `json:"s"`
+	ID    uint `json:"i"`
+}
+
+func (c commentCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCommentCursor 解码游标，空字符串返回 nil
+func decodeCommentCursor(raw string, scope uint) (*commentCursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c commentCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Scope != scope || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+type commentRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentRepository 创建评论仓储实例
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	return &commentRepository{db: db}
+}
+
+func (r *commentRepository) Create(comment *models.Comment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User", "Parent").Create(comment).Error; err != nil {
+			return err
+		}
+		if comment.RootID == 0 {
+			return nil
+		}
+		return tx.Model(&models.Comment{}).Where("id = ?", comment.RootID).
+			UpdateColumn("reply_count", gorm.Expr("reply_count + ?", 1)).Error
+	})
+}
+
+func (r *commentRepository) FindByID(id uint) (*models.Comment, error) {
+	var comment models.Comment
+	if err := r.db.Preload("User").First(&comment, id).Error; err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *commentRepository) FindThreads(modID uint, cursor string, limit int) (*CommentPage, error) {
+	c, err := decodeCommentCursor(cursor, modID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := r.db.Preload("User").Where("mod_id = ? AND root_id = 0", modID)
+	if c != nil {
+		db = db.Where("id < ?", c.ID)
+	}
+	return r.page(db.Order("id DESC"), modID, limit)
+}
+
+func (r *commentRepository) FindReplies(rootID uint, cursor string, limit int) (*CommentPage, error) {
+	c, err := decodeCommentCursor(cursor, rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := r.db.Preload("User").Preload("Parent.User").Where("root_id = ?", rootID)
+	if c != nil {
+		db = db.Where("id > ?", c.ID)
+	}
+	return r.page(db.Order("id ASC"), rootID, limit)
+}
+
+// page 多查一条判断是否还有下一页
+func (r *commentRepository) page(db *gorm.DB, scope uint, limit int) (*CommentPage, error) {
+	var comments []models.Comment
+	if err := db.Limit(limit + 1).Find(&comments).Error; err != nil {
+		return nil, err
+	}
+
+	result := &CommentPage{Comments: comments}
+	if len(comments) > limit {
+		result.Comments = comments[:limit]
+		result.NextCursor = commentCursor{Scope: scope, ID: comments[limit-1].ID}.encode()
+	}
+	return result, nil
+}
+
+func (r *commentRepository) UpdateContent(comment *models.Comment) error {
+	now := time.Now()
+	err := r.db.Model(comment).Updates(map[string]interface{}{
+		"content":   comment.Content,
+		"edited_at": now,
+	}).Error
+	if err != nil {
+		return err
+	}
+	comment.EditedAt = &now
+	return nil
+}
+
+func (r *commentRepository) SoftDelete(comment *models.Comment) error {
+	now := time.Now()
+	err := r.db.Model(comment).Updates(map[string]interface{}{
+		"deleted_at": now,
+		"deleted_by": comment.DeletedBy,
+	}).Error
+	if err != nil {
+		return err
+	}
+	comment.DeletedAt = &now
+	return nil
+}
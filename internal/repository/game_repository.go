@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// GameStats 游戏聚合统计
+type GameStats struct {
+	ModCount       int64
+	TotalDownloads int64
+	TotalViews     int64
+}
+
+// CategoryCount 分类及其 Mod 数量
+type CategoryCount struct {
+	ID       uint
+	Name     string
+	ModCount int64
+}
+
+// GameRepository 游戏仓储接口
+type GameRepository interface {
+	// FindByID 查询游戏（含支持的游戏版本）
+	FindByID(id uint) (*models.Game, error)
+	// UpdateCover 更新游戏封面地址
+	UpdateCover(id uint, url string) error
+	// Stats 游戏下已通过审核的 Mod 统计（以下聚合均只计入已通过审核的 Mod）
+	Stats(gameID uint) (*GameStats, error)
+	// TopCategories 游戏下 Mod 数量最多的分类
+	TopCategories(gameID uint, limit int) ([]CategoryCount, error)
+	// NewestMods 游戏下最新发布的 Mod
+	NewestMods(gameID uint, limit int) ([]models.Mod, error)
+	FindVersions(gameID uint) ([]models.GameVersion, error)
+	FindVersionByID(id uint) (*models.GameVersion, error)
+	CreateVersion(version *models.GameVersion) error
+	// DeleteVersion 删除游戏版本（同时删除 Mod 及发布版本的兼容性声明）
+	DeleteVersion(id uint) error
+}
+
+type gameRepository struct {
+	db *gorm.DB
+}
+
+// NewGameRepository 创建游戏仓储实例
+func NewGameRepository(db *gorm.DB) GameRepository {
+	return &gameRepository{db: db}
+}
+
+func (r *gameRepository) FindByID(id uint) (*models.Game, error) {
+	var game models.Game
+	err := r.db.Preload("Versions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id DESC")
+	}).First(&game, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &game, nil
+}
+
+func (r *gameRepository) UpdateCover(id uint, url string) error {
+	return r.db.Model(&models.Game{ID: id}).Update("cover_image", url).Error
+}
+
+func (r *gameRepository) Stats(gameID uint) (*GameStats, error) {
+	var stats GameStats
+	err := r.db.Model(&models.Mod{}).
+		Select("COUNT(*) AS mod_count, COALESCE(SUM(download_count), 0) AS total_downloads, COALESCE(SUM(view_count), 0) AS total_views").
+		Where("game_id = ? AND status = ?", gameID, models.ModStatusApproved).
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func (r *gameRepository) TopCategories(gameID uint, limit int) ([]CategoryCount, error) {
+	var rows []CategoryCount
+	err := r.db.Model(&models.Mod{}).
+		Select("categories.id AS id, categories.name AS name, COUNT(*) AS mod_count").
+		Joins("JOIN gw_mod_categories ON gw_mod_categories.mod_id = mods.id").
+		Joins("JOIN categories ON categories.id = gw_mod_categories.category_id").
+		Where("mods.game_id = ? AND mods.status = ?", gameID, models.ModStatusApproved).
+		Group("categories.id, categories.name").
+		Order("mod_count DESC, categories.id").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *gameRepository) NewestMods(gameID uint, limit int) ([]models.Mod, error) {
+	var mods []models.Mod
+	err := r.db.Preload("Game").Preload("Categories").Preload("Tags").
+		Where("game_id = ? AND status = ?", gameID, models.ModStatusApproved).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&mods).Error
+	if err != nil {
+		return nil, err
+	}
+	return mods, nil
+}
+
+func (r *gameRepository) FindVersions(gameID uint) ([]models.GameVersion, error) {
+	var versions []models.GameVersion
+	if err := r.db.Where("game_id = ?", gameID).Order("id DESC").Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (r *gameRepository) FindVersionByID(id uint) (*models.GameVersion, error) {
+	var version models.GameVersion
+	if err := r.db.First(&version, id).Error; err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+func (r *gameRepository) CreateVersion(version *models.GameVersion) error {
+	return r.db.Create(version).Error
+}
+
+func (r *gameRepository) DeleteVersion(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM gw_mod_game_versions WHERE game_version_id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM gw_release_game_versions WHERE game_version_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.GameVersion{}, id).Error
+	})
+}
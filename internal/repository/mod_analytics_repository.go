@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"time"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// VersionDownloads 版本在统计区间内的下载量
+type VersionDownloads struct {
+	Version   string
+	Downloads int64
+}
+
+// ModStatTotal Mod 在统计区间内的累计计数
+type ModStatTotal struct {
+	ModID     uint
+	Name      string
+	Downloads int64
+	Views     int64
+}
+
+// GameStatTotal 游戏在统计区间内的累计计数
+type GameStatTotal struct {
+	GameID    uint
+	Name      string
+	Downloads int64
+	Views     int64
+}
+
+// ModAnalyticsRepository Mod 统计事件汇总与报表查询仓储接口
+// 日期区间均为闭区间 [from, to]，按 date 列（自然日）过滤
+type ModAnalyticsRepository interface {
+	// Rollup 将 since（含）之后的统计事件按天汇总到每日计数与版本每日下载表，返回写入的每日计数行数
+	// 汇总结果与已有计数取较大值，重复执行不会重复累加
+	Rollup(since time.Time) (int64, error)
+	// PurgeEvents 删除 before 之前的统计事件，返回删除的行数
+	PurgeEvents(before time.Time) (int64, error)
+	// FindDailyStats 查询 Mod 在区间内的每日计数（按日期升序，无数据的日期不返回）
+	FindDailyStats(modID uint, from, to time.Time) ([]models.ModDailyStat, error)
+	// SumDailyStats 全站每日计数之和（按日期升序，ModID 为 0）
+	SumDailyStats(from, to time.Time) ([]models.ModDailyStat, error)
+	// FindVersionDownloads Mod 各版本在区间内的下载量（下载量多的在前）
+	FindVersionDownloads(modID uint, from, to time.Time) ([]VersionDownloads, error)
+	// TopMods 区间内下载量最多的 Mod
+	TopMods(from, to time.Time, limit int) ([]ModStatTotal, error)
+	// TopGames 区间内下载量最多的游戏
+	TopGames(from, to time.Time, limit int) ([]GameStatTotal, error)
+}
+
+type modAnalyticsRepository struct {
+	db *gorm.DB
+}
+
+// NewModAnalyticsRepository 创建统计汇总仓储实例
+func NewModAnalyticsRepository(db *gorm.DB) ModAnalyticsRepository {
+	return &modAnalyticsRepository{db: db}
+}
+
+func (r *modAnalyticsRepository) Rollup(since time.Time) (int64, error) {
+	var rows int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`INSERT INTO mod_daily_stats (mod_id, date, downloads, views, unique_users)
+			SELECT mod_id, DATE(created_at), SUM(type = ?), SUM(type = ?), COUNT(DISTINCT visitor)
+			FROM mod_stat_events WHERE created_at >= ?
+			GROUP BY mod_id, DATE(created_at)
+			ON DUPLICATE KEY UPDATE
+				downloads = GREATEST(downloads, VALUES(downloads)),
+				views = GREATEST(views, VALUES(views)),
+				unique_users = GREATEST(unique_users, VALUES(unique_users))`,
+			models.StatEventDownload, models.StatEventView, since)
+		if result.Error != nil {
+			return result.Error
+		}
+		rows = result.RowsAffected
+
+		return tx.Exec(`INSERT INTO mod_version_daily_stats (mod_id, version, date, downloads)
+			SELECT mod_id, version, DATE(created_at), COUNT(*)
+			FROM mod_stat_events WHERE created_at >= ? AND type = ? AND version <> ''
+			GROUP BY mod_id, version, DATE(created_at)
+			ON DUPLICATE KEY UPDATE downloads = GREATEST(downloads, VALUES(downloads))`,
+			since, models.StatEventDownload).Error
+	})
+	return rows, err
+}
+
+func (r *modAnalyticsRepository) PurgeEvents(before time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", before).Delete(&models.ModStatEvent{})
+	return result.RowsAffected, result.Error
+}
+
+func (r *modAnalyticsRepository) FindDailyStats(modID uint, from, to time.Time) ([]models.ModDailyStat, error) {
+	var stats []models.ModDailyStat
+	err := r.db.Where("mod_id = ? AND date BETWEEN ? AND ?", modID, from, to).
+		Order("date").
+		Find(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (r *modAnalyticsRepository) SumDailyStats(from, to time.Time) ([]models.ModDailyStat, error) {
+	var stats []models.ModDailyStat
+	err := r.db.Model(&models.ModDailyStat{}).
+		Select("date, SUM(downloads) AS downloads, SUM(views) AS views, SUM(unique_users) AS unique_users").
+		Where("date BETWEEN ? AND ?", from, to).
+		Group("date").
+		Order("date").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (r *modAnalyticsRepository) FindVersionDownloads(modID uint, from, to time.Time) ([]VersionDownloads, error) {
+	var rows []VersionDownloads
+	err := r.db.Model(&models.ModVersionDailyStat{}).
+		Select("version, SUM(downloads) AS downloads").
+		Where("mod_id = ? AND date BETWEEN ? AND ?", modID, from, to).
+		Group("version").
+		Order("downloads DESC, version").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *modAnalyticsRepository) TopMods(from, to time.Time, limit int) ([]ModStatTotal, error) {
+	var rows []ModStatTotal
+	err := r.db.Table("mod_daily_stats s").
+		Select("s.mod_id, mods.name, SUM(s.downloads) AS downloads, SUM(s.views) AS views").
+		Joins("JOIN mods ON mods.id = s.mod_id").
+		Where("s.date BETWEEN ? AND ?", from, to).
+		Group("s.mod_id, mods.name").
+		Order("downloads DESC, views DESC, s.mod_id").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *modAnalyticsRepository) TopGames(from, to time.Time, limit int) ([]GameStatTotal, error) {
+	var rows []GameStatTotal
+	err := r.db.Table("mod_daily_stats s").
+		Select("games.id AS game_id, games.name, SUM(s.downloads) AS downloads, SUM(s.views) AS views").
+		Joins("JOIN mods ON mods.id = s.mod_id").
+		Joins("JOIN games ON games.id = mods.game_id").
+		Where("s.date BETWEEN ? AND ?", from, to).
+		Group("games.id, games.name").
+		Order("downloads DESC, views DESC, games.id").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,435 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"gin-web/app/models"
+	"gin-web/pkg/cache"
+)
+
+// 缓存默认过期时间
+const (
+	defaultCatalogCacheTTL = 10 * time.Minute
+	defaultModCacheTTL     = time.Minute
+)
+
+// modCacheGenerationKey 缓存代数：所有缓存键都带有当前代数，代数递增后旧键全部失效（随过期时间自然清除）
+const modCacheGenerationKey = "gen"
+
+// ModCacheOptions 各方法的缓存过期时间（为 0 时使用默认值，小于 0 时不缓存该方法）
+type ModCacheOptions struct {
+	GamesTTL      time.Duration // FindAllGames，默认 10 分钟
+	CategoriesTTL time.Duration // FindAllCategories，默认 10 分钟
+	GameTTL       time.Duration // FindGameByID，默认 10 分钟
+	ModTTL        time.Duration // FindPublicByID，默认 1 分钟
+}
+
+// ModCache Mod 仓储的读穿缓存
+// 缓存未命中时查询数据库并写入缓存，同一键的并发未命中只查询一次；缓存不可用时直接查询数据库
+type ModCache struct {
+	store cache.Store
+	opts  ModCacheOptions
+	log   *zap.Logger
+	group singleflight.Group
+}
+
+// NewModCache 创建 Mod 仓储缓存
+func NewModCache(store cache.Store, opts ModCacheOptions, log *zap.Logger) *ModCache {
+	if opts.GamesTTL == 0 {
+		opts.GamesTTL = defaultCatalogCacheTTL
+	}
+	if opts.CategoriesTTL == 0 {
+		opts.CategoriesTTL = defaultCatalogCacheTTL
+	}
+	if opts.GameTTL == 0 {
+		opts.GameTTL = defaultCatalogCacheTTL
+	}
+	if opts.ModTTL == 0 {
+		opts.ModTTL = defaultModCacheTTL
+	}
+	return &ModCache{store: store, opts: opts, log: log}
+}
+
+// InvalidateCatalog 清除全部缓存（游戏、分类或批量导入的 Mod 变更后调用）
+func (c *ModCache) InvalidateCatalog() error {
+	_, err := c.store.Incr(context.Background(), modCacheGenerationKey)
+	return err
+}
+
+// InvalidateMod 清除 Mod 详情缓存
+func (c *ModCache) InvalidateMod(id uint) error {
+	ctx := context.Background()
+	gen, err := c.generation(ctx)
+	if err != nil {
+		return err
+	}
+	return c.store.Delete(ctx, gen+":"+modCacheKey(id))
+}
+
+// afterWrite 写操作成功后清除缓存，清除失败只记录日志（缓存在过期后自然更新），不影响写操作的结果
+func (c *ModCache) afterWrite(invalidate func() error) {
+	if err := invalidate(); err != nil {
+		c.log.Warn("invalidate mod repository cache failed", zap.Error(err))
+	}
+}
+
+func (c *ModCache) generation(ctx context.Context) (string, error) {
+	gen, err := c.store.Get(ctx, modCacheGenerationKey)
+	if errors.Is(err, cache.ErrMiss) {
+		return "0", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(gen), nil
+}
+
+// fetchCached 读取缓存，未命中时调用 load 并写入缓存
+// 缓存中保存编码后的数据，每个调用方得到各自解码的副本，可以放心修改（如替换为翻译内容）
+func fetchCached[T any](c *ModCache, key string, ttl time.Duration, load func() (T, error)) (T, error) {
+	if ttl < 0 {
+		return load()
+	}
+
+	ctx := context.Background()
+	gen, err := c.generation(ctx)
+	if err != nil {
+		return load()
+	}
+	key = gen + ":" + key
+
+	if data, err := c.store.Get(ctx, key); err == nil {
+		if value, err := decodeCached[T](data); err == nil {
+			return value, nil
+		}
+	}
+
+	data, err, _ := c.group.Do(key, func() (interface{}, error) {
+		value, err := load()
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(value); err != nil {
+			return nil, err
+		}
+		// 写入失败只影响下次是否命中
+		_ = c.store.Set(ctx, key, buf.Bytes(), ttl)
+		return buf.Bytes(), nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return decodeCached[T](data.([]byte))
+}
+
+func decodeCached[T any](data []byte) (T, error) {
+	var value T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value)
+	return value, err
+}
+
+func modCacheKey(id uint) string {
+	return "mod:" + strconv.FormatUint(uint64(id), 10)
+}
+
+func gameCacheKey(id uint) string {
+	return "game:" + strconv.FormatUint(uint64(id), 10)
+}
+
+// cachedModRepository 带缓存的 Mod 仓储
+// 缓存游戏列表、分类列表、游戏及公开的 Mod 详情；Mod 的写操作后清除其详情缓存
+// FindByID 供写操作与审核使用，始终查询数据库；浏览、下载计数在详情缓存过期前不会更新
+type cachedModRepository struct {
+	ModRepository
+	cache *ModCache
+}
+
+// NewCachedModRepository 为 Mod 仓储增加读穿缓存
+func NewCachedModRepository(repo ModRepository, cache *ModCache) ModRepository {
+	return &cachedModRepository{ModRepository: repo, cache: cache}
+}
+
+func (r *cachedModRepository) FindAllGames() ([]models.Game, error) {
+	games, err := fetchCached(r.cache, "games", r.cache.opts.GamesTTL, r.ModRepository.FindAllGames)
+	if err == nil && games == nil {
+		games = []models.Game{}
+	}
+	return games, err
+}
+
+func (r *cachedModRepository) FindAllCategories() ([]models.Category, error) {
+	categories, err := fetchCached(r.cache, "categories", r.cache.opts.CategoriesTTL, r.ModRepository.FindAllCategories)
+	if err == nil && categories == nil {
+		categories = []models.Category{}
+	}
+	return categories, err
+}
+
+func (r *cachedModRepository) FindGameByID(id uint) (*models.Game, error) {
+	return fetchCached(r.cache, gameCacheKey(id), r.cache.opts.GameTTL, func() (*models.Game, error) {
+		return r.ModRepository.FindGameByID(id)
+	})
+}
+
+func (r *cachedModRepository) FindPublicByID(id uint) (*models.Mod, error) {
+	return fetchCached(r.cache, modCacheKey(id), r.cache.opts.ModTTL, func() (*models.Mod, error) {
+		mod, err := r.ModRepository.FindPublicByID(id)
+		if err != nil {
+			return nil, err
+		}
+		// 缓存中不保存账号密码摘要
+		if mod.Owner != nil {
+			mod.Owner.Password = ""
+		}
+		for i := range mod.Maintainers {
+			mod.Maintainers[i].Password = ""
+		}
+		return mod, nil
+	})
+}
+
+func (r *cachedModRepository) UpdateStatus(mod *models.Mod, review *models.ModReview) error {
+	if err := r.ModRepository.UpdateStatus(mod, review); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(mod.ID) })
+	return nil
+}
+
+func (r *cachedModRepository) Update(mod *models.Mod) error {
+	if err := r.ModRepository.Update(mod); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(mod.ID) })
+	return nil
+}
+
+func (r *cachedModRepository) Delete(id uint) error {
+	if err := r.ModRepository.Delete(id); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(id) })
+	return nil
+}
+
+// cacheInvalidatingGameRepository 游戏写操作后清除 Mod 仓储缓存（游戏信息随 Mod 详情一起缓存）
+type cacheInvalidatingGameRepository struct {
+	GameRepository
+	cache *ModCache
+}
+
+// NewCacheInvalidatingGameRepository 游戏封面、游戏版本变更后清除 Mod 仓储缓存
+func NewCacheInvalidatingGameRepository(repo GameRepository, cache *ModCache) GameRepository {
+	return &cacheInvalidatingGameRepository{GameRepository: repo, cache: cache}
+}
+
+func (r *cacheInvalidatingGameRepository) UpdateCover(id uint, url string) error {
+	if err := r.GameRepository.UpdateCover(id, url); err != nil {
+		return err
+	}
+	r.cache.afterWrite(r.cache.InvalidateCatalog)
+	return nil
+}
+
+func (r *cacheInvalidatingGameRepository) CreateVersion(version *models.GameVersion) error {
+	if err := r.GameRepository.CreateVersion(version); err != nil {
+		return err
+	}
+	r.cache.afterWrite(r.cache.InvalidateCatalog)
+	return nil
+}
+
+func (r *cacheInvalidatingGameRepository) DeleteVersion(id uint) error {
+	if err := r.GameRepository.DeleteVersion(id); err != nil {
+		return err
+	}
+	r.cache.afterWrite(r.cache.InvalidateCatalog)
+	return nil
+}
+
+// cacheInvalidatingCatalogRepository 目录数据写入后清除 Mod 仓储缓存
+type cacheInvalidatingCatalogRepository struct {
+	CatalogRepository
+	cache *ModCache
+}
+
+// NewCacheInvalidatingCatalogRepository 导入游戏、分类与 Mod 后清除 Mod 仓储缓存
+// 事务内的写入在事务提交后统一清除
+func NewCacheInvalidatingCatalogRepository(repo CatalogRepository, cache *ModCache) CatalogRepository {
+	return &cacheInvalidatingCatalogRepository{CatalogRepository: repo, cache: cache}
+}
+
+func (r *cacheInvalidatingCatalogRepository) Transaction(fn func(tx CatalogRepository) error) error {
+	if err := r.CatalogRepository.Transaction(fn); err != nil {
+		return err
+	}
+	r.cache.afterWrite(r.cache.InvalidateCatalog)
+	return nil
+}
+
+func (r *cacheInvalidatingCatalogRepository) SaveGame(game *models.Game, columns []string) error {
+	if err := r.CatalogRepository.SaveGame(game, columns); err != nil {
+		return err
+	}
+	r.cache.afterWrite(r.cache.InvalidateCatalog)
+	return nil
+}
+
+func (r *cacheInvalidatingCatalogRepository) SaveCategory(category *models.Category, columns []string) error {
+	if err := r.CatalogRepository.SaveCategory(category, columns); err != nil {
+		return err
+	}
+	r.cache.afterWrite(r.cache.InvalidateCatalog)
+	return nil
+}
+
+func (r *cacheInvalidatingCatalogRepository) SaveMod(mod *models.Mod, columns []string, categories []models.Category) error {
+	if err := r.CatalogRepository.SaveMod(mod, columns, categories); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(mod.ID) })
+	return nil
+}
+
+// cacheInvalidatingTagRepository 标签关联变更后清除 Mod 详情缓存（标签随 Mod 详情一起缓存）
+type cacheInvalidatingTagRepository struct {
+	TagRepository
+	cache *ModCache
+}
+
+// NewCacheInvalidatingTagRepository 为 Mod 添加或移除标签后清除其详情缓存
+func NewCacheInvalidatingTagRepository(repo TagRepository, cache *ModCache) TagRepository {
+	return &cacheInvalidatingTagRepository{TagRepository: repo, cache: cache}
+}
+
+func (r *cacheInvalidatingTagRepository) AttachToMod(modID, tagID uint) (bool, error) {
+	attached, err := r.TagRepository.AttachToMod(modID, tagID)
+	if err != nil {
+		return attached, err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(modID) })
+	return attached, nil
+}
+
+func (r *cacheInvalidatingTagRepository) DetachFromMod(modID, tagID uint) (bool, error) {
+	detached, err := r.TagRepository.DetachFromMod(modID, tagID)
+	if err != nil {
+		return detached, err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(modID) })
+	return detached, nil
+}
+
+// cacheInvalidatingAuthorRepository 作者与共同维护者变更后清除 Mod 详情缓存
+type cacheInvalidatingAuthorRepository struct {
+	AuthorRepository
+	cache *ModCache
+}
+
+// NewCacheInvalidatingAuthorRepository 变更作者、增删共同维护者后清除 Mod 详情缓存，
+// 按作者文本批量关联所有者后清除全部缓存
+func NewCacheInvalidatingAuthorRepository(repo AuthorRepository, cache *ModCache) AuthorRepository {
+	return &cacheInvalidatingAuthorRepository{AuthorRepository: repo, cache: cache}
+}
+
+func (r *cacheInvalidatingAuthorRepository) SetOwner(modID, userID uint) error {
+	if err := r.AuthorRepository.SetOwner(modID, userID); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(modID) })
+	return nil
+}
+
+func (r *cacheInvalidatingAuthorRepository) AddMaintainer(modID, userID uint) error {
+	if err := r.AuthorRepository.AddMaintainer(modID, userID); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(modID) })
+	return nil
+}
+
+func (r *cacheInvalidatingAuthorRepository) RemoveMaintainer(modID, userID uint) error {
+	if err := r.AuthorRepository.RemoveMaintainer(modID, userID); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(modID) })
+	return nil
+}
+
+func (r *cacheInvalidatingAuthorRepository) LinkOwnersByAuthorName() (int64, error) {
+	updated, err := r.AuthorRepository.LinkOwnersByAuthorName()
+	if err != nil {
+		return updated, err
+	}
+	if updated > 0 {
+		r.cache.afterWrite(r.cache.InvalidateCatalog)
+	}
+	return updated, nil
+}
+
+// cacheInvalidatingModImageRepository 截图变更后清除 Mod 详情缓存（处理完成的截图随 Mod 详情一起缓存）
+type cacheInvalidatingModImageRepository struct {
+	ModImageRepository
+	cache *ModCache
+}
+
+// NewCacheInvalidatingModImageRepository 截图新增、处理完成、修改说明、排序或删除后清除所属 Mod 的详情缓存
+func NewCacheInvalidatingModImageRepository(repo ModImageRepository, cache *ModCache) ModImageRepository {
+	return &cacheInvalidatingModImageRepository{ModImageRepository: repo, cache: cache}
+}
+
+func (r *cacheInvalidatingModImageRepository) Create(image *models.ModImage) error {
+	if err := r.ModImageRepository.Create(image); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(image.ModID) })
+	return nil
+}
+
+func (r *cacheInvalidatingModImageRepository) Update(image *models.ModImage) error {
+	if err := r.ModImageRepository.Update(image); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(image.ModID) })
+	return nil
+}
+
+func (r *cacheInvalidatingModImageRepository) UpdateCaption(id uint, caption string) error {
+	if err := r.ModImageRepository.UpdateCaption(id, caption); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error {
+		image, err := r.ModImageRepository.FindByID(id)
+		if err != nil {
+			return err
+		}
+		return r.cache.InvalidateMod(image.ModID)
+	})
+	return nil
+}
+
+func (r *cacheInvalidatingModImageRepository) Reorder(modID uint, ids []uint) error {
+	if err := r.ModImageRepository.Reorder(modID, ids); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(modID) })
+	return nil
+}
+
+func (r *cacheInvalidatingModImageRepository) Delete(image *models.ModImage) error {
+	if err := r.ModImageRepository.Delete(image); err != nil {
+		return err
+	}
+	r.cache.afterWrite(func() error { return r.cache.InvalidateMod(image.ModID) })
+	return nil
+}
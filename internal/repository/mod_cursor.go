@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"gin-web/app/models"
+)
+
+// ErrInvalidCursor 游标无法解析，或与当前排序/筛选条件不匹配
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// modCursor 键集分页游标（序列化后以 base64url 编码，对客户端不透明）
+// 记录上一页最后一条记录的排序键和 ID，下一页从该位置之后继续读取
+type modCursor struct {
+	SortBy string      `json:"s"`
+	Order  string      `json:"o"`
+	Value  interface{} `json:"v"`
+	ID     uint        `json:"i"`
+	Filter uint32      `json:"f"` // 筛选条件指纹，防止游标被用于不同的查询
+}
+
+// encode 编码游标
+func (c modCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeModCursor 解码游标并校验与当前查询是否匹配
+func decodeModCursor(raw, sortBy, order string, filter uint32) (*modCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c modCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.SortBy != sortBy || c.Order != order || c.Filter != filter {
+		return nil, ErrInvalidCursor
+	}
+
+	// 时间类排序键以 RFC3339 字符串保存，还原为 time.Time 以便数据库比较
+	if isTimeSortField(sortBy) {
+		s, ok := c.Value.(string)
+		if !ok {
+			return nil, ErrInvalidCursor
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		c.Value = t
+	} else if _, ok := c.Value.(float64); !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// newModCursor 根据最后一条记录生成游标
+func newModCursor(mod models.Mod, sortBy, order string, filter uint32, score float64) modCursor {
+	var value interface{}
+	switch sortBy {
+	case SortByRelevance:
+		value = score
+	case SortByTrending:
+		value = mod.TrendingScore
+	case "rating":
+		value = mod.Rating
+	case "download_count":
+		value = mod.DownloadCount
+	case "view_count":
+		value = mod.ViewCount
+	case "created_at":
+		value = mod.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		value = mod.UpdatedAt.Format(time.RFC3339Nano)
+	}
+	return modCursor{SortBy: sortBy, Order: order, Value: value, ID: mod.ID, Filter: filter}
+}
+
+// isTimeSortField 是否为时间类排序字段
+func isTimeSortField(sortBy string) bool {
+	return sortBy == "created_at" || sortBy == "updated_at"
+}
+
+// filterFingerprint 计算筛选条件指纹
+func filterFingerprint(criteria ModSearchCriteria) uint32 {
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%s|%v|%v|%v|%v|%s|%d",
+		criteria.Keyword, criteria.GameIDs, criteria.CategoryIDs, criteria.Tags, criteria.GameVersionIDs, criteria.Author, criteria.AuthorID)
+	return h.Sum32()
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// ModDependencyRepository Mod 依赖关系仓储接口
+type ModDependencyRepository interface {
+	FindByID(id uint) (*models.ModDependency, error)
+	// FindByModIDs 查询多个 Mod 声明的全部依赖关系（含被依赖的 Mod），用于逐层展开依赖图
+	FindByModIDs(modIDs []uint) ([]models.ModDependency, error)
+	Create(dep *models.ModDependency) error
+	Update(dep *models.ModDependency) error
+	Delete(id uint) error
+}
+
+type modDependencyRepository struct {
+	db *gorm.DB
+}
+
+// NewModDependencyRepository 创建 Mod 依赖关系仓储实例
+func NewModDependencyRepository(db *gorm.DB) ModDependencyRepository {
+	return &modDependencyRepository{db: db}
+}
+
+func (r *modDependencyRepository) FindByID(id uint) (*models.ModDependency, error) {
+	var dep models.ModDependency
+	if err := r.db.Preload("DependsOn").First(&dep, id).Error; err != nil {
+		return nil, err
+	}
+	return &dep, nil
+}
+
+func (r *modDependencyRepository) FindByModIDs(modIDs []uint) ([]models.ModDependency, error) {
+	var deps []models.ModDependency
+	if len(modIDs) == 0 {
+		return deps, nil
+	}
+	err := r.db.Preload("DependsOn").
+		Where("mod_id IN ?", modIDs).
+		Order("mod_id, depends_on_id").
+		Find(&deps).Error
+	if err != nil {
+		return nil, err
+	}
+	return deps, nil
+}
+
+func (r *modDependencyRepository) Create(dep *models.ModDependency) error {
+	return r.db.Omit("Mod", "DependsOn").Create(dep).Error
+}
+
+func (r *modDependencyRepository) Update(dep *models.ModDependency) error {
+	return r.db.Omit("Mod", "DependsOn", "CreatedAt").Save(dep).Error
+}
+
+func (r *modDependencyRepository) Delete(id uint) error {
+	return r.db.Delete(&models.ModDependency{}, id).Error
+}
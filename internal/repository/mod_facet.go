@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gin-web/app/models"
+)
+
+// 分面维度
+const (
+	facetGame     = "game"
+	facetCategory = "category"
+)
+
+// facetAuthorLimit 作者分面最多返回的条目数
+const facetAuthorLimit = 20
+
+// ratingBuckets 评分分桶数（0-1, 1-2, ..., 4-5，满分计入最后一个桶）
+const ratingBuckets = 5
+
+// ModFacetBucket 分面统计项
+type ModFacetBucket struct {
+	Value string // 筛选值（游戏/分类 ID、作者用户 ID、评分桶下限）
+	Label string // 展示名称
+	Count int64
+}
+
+// ModFacets 分面统计结果（基于当前关键词和筛选条件）
+// 游戏、分类分面忽略自身维度的筛选，便于展示多选时每个选项对应的结果数
+type ModFacets struct {
+	Games      []ModFacetBucket
+	Categories []ModFacetBucket
+	Authors    []ModFacetBucket // 按 Mod 所有者统计，按数量降序，最多 facetAuthorLimit 条
+	Ratings    []ModFacetBucket // 按评分从高到低，固定 ratingBuckets 个桶
+}
+
+// facetRow 分面聚合查询结果
+type facetRow struct {
+	ID    uint
+	Name  string
+	Count int64
+}
+
+// modCategoryRow Mod 与分类的关联
+type modCategoryRow struct {
+	ModID      uint
+	CategoryID uint
+}
+
+// ratingFacetRow 评分分桶聚合查询结果
+type ratingFacetRow struct {
+	Bucket int
+	Count  int64
+}
+
+// searchFacets 统计分面
+func (r *modRepository) searchFacets(filter *modFilter) (*ModFacets, error) {
+	facets := &ModFacets{}
+
+	// 游戏分面
+	var games []facetRow
+	err := filter.apply(r.db.Model(&models.Mod{}), facetGame).
+		Select("games.id AS id, games.name AS name, COUNT(*) AS count").
+		Joins("JOIN games ON games.id = mods.game_id").
+		Group("games.id, games.name").
+		Order("count DESC, games.id").
+		Scan(&games).Error
+	if err != nil {
+		return nil, err
+	}
+	facets.Games = idFacetBuckets(games)
+
+	// 分类分面：与分类筛选一致，父分类计入其子孙分类下的 Mod
+	facets.Categories, err = r.categoryFacets(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// 作者分面：按所有者用户统计，筛选值为用户 ID（可直接用作 author_id 筛选），未关联作者的 Mod 不计入
+	var authors []facetRow
+	err = filter.apply(r.db.Model(&models.Mod{}), "").
+		Select("users.id AS id, users.name AS name, COUNT(*) AS count").
+		Joins("JOIN users ON users.id = mods.owner_id").
+		Group("users.id, users.name").
+		Order("count DESC, users.id").
+		Limit(facetAuthorLimit).
+		Scan(&authors).Error
+	if err != nil {
+		return nil, err
+	}
+	facets.Authors = idFacetBuckets(authors)
+
+	// 评分分面
+	var ratings []ratingFacetRow
+	err = filter.apply(r.db.Model(&models.Mod{}), "").
+		Select(fmt.Sprintf("LEAST(FLOOR(mods.rating), %d) AS bucket, COUNT(*) AS count", ratingBuckets-1)).
+		Group("bucket").
+		Scan(&ratings).Error
+	if err != nil {
+		return nil, err
+	}
+	facets.Ratings = ratingFacetBuckets(ratings)
+
+	return facets, nil
+}
+
+// categoryFacets 统计分类分面
+// 每个分类的数量为属于该分类或其任一子孙分类的 Mod 数（同一 Mod 只计一次），即按该分类筛选时的结果数
+func (r *modRepository) categoryFacets(filter *modFilter) ([]ModFacetBucket, error) {
+	var rows []modCategoryRow
+	err := filter.apply(r.db.Model(&models.Mod{}), facetCategory).
+		Distinct("gw_mod_categories.mod_id AS mod_id", "gw_mod_categories.category_id AS category_id").
+		Joins("JOIN gw_mod_categories ON gw_mod_categories.mod_id = mods.id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []ModFacetBucket{}, nil
+	}
+
+	var categories []models.Category
+	if err := r.db.Select("id", "name", "parent_id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	modsByCategory := make(map[uint][]uint)
+	for _, row := range rows {
+		modsByCategory[row.CategoryID] = append(modsByCategory[row.CategoryID], row.ModID)
+	}
+
+	children := categoryChildren(categories)
+	counts := make([]facetRow, 0, len(categories))
+	for _, category := range categories {
+		mods := make(map[uint]struct{})
+		for _, id := range expandCategories(children, []uint{category.ID}) {
+			for _, modID := range modsByCategory[id] {
+				mods[modID] = struct{}{}
+			}
+		}
+		if len(mods) > 0 {
+			counts = append(counts, facetRow{ID: category.ID, Name: category.Name, Count: int64(len(mods))})
+		}
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].ID < counts[j].ID
+	})
+	return idFacetBuckets(counts), nil
+}
+
+// idFacetBuckets 转换以 ID 为筛选值的分面
+func idFacetBuckets(rows []facetRow) []ModFacetBucket {
+	buckets := make([]ModFacetBucket, len(rows))
+	for i, row := range rows {
+		buckets[i] = ModFacetBucket{Value: strconv.FormatUint(uint64(row.ID), 10), Label: row.Name, Count: row.Count}
+	}
+	return buckets
+}
+
+// ratingFacetBuckets 补齐所有评分桶（无数据的桶计数为 0），按评分从高到低排列
+func ratingFacetBuckets(rows []ratingFacetRow) []ModFacetBucket {
+	counts := make(map[int]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Bucket] = row.Count
+	}
+
+	buckets := make([]ModFacetBucket, 0, ratingBuckets)
+	for i := ratingBuckets - 1; i >= 0; i-- {
+		buckets = append(buckets, ModFacetBucket{
+			Value: strconv.Itoa(i),
+			Label: fmt.Sprintf("%d-%d", i, i+1),
+			Count: counts[i],
+		})
+	}
+	return buckets
+}
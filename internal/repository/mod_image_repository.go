@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"time"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// ModImageRepository Mod 截图仓储接口
+// 截图新增、处理完成、排序或删除后同步更新 mods.thumbnail_url（第一张处理完成的截图的缩略图）
+type ModImageRepository interface {
+	// Create 新增截图（排在最后）
+	Create(image *models.ModImage) error
+	FindByID(id uint) (*models.ModImage, error)
+	// ListByMod 查询 Mod 的全部截图（不限处理状态，按顺序排列）
+	ListByMod(modID uint) ([]models.ModImage, error)
+	CountByMod(modID uint) (int64, error)
+	// Update 保存截图的处理结果
+	Update(image *models.ModImage) error
+	UpdateCaption(id uint, caption string) error
+	// Reorder 按给定顺序重排截图（ids 需为该 Mod 的全部截图）
+	Reorder(modID uint, ids []uint) error
+	Delete(image *models.ModImage) error
+	// FindStale 查询在 before 之前上传且仍未处理的截图
+	FindStale(before time.Time, limit int) ([]models.ModImage, error)
+	// FindOrphans 查询所属 Mod 已删除的截图
+	FindOrphans(limit int) ([]models.ModImage, error)
+}
+
+type modImageRepository struct {
+	db *gorm.DB
+}
+
+// NewModImageRepository 创建 Mod 截图仓储实例
+func NewModImageRepository(db *gorm.DB) ModImageRepository {
+	return &modImageRepository{db: db}
+}
+
+func (r *modImageRepository) Create(image *models.ModImage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var position int
+		err := tx.Model(&models.ModImage{}).
+			Where("mod_id = ?", image.ModID).
+			Select("COALESCE(MAX(position) + 1, 0)").
+			Scan(&position).Error
+		if err != nil {
+			return err
+		}
+		image.Position = position
+		return tx.Create(image).Error
+	})
+}
+
+func (r *modImageRepository) FindByID(id uint) (*models.ModImage, error) {
+	var image models.ModImage
+	if err := r.db.First(&image, id).Error; err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+func (r *modImageRepository) ListByMod(modID uint) ([]models.ModImage, error) {
+	var images []models.ModImage
+	if err := r.db.Where("mod_id = ?", modID).Order("position ASC, id ASC").Find(&images).Error; err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (r *modImageRepository) CountByMod(modID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.ModImage{}).Where("mod_id = ?", modID).Count(&count).Error
+	return count, err
+}
+
+func (r *modImageRepository) Update(image *models.ModImage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(image).Error; err != nil {
+			return err
+		}
+		return refreshModThumbnail(tx, image.ModID)
+	})
+}
+
+func (r *modImageRepository) UpdateCaption(id uint, caption string) error {
+	return r.db.Model(&models.ModImage{ID: id}).Update("caption", caption).Error
+}
+
+func (r *modImageRepository) Reorder(modID uint, ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			err := tx.Model(&models.ModImage{}).
+				Where("id = ? AND mod_id = ?", id, modID).
+				UpdateColumn("position", i).Error
+			if err != nil {
+				return err
+			}
+		}
+		return refreshModThumbnail(tx, modID)
+	})
+}
+
+func (r *modImageRepository) Delete(image *models.ModImage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(image).Error; err != nil {
+			return err
+		}
+		return refreshModThumbnail(tx, image.ModID)
+	})
+}
+
+func (r *modImageRepository) FindStale(before time.Time, limit int) ([]models.ModImage, error) {
+	var images []models.ModImage
+	err := r.db.Where("status = ? AND updated_at < ?", models.ModImageStatusPending, before).
+		Order("id ASC").
+		Limit(limit).
+		Find(&images).Error
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (r *modImageRepository) FindOrphans(limit int) ([]models.ModImage, error) {
+	var images []models.ModImage
+	err := r.db.Where("mod_id NOT IN (?)", r.db.Model(&models.Mod{}).Select("id")).
+		Order("id ASC").
+		Limit(limit).
+		Find(&images).Error
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// refreshModThumbnail 将 Mod 的缩略图更新为第一张处理完成的截图（没有时清空）
+func refreshModThumbnail(tx *gorm.DB, modID uint) error {
+	return tx.Exec(`UPDATE mods SET thumbnail_url = COALESCE((
+			SELECT thumbnail_url FROM mod_images
+			WHERE mod_id = ? AND status = ?
+			ORDER BY position ASC, id ASC LIMIT 1
+		), '') WHERE id = ?`, modID, models.ModImageStatusReady, modID).Error
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// ModReleaseRepository Mod 发布版本仓储接口
+type ModReleaseRepository interface {
+	// FindByModID 查询 Mod 的所有发布版本（含兼容的游戏版本），最新的在前
+	FindByModID(modID uint) ([]models.ModRelease, error)
+	FindByID(id uint) (*models.ModRelease, error)
+	Create(release *models.ModRelease) error
+	// Delete 删除发布版本（同时删除兼容性声明）
+	Delete(id uint) error
+}
+
+type modReleaseRepository struct {
+	db *gorm.DB
+}
+
+// NewModReleaseRepository 创建 Mod 发布版本仓储实例
+func NewModReleaseRepository(db *gorm.DB) ModReleaseRepository {
+	return &modReleaseRepository{db: db}
+}
+
+func (r *modReleaseRepository) FindByModID(modID uint) ([]models.ModRelease, error) {
+	var releases []models.ModRelease
+	err := r.db.Preload("GameVersions").
+		Where("mod_id = ?", modID).
+		Order("created_at DESC, id DESC").
+		Find(&releases).Error
+	if err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+func (r *modReleaseRepository) FindByID(id uint) (*models.ModRelease, error) {
+	var release models.ModRelease
+	if err := r.db.Preload("GameVersions").First(&release, id).Error; err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+func (r *modReleaseRepository) Create(release *models.ModRelease) error {
+	return r.db.Omit("GameVersions.*").Create(release).Error
+}
+
+func (r *modReleaseRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 锁定该版本的合集条目改为跟随 Mod 当前版本
+		err := tx.Model(&models.CollectionItem{}).Where("release_id = ?", id).UpdateColumn("release_id", 0).Error
+		if err != nil {
+			return err
+		}
+		release := models.ModRelease{ID: id}
+		return tx.Select("GameVersions").Delete(&release).Error
+	})
+}
@@ -1,82 +1,110 @@
 package repository
 
 import (
+	"sort"
+
 	"gin-web/app/models"
 	"gorm.io/gorm"
 )
 
 // ModSearchCriteria 搜索条件（封装查询参数，避免 Service 直接操作 gorm.DB）
+// 同一维度的多个值之间为“或”关系，不同维度之间为“且”关系
 type ModSearchCriteria struct {
-	Keyword    string
-	GameID     uint
-	CategoryID uint
-	Author     string
-	SortBy     string // rating, download_count, created_at, updated_at
-	Order      string // asc, desc
-	Page       int
-	PageSize   int
+	Keyword     string
+	GameIDs     []uint
+	CategoryIDs []uint   // 同时匹配所有子孙分类
+	Tags        []string // 标签名称
+	// GameVersionIDs 兼容的游戏版本（Mod 本身或任一发布版本声明兼容即可）
+	GameVersionIDs []uint
+	Author         string // 作者名称（模糊匹配旧的作者文本）
+	AuthorID       uint   // 作者用户 ID（作为所有者或共同维护者）
+	SortBy         string // relevance, trending, rating, download_count, view_count, created_at, updated_at
+	Order          string // asc, desc
+	Page           int
+	PageSize       int
+	Cursor         string // 键集分页游标，非空时忽略 Page
+	SkipTotal      bool   // 是否跳过总数统计（省去一次 COUNT 查询）
+	WithFacets     bool   // 是否统计分面
 }
 
 // ModSearchResult 搜索结果
 type ModSearchResult struct {
 	Mods       []models.Mod
-	Total      int64
+	Total      int64 // HasTotal 为 false 时无意义
+	HasTotal   bool
 	Page       int
 	PageSize   int
 	TotalPages int
+	NextCursor string     // 下一页游标，没有更多数据时为空
+	Facets     *ModFacets // 分面统计，未请求时为 nil
 }
 
 // ModRepository Mod仓储接口
 type ModRepository interface {
 	Search(criteria ModSearchCriteria) (*ModSearchResult, error)
+	// FindByID 查询 Mod（不限审核状态，含作者、共同维护者及翻译，供写操作、审核及检索索引使用）
 	FindByID(id uint) (*models.Mod, error)
-	UpdateDownloadCount(mod *models.Mod) error
+	// FindPublicByID 查询已通过审核的 Mod（公开详情与下载使用，含处理完成的截图）
+	FindPublicByID(id uint) (*models.Mod, error)
+	// FindByStatus 按审核状态分页查询（最早提交的在前，用于审核队列）
+	FindByStatus(status string, page, pageSize int) ([]models.Mod, int64, error)
+	// UpdateStatus 更新审核状态并写入审核记录
+	UpdateStatus(mod *models.Mod, review *models.ModReview) error
+	// FindReviews 查询 Mod 的审核记录（最新的在前）
+	FindReviews(modID uint) ([]models.ModReview, error)
+	// FindByIDs 批量查询已通过审核的 Mod（含游戏、分类、标签），结果顺序与 ids 一致，不存在的 ID 被忽略
+	FindByIDs(ids []uint) ([]models.Mod, error)
+	// UpdateDownloadCount / UpdateViewCount 累加计数并记录统计事件，visitor 为访客标识摘要
+	UpdateDownloadCount(mod *models.Mod, visitor string) error
+	UpdateViewCount(mod *models.Mod, visitor string) error
 	FindAllGames() ([]models.Game, error)
 	FindAllCategories() ([]models.Category, error)
+	FindGameByID(id uint) (*models.Game, error)
+	FindCategoriesByIDs(ids []uint) ([]models.Category, error)
+	FindGameVersionsByIDs(ids []uint) ([]models.GameVersion, error)
+	// FindGameVersionsByGames 查询多个游戏的全部版本
+	FindGameVersionsByGames(gameIDs []uint) ([]models.GameVersion, error)
+	FindInBatches(batchSize int, fn func(mods []models.Mod) error) error
+	Create(mod *models.Mod) error
+	Update(mod *models.Mod) error
+	Delete(id uint) error
 }
 
+// SortByRelevance 按相关度排序（仅在有关键词且配置了检索后端时生效）
+const SortByRelevance = "relevance"
+
+// SortByTrending 按热度分排序（对应 trending_score 列）
+const SortByTrending = "trending"
+
 type modRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	search ModSearchBackend
 }
 
 // NewModRepository 创建 Mod 仓储实例
-func NewModRepository(db *gorm.DB) ModRepository {
-	return &modRepository{db: db}
+// search 为空时关键词搜索退化为 LIKE 匹配
+func NewModRepository(db *gorm.DB, search ModSearchBackend) ModRepository {
+	return &modRepository{db: db, search: search}
 }
 
 // Search 搜索 Mod（查询构建逻辑封装在 Repository 内部）
+// 排序固定追加 id 作为第二排序键，保证顺序稳定；传入游标时使用键集分页
 func (r *modRepository) Search(criteria ModSearchCriteria) (*ModSearchResult, error) {
-	db := r.db.Model(&models.Mod{})
-
-	// 预加载关联数据
-	db = db.Preload("Game").Preload("Categories")
-
-	// 关键词搜索
-	if criteria.Keyword != "" {
-		keyword := "%" + criteria.Keyword + "%"
-		db = db.Where("name LIKE ? OR description LIKE ? OR author LIKE ?", keyword, keyword, keyword)
-	}
-
-	// 游戏筛选
-	if criteria.GameID > 0 {
-		db = db.Where("game_id = ?", criteria.GameID)
-	}
-
-	// 作者筛选
-	if criteria.Author != "" {
-		db = db.Where("author LIKE ?", "%"+criteria.Author+"%")
-	}
-
-	// 分类筛选
-	if criteria.CategoryID > 0 {
-		db = db.Joins("JOIN gw_mod_categories ON mods.id = gw_mod_categories.mod_id").
-			Where("gw_mod_categories.category_id = ?", criteria.CategoryID)
+	filter, err := r.resolveFilter(criteria)
+	if err != nil {
+		return nil, err
 	}
+	scores := filter.scores
+	db := filter.apply(r.db.Model(&models.Mod{}), "")
 
 	// 排序
 	sortBy := criteria.SortBy
 	if sortBy == "" {
 		sortBy = "created_at"
+		// 有相关度时默认按相关度排序
+		if scores != nil {
+			sortBy = SortByRelevance
+		}
 	}
 	order := criteria.Order
 	if order == "" {
@@ -85,8 +113,11 @@ func (r *modRepository) Search(criteria ModSearchCriteria) (*ModSearchResult, er
 
 	// 验证排序字段
 	validSortFields := map[string]bool{
+		SortByRelevance:  scores != nil,
+		SortByTrending:   true,
 		"rating":         true,
 		"download_count": true,
+		"view_count":     true,
 		"created_at":     true,
 		"updated_at":     true,
 	}
@@ -99,14 +130,6 @@ func (r *modRepository) Search(criteria ModSearchCriteria) (*ModSearchResult, er
 		order = "desc"
 	}
 
-	db = db.Order(sortBy + " " + order)
-
-	// 获取总数
-	var total int64
-	if err := db.Count(&total).Error; err != nil {
-		return nil, err
-	}
-
 	// 分页
 	page := criteria.Page
 	if page < 1 {
@@ -117,36 +140,445 @@ func (r *modRepository) Search(criteria ModSearchCriteria) (*ModSearchResult, er
 		pageSize = 20
 	}
 
-	offset := (page - 1) * pageSize
-	db = db.Offset(offset).Limit(pageSize)
+	// 解析游标
+	fingerprint := filterFingerprint(criteria)
+	var cursor *modCursor
+	if criteria.Cursor != "" {
+		c, err := decodeModCursor(criteria.Cursor, sortBy, order, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		cursor = c
+	}
+
+	q := modPageQuery{
+		sortBy:    sortBy,
+		order:     order,
+		page:      page,
+		pageSize:  pageSize,
+		cursor:    cursor,
+		filter:    fingerprint,
+		skipTotal: criteria.SkipTotal,
+	}
+
+	var result *ModSearchResult
+	if sortBy == SortByRelevance {
+		result, err = r.searchByRelevance(db, scores, q)
+	} else {
+		result, err = r.searchByColumn(db, q)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if criteria.WithFacets {
+		if result.Facets, err = r.searchFacets(filter); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// modFilter 解析后的筛选条件
+type modFilter struct {
+	db       *gorm.DB
+	criteria ModSearchCriteria
+	ids      []uint           // 检索后端命中的 ID，未使用检索后端时为 nil
+	scores   map[uint]float64 // 检索后端给出的相关度
+}
+
+// resolveFilter 解析筛选条件（有关键词且配置了检索后端时先执行检索）
+func (r *modRepository) resolveFilter(criteria ModSearchCriteria) (*modFilter, error) {
+	f := &modFilter{db: r.db, criteria: criteria}
+
+	if len(criteria.CategoryIDs) > 0 {
+		ids, err := r.categoryDescendantIDs(criteria.CategoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		f.criteria.CategoryIDs = ids
+	}
+
+	if criteria.Keyword == "" || r.search == nil {
+		return f, nil
+	}
+
+	hits, err := r.search.Search(criteria.Keyword)
+	if err != nil {
+		return nil, err
+	}
+	f.ids = make([]uint, len(hits))
+	f.scores = make(map[uint]float64, len(hits))
+	for i, hit := range hits {
+		f.ids[i] = hit.ModID
+		f.scores[hit.ModID] = hit.Score
+	}
+	return f, nil
+}
+
+// apply 将筛选条件应用到查询，skip 指定需要忽略的分面维度（统计该维度分面时使用）
+func (f *modFilter) apply(db *gorm.DB, skip string) *gorm.DB {
+	c := f.criteria
+
+	// 公开搜索只返回已通过审核的 Mod
+	db = db.Where("mods.status = ?", models.ModStatusApproved)
 
-	// 执行查询
+	// 关键词搜索
+	if c.Keyword != "" {
+		if f.scores != nil {
+			db = db.Where("mods.id IN ?", f.ids)
+		} else {
+			// 同时匹配各语言的翻译
+			keyword := "%" + c.Keyword + "%"
+			db = db.Where("mods.name LIKE ? OR mods.description LIKE ? OR mods.author LIKE ? OR mods.id IN (?)", keyword, keyword, keyword,
+				f.db.Table("mod_translations").Select("mod_id").Where("name LIKE ? OR description LIKE ?", keyword, keyword))
+		}
+	}
+
+	// 游戏筛选
+	if len(c.GameIDs) > 0 && skip != facetGame {
+		db = db.Where("mods.game_id IN ?", c.GameIDs)
+	}
+
+	// 作者筛选
+	if c.Author != "" {
+		db = db.Where("mods.author LIKE ?", "%"+c.Author+"%")
+	}
+	if c.AuthorID > 0 {
+		db = db.Where("mods.owner_id = ? OR mods.id IN (?)", c.AuthorID,
+			f.db.Table("gw_mod_maintainers").Select("mod_id").Where("user_id = ?", c.AuthorID))
+	}
+
+	// 分类筛选（子查询避免同时命中多个分类时产生重复行）
+	if len(c.CategoryIDs) > 0 && skip != facetCategory {
+		db = db.Where("mods.id IN (?)",
+			f.db.Table("gw_mod_categories").Select("mod_id").Where("category_id IN ?", c.CategoryIDs))
+	}
+
+	// 标签筛选
+	if len(c.Tags) > 0 {
+		db = db.Where("mods.id IN (?)",
+			f.db.Table("gw_mod_tags").Select("gw_mod_tags.mod_id").
+				Joins("JOIN tags ON tags.id = gw_mod_tags.tag_id").
+				Where("tags.name IN ?", c.Tags))
+	}
+
+	// 游戏版本兼容性筛选
+	if len(c.GameVersionIDs) > 0 {
+		db = db.Where("mods.id IN (?) OR mods.id IN (?)",
+			f.db.Table("gw_mod_game_versions").Select("mod_id").Where("game_version_id IN ?", c.GameVersionIDs),
+			f.db.Table("mod_releases").Select("mod_releases.mod_id").
+				Joins("JOIN gw_release_game_versions ON gw_release_game_versions.mod_release_id = mod_releases.id").
+				Where("gw_release_game_versions.game_version_id IN ?", c.GameVersionIDs))
+	}
+
+	return db
+}
+
+// categoryDescendantIDs 返回给定分类及其所有子孙分类的 ID
+func (r *modRepository) categoryDescendantIDs(ids []uint) ([]uint, error) {
+	var categories []models.Category
+	if err := r.db.Select("id", "parent_id").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return expandCategories(categoryChildren(categories), ids), nil
+}
+
+// categoryChildren 按父分类 ID 索引子分类 ID
+func categoryChildren(categories []models.Category) map[uint][]uint {
+	children := make(map[uint][]uint)
+	for _, c := range categories {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+	return children
+}
+
+// expandCategories 返回给定分类及其所有子孙分类的 ID（父子关系成环时每个分类只出现一次）
+func expandCategories(children map[uint][]uint, ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	result := make([]uint, 0, len(ids))
+	queue := append([]uint{}, ids...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+		queue = append(queue, children[id]...)
+	}
+	return result
+}
+
+// modPageQuery 归一化后的排序与分页参数
+type modPageQuery struct {
+	sortBy    string
+	order     string
+	page      int
+	pageSize  int
+	cursor    *modCursor // 非空时使用键集分页
+	filter    uint32
+	skipTotal bool
+}
+
+// searchByColumn 按数据库列排序分页
+func (r *modRepository) searchByColumn(db *gorm.DB, q modPageQuery) (*ModSearchResult, error) {
+	result := &ModSearchResult{Page: q.page, PageSize: q.pageSize}
+
+	// 获取总数（在应用游标条件之前统计）
+	if !q.skipTotal {
+		if err := db.Count(&result.Total).Error; err != nil {
+			return nil, err
+		}
+		result.HasTotal = true
+	}
+
+	column := "mods." + sortColumn(q.sortBy)
+	op := "<"
+	if q.order == "asc" {
+		op = ">"
+	}
+
+	if q.cursor != nil {
+		// 键集条件：(排序键, id) 严格位于游标之后
+		db = db.Where("("+column+" "+op+" ? OR ("+column+" = ? AND mods.id "+op+" ?))",
+			q.cursor.Value, q.cursor.Value, q.cursor.ID)
+	} else {
+		db = db.Offset((q.page - 1) * q.pageSize)
+	}
+
+	// 多取一条用于判断是否还有下一页
 	var mods []models.Mod
-	if err := db.Find(&mods).Error; err != nil {
+	err := db.Preload("Game").Preload("Categories").Preload("Tags").
+		Order(column + " " + q.order).
+		Order("mods.id " + q.order).
+		Limit(q.pageSize + 1).
+		Find(&mods).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(mods) > q.pageSize {
+		mods = mods[:q.pageSize]
+		result.NextCursor = newModCursor(mods[len(mods)-1], q.sortBy, q.order, q.filter, 0).encode()
+	}
+	result.Mods = mods
+	result.TotalPages = totalPages(result.Total, q.pageSize)
+
+	return result, nil
+}
+
+// sortColumn 排序字段对应的数据库列
+func sortColumn(sortBy string) string {
+	if sortBy == SortByTrending {
+		return "trending_score"
+	}
+	return sortBy
+}
+
+// searchByRelevance 按检索后端给出的相关度排序分页
+// 先在数据库中应用筛选条件取得候选 ID，再按 (相关度, id) 排序并加载当前页
+func (r *modRepository) searchByRelevance(db *gorm.DB, scores map[uint]float64, q modPageQuery) (*ModSearchResult, error) {
+	var ids []uint
+	if err := db.Distinct("mods.id").Pluck("mods.id", &ids).Error; err != nil {
 		return nil, err
 	}
 
-	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
+	// before 判断 (分数, id) 排序位置是否在前
+	before := func(si float64, idi uint, sj float64, idj uint) bool {
+		if si != sj {
+			if q.order == "asc" {
+				return si < sj
+			}
+			return si > sj
+		}
+		if q.order == "asc" {
+			return idi < idj
+		}
+		return idi > idj
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return before(scores[ids[i]], ids[i], scores[ids[j]], ids[j])
+	})
+
+	result := &ModSearchResult{
+		Page:     q.page,
+		PageSize: q.pageSize,
+		Total:    int64(len(ids)),
+		HasTotal: true, // 候选 ID 已全部取出，总数无需额外查询
+	}
+
+	// 定位起始位置
+	offset := 0
+	if q.cursor != nil {
+		score, _ := q.cursor.Value.(float64)
+		offset = sort.Search(len(ids), func(i int) bool {
+			return before(score, q.cursor.ID, scores[ids[i]], ids[i])
+		})
+	} else {
+		offset = min((q.page-1)*q.pageSize, len(ids))
+	}
+	end := min(offset+q.pageSize, len(ids))
+	pageIDs := ids[offset:end]
+
+	mods, err := r.findByIDsOrdered(pageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if end < len(ids) && len(mods) > 0 {
+		last := mods[len(mods)-1]
+		result.NextCursor = newModCursor(last, q.sortBy, q.order, q.filter, scores[last.ID]).encode()
+	}
+	result.Mods = mods
+	result.TotalPages = totalPages(result.Total, q.pageSize)
+
+	return result, nil
+}
 
-	return &ModSearchResult{
-		Mods:       mods,
-		Total:      total,
-		Page:       page,
-		PageSize:   pageSize,
-		TotalPages: totalPages,
-	}, nil
+// findByIDsOrdered 按给定 ID 顺序加载 Mod（含关联）
+func (r *modRepository) findByIDsOrdered(ids []uint) ([]models.Mod, error) {
+	if len(ids) == 0 {
+		return []models.Mod{}, nil
+	}
+
+	var found []models.Mod
+	if err := r.db.Preload("Game").Preload("Categories").Preload("Tags").Where("id IN ?", ids).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]models.Mod, len(found))
+	for _, mod := range found {
+		byID[mod.ID] = mod
+	}
+	mods := make([]models.Mod, 0, len(found))
+	for _, id := range ids {
+		if mod, ok := byID[id]; ok {
+			mods = append(mods, mod)
+		}
+	}
+	return mods, nil
+}
+
+// totalPages 计算总页数
+func totalPages(total int64, pageSize int) int {
+	return int((total + int64(pageSize) - 1) / int64(pageSize))
+}
+
+// preloadDetail 预加载详情所需的关联（含作者及共同维护者）
+func (r *modRepository) preloadDetail() *gorm.DB {
+	return r.db.Preload("Game").Preload("Categories").Preload("Tags").Preload("GameVersions").
+		Preload("Owner").Preload("Maintainers")
 }
 
 func (r *modRepository) FindByID(id uint) (*models.Mod, error) {
 	var mod models.Mod
-	if err := r.db.Preload("Game").Preload("Categories").First(&mod, id).Error; err != nil {
+	if err := r.preloadDetail().Preload("Translations").First(&mod, id).Error; err != nil {
+		return nil, err
+	}
+	return &mod, nil
+}
+
+func (r *modRepository) FindPublicByID(id uint) (*models.Mod, error) {
+	var mod models.Mod
+	err := r.preloadDetail().
+		Preload("Images", func(db *gorm.DB) *gorm.DB {
+			return db.Where("status = ?", models.ModImageStatusReady).Order("position ASC, id ASC")
+		}).
+		Where("status = ?", models.ModStatusApproved).
+		First(&mod, id).Error
+	if err != nil {
 		return nil, err
 	}
 	return &mod, nil
 }
 
-func (r *modRepository) UpdateDownloadCount(mod *models.Mod) error {
-	return r.db.Model(mod).UpdateColumn("download_count", mod.DownloadCount+1).Error
+func (r *modRepository) FindByStatus(status string, page, pageSize int) ([]models.Mod, int64, error) {
+	db := r.db.Model(&models.Mod{}).Where("status = ?", status)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var mods []models.Mod
+	err := db.Preload("Game").Preload("Categories").Preload("Tags").
+		Order("updated_at ASC, id ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&mods).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return mods, total, nil
+}
+
+func (r *modRepository) UpdateStatus(mod *models.Mod, review *models.ModReview) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(mod).Update("status", mod.Status).Error; err != nil {
+			return err
+		}
+		return tx.Create(review).Error
+	})
+}
+
+func (r *modRepository) FindReviews(modID uint) ([]models.ModReview, error) {
+	var reviews []models.ModReview
+	if err := r.db.Where("mod_id = ?", modID).Order("id DESC").Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+func (r *modRepository) FindByIDs(ids []uint) ([]models.Mod, error) {
+	if len(ids) == 0 {
+		return []models.Mod{}, nil
+	}
+
+	var mods []models.Mod
+	err := r.db.Preload("Game").Preload("Categories").Preload("Tags").
+		Where("id IN ? AND status = ?", ids, models.ModStatusApproved).
+		Find(&mods).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint]models.Mod, len(mods))
+	for _, mod := range mods {
+		byID[mod.ID] = mod
+	}
+	ordered := make([]models.Mod, 0, len(mods))
+	for _, id := range ids {
+		if mod, ok := byID[id]; ok {
+			ordered = append(ordered, mod)
+		}
+	}
+	return ordered, nil
+}
+
+// UpdateDownloadCount 下载次数 +1（原子自增，避免并发覆盖），同时记录下载事件
+func (r *modRepository) UpdateDownloadCount(mod *models.Mod, visitor string) error {
+	return r.incrementCounter(mod, "download_count", models.StatEventDownload, visitor)
+}
+
+// UpdateViewCount 浏览次数 +1（原子自增，避免并发覆盖），同时记录浏览事件
+func (r *modRepository) UpdateViewCount(mod *models.Mod, visitor string) error {
+	return r.incrementCounter(mod, "view_count", models.StatEventView, visitor)
+}
+
+// incrementCounter 累加 Mod 总计数并记录统计事件（每日计数由汇总任务从事件生成）
+func (r *modRepository) incrementCounter(mod *models.Mod, column, eventType, visitor string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(mod).UpdateColumn(column, gorm.Expr(column+" + ?", 1)).Error; err != nil {
+			return err
+		}
+
+		event := models.ModStatEvent{ModID: mod.ID, Type: eventType, Version: mod.Version, Visitor: visitor}
+		return tx.Create(&event).Error
+	})
 }
 
 func (r *modRepository) FindAllGames() ([]models.Game, error) {
@@ -164,3 +596,117 @@ func (r *modRepository) FindAllCategories() ([]models.Category, error) {
 	}
 	return categories, nil
 }
+
+func (r *modRepository) FindGameByID(id uint) (*models.Game, error) {
+	var game models.Game
+	if err := r.db.First(&game, id).Error; err != nil {
+		return nil, err
+	}
+	return &game, nil
+}
+
+func (r *modRepository) FindCategoriesByIDs(ids []uint) ([]models.Category, error) {
+	var categories []models.Category
+	if len(ids) == 0 {
+		return categories, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+func (r *modRepository) FindGameVersionsByIDs(ids []uint) ([]models.GameVersion, error) {
+	var versions []models.GameVersion
+	if len(ids) == 0 {
+		return versions, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (r *modRepository) FindGameVersionsByGames(gameIDs []uint) ([]models.GameVersion, error) {
+	var versions []models.GameVersion
+	if len(gameIDs) == 0 {
+		return versions, nil
+	}
+	if err := r.db.Where("game_id IN ?", gameIDs).Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// FindInBatches 按 ID 顺序分批遍历所有 Mod（含关联及翻译），用于重建索引等全量任务
+func (r *modRepository) FindInBatches(batchSize int, fn func(mods []models.Mod) error) error {
+	var mods []models.Mod
+	return r.db.Preload("Game").Preload("Categories").Preload("Translations").Order("id").
+		FindInBatches(&mods, batchSize, func(tx *gorm.DB, batch int) error {
+			return fn(mods)
+		}).Error
+}
+
+// Create 创建 Mod（同时写入分类关联）
+func (r *modRepository) Create(mod *models.Mod) error {
+	return r.db.Omit("Game", "Categories.*", "Tags", "GameVersions.*", "Releases", "Translations", "Images", "Owner", "Maintainers").Create(mod).Error
+}
+
+// Update 更新 Mod 基本信息并替换分类、兼容游戏版本关联（标签由 TagRepository 维护，作者及维护者由 AuthorRepository 维护）
+func (r *modRepository) Update(mod *models.Mod) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Game", "Categories", "Tags", "GameVersions", "Releases", "Translations", "Images", "Owner", "OwnerID", "Maintainers", "DownloadCount", "ViewCount", "ThumbnailURL", "CreatedAt").Save(mod).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(mod).Omit("Categories.*").Association("Categories").Replace(mod.Categories); err != nil {
+			return err
+		}
+		return tx.Model(mod).Omit("GameVersions.*").Association("GameVersions").Replace(mod.GameVersions)
+	})
+}
+
+// Delete 删除 Mod（同时删除发布版本、评论、翻译、合集中的条目及各类关联，并更新标签使用数；截图及其文件由图片维护任务清理）
+func (r *modRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Tag{}).
+			Where("id IN (?)", tx.Table("gw_mod_tags").Select("tag_id").Where("mod_id = ?", id)).
+			UpdateColumn("mod_count", gorm.Expr("mod_count - ?", 1)).Error
+		if err != nil {
+			return err
+		}
+
+		var releases []models.ModRelease
+		if err := tx.Where("mod_id = ?", id).Find(&releases).Error; err != nil {
+			return err
+		}
+		if len(releases) > 0 {
+			if err := tx.Select("GameVersions").Delete(&releases).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("mod_id = ?", id).Delete(&models.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("mod_id = ?", id).Delete(&models.ModTranslation{}).Error; err != nil {
+			return err
+		}
+		if err := r.removeFromCollections(tx, id); err != nil {
+			return err
+		}
+
+		mod := models.Mod{ID: id}
+		return tx.Select("Categories", "Tags", "GameVersions", "Maintainers").Delete(&mod).Error
+	})
+}
+
+// removeFromCollections 从所有合集中移除 Mod 并更新合集的 Mod 数量
+func (r *modRepository) removeFromCollections(tx *gorm.DB, modID uint) error {
+	err := tx.Model(&models.Collection{}).
+		Where("id IN (?)", tx.Model(&models.CollectionItem{}).Select("collection_id").Where("mod_id = ?", modID)).
+		UpdateColumn("item_count", gorm.Expr("item_count - ?", 1)).Error
+	if err != nil {
+		return err
+	}
+	return tx.Where("mod_id = ?", modID).Delete(&models.CollectionItem{}).Error
+}
@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"gin-web/app/models"
+	"gin-web/pkg/search"
+)
+
+// 检索字段
+const (
+	SearchFieldName        = "name"
+	SearchFieldDescription = "description"
+	SearchFieldAuthor      = "author"
+)
+
+// defaultMaxHits 单次检索默认最大命中数
+const defaultMaxHits = 1000
+
+// ModSearchHit 检索命中结果
+type ModSearchHit struct {
+	ModID uint
+	Score float64
+}
+
+// ModSearchBackend Mod 全文检索后端接口
+type ModSearchBackend interface {
+	// Search 按关键词检索，结果按相关度降序排列
+	Search(keyword string) ([]ModSearchHit, error)
+}
+
+// ModSearchIndexer 需要显式同步数据的检索索引
+// 由 Mod 变更事件驱动增量更新，Reset 后配合 ReindexMods 全量重建
+type ModSearchIndexer interface {
+	Index(mod *models.Mod) error
+	Remove(id uint) error
+	Reset() error
+}
+
+// reindexBatchSize 全量重建索引时的批大小
+const reindexBatchSize = 500
+
+// ReindexMods 从 ModRepository 全量重建检索索引，返回已索引的 Mod 数量
+func ReindexMods(repo ModRepository, indexer ModSearchIndexer) (int, error) {
+	if err := indexer.Reset(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	err := repo.FindInBatches(reindexBatchSize, func(mods []models.Mod) error {
+		for i := range mods {
+			if err := indexer.Index(&mods[i]); err != nil {
+				return err
+			}
+		}
+		count += len(mods)
+		return nil
+	})
+	return count, err
+}
+
+// SearchOptions 检索后端配置
+type SearchOptions struct {
+	NameWeight        float64 // 名称权重
+	DescriptionWeight float64 // 描述权重
+	AuthorWeight      float64 // 作者权重
+	MaxHits           int     // 最大命中数
+}
+
+// withDefaults 填充默认值（名称权重高于作者，作者高于描述）
+func (o SearchOptions) withDefaults() SearchOptions {
+	if o.NameWeight <= 0 {
+		o.NameWeight = 3
+	}
+	if o.DescriptionWeight <= 0 {
+		o.DescriptionWeight = 1
+	}
+	if o.AuthorWeight <= 0 {
+		o.AuthorWeight = 2
+	}
+	if o.MaxHits <= 0 {
+		o.MaxHits = defaultMaxHits
+	}
+	return o
+}
+
+// ================================
+// MySQL FULLTEXT 检索后端
+// ================================
+
+// fulltextIndexes 全文索引定义（索引名 -> 列）
+// 组合索引用于筛选，单列索引用于按字段加权计算相关度；翻译表的索引用于跨语言检索
+var fulltextIndexes = []struct {
+	model   interface{}
+	name    string
+	columns string
+}{
+	{&models.Mod{}, "ft_mods_search", "name, description, author"},
+	{&models.Mod{}, "ft_mods_name", "name"},
+	{&models.Mod{}, "ft_mods_description", "description"},
+	{&models.Mod{}, "ft_mods_author", "author"},
+	{&models.ModTranslation{}, "ft_mod_translations_search", "name, description"},
+	{&models.ModTranslation{}, "ft_mod_translations_name", "name"},
+	{&models.ModTranslation{}, "ft_mod_translations_description", "description"},
+}
+
+type fulltextModSearch struct {
+	db   *gorm.DB
+	opts SearchOptions
+}
+
+// NewFulltextModSearch 创建基于 MySQL FULLTEXT 索引的检索后端
+// 启动时自动创建缺失的全文索引（使用 ngram 解析器以支持中文）
+func NewFulltextModSearch(db *gorm.DB, opts SearchOptions) (ModSearchBackend, error) {
+	s := &fulltextModSearch{db: db, opts: opts.withDefaults()}
+	if err := s.ensureIndexes(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ensureIndexes 创建缺失的全文索引
+func (s *fulltextModSearch) ensureIndexes() error {
+	migrator := s.db.Migrator()
+	for _, idx := range fulltextIndexes {
+		if migrator.HasIndex(idx.model, idx.name) {
+			continue
+		}
+		stmt := &gorm.Statement{DB: s.db}
+		if err := stmt.Parse(idx.model); err != nil {
+			return err
+		}
+		sql := fmt.Sprintf("ALTER TABLE `%s` ADD FULLTEXT INDEX `%s` (%s) WITH PARSER ngram", stmt.Schema.Table, idx.name, idx.columns)
+		if err := s.db.Exec(sql).Error; err != nil {
+			return fmt.Errorf("create fulltext index %s failed: %w", idx.name, err)
+		}
+	}
+	return nil
+}
+
+// Index 全文索引由 MySQL 随数据写入自动维护，无需处理
+func (s *fulltextModSearch) Index(_ *models.Mod) error {
+	return nil
+}
+
+// Remove 全文索引由 MySQL 随数据删除自动维护，无需处理
+func (s *fulltextModSearch) Remove(_ uint) error {
+	return nil
+}
+
+// Reset 删除并重建全文索引（MySQL 会从表数据重新生成索引，如修改 ngram_token_size 后需执行）
+func (s *fulltextModSearch) Reset() error {
+	migrator := s.db.Migrator()
+	for _, idx := range fulltextIndexes {
+		if !migrator.HasIndex(idx.model, idx.name) {
+			continue
+		}
+		if err := migrator.DropIndex(idx.model, idx.name); err != nil {
+			return fmt.Errorf("drop fulltext index %s failed: %w", idx.name, err)
+		}
+	}
+	return s.ensureIndexes()
+}
+
+// Search 使用 MATCH ... AGAINST 检索并按字段加权计算相关度
+// 同时检索各语言的翻译，同一 Mod 取主表与各翻译中最高的相关度
+func (s *fulltextModSearch) Search(keyword string) ([]ModSearchHit, error) {
+	var rows []struct {
+		ID    uint
+		Score float64
+	}
+
+	err := s.db.Model(&models.Mod{}).
+		Select(
+			"id, MATCH(name) AGAINST(?) * ? + MATCH(description) AGAINST(?) * ? + MATCH(author) AGAINST(?) * ? AS score",
+			keyword, s.opts.NameWeight,
+			keyword, s.opts.DescriptionWeight,
+			keyword, s.opts.AuthorWeight,
+		).
+		Where("MATCH(name, description, author) AGAINST(?)", keyword).
+		Order("score DESC").
+		Order("id ASC").
+		Limit(s.opts.MaxHits).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var translated []struct {
+		ID    uint
+		Score float64
+	}
+	err = s.db.Model(&models.ModTranslation{}).
+		Select(
+			"mod_id AS id, MAX(MATCH(name) AGAINST(?) * ? + MATCH(description) AGAINST(?) * ?) AS score",
+			keyword, s.opts.NameWeight,
+			keyword, s.opts.DescriptionWeight,
+		).
+		Where("MATCH(name, description) AGAINST(?)", keyword).
+		Group("mod_id").
+		Order("score DESC").
+		Order("id ASC").
+		Limit(s.opts.MaxHits).
+		Scan(&translated).Error
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[uint]float64, len(rows)+len(translated))
+	for _, row := range append(rows, translated...) {
+		if score, ok := scores[row.ID]; !ok || row.Score > score {
+			scores[row.ID] = row.Score
+		}
+	}
+
+	hits := make([]ModSearchHit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, ModSearchHit{ModID: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ModID < hits[j].ModID
+	})
+	if len(hits) > s.opts.MaxHits {
+		hits = hits[:s.opts.MaxHits]
+	}
+	return hits, nil
+}
+
+// ================================
+// 内存倒排索引检索后端
+// ================================
+
+// MemoryModSearch 基于内存倒排索引的检索后端（适用于 SQLite / 测试环境）
+type MemoryModSearch struct {
+	index   *search.Index
+	maxHits int
+}
+
+// NewMemoryModSearch 创建内存检索后端
+func NewMemoryModSearch(opts SearchOptions) *MemoryModSearch {
+	opts = opts.withDefaults()
+	return &MemoryModSearch{
+		index: search.NewIndex(map[string]float64{
+			SearchFieldName:        opts.NameWeight,
+			SearchFieldDescription: opts.DescriptionWeight,
+			SearchFieldAuthor:      opts.AuthorWeight,
+		}),
+		maxHits: opts.MaxHits,
+	}
+}
+
+// Index 添加或更新 Mod 索引（各语言的翻译并入名称和描述字段，以支持跨语言检索）
+func (s *MemoryModSearch) Index(mod *models.Mod) error {
+	names := []string{mod.Name}
+	descriptions := []string{mod.Description}
+	for _, t := range mod.Translations {
+		names = append(names, t.Name)
+		descriptions = append(descriptions, t.Description)
+	}
+
+	s.index.Add(mod.ID, map[string]string{
+		SearchFieldName:        strings.Join(names, "\n"),
+		SearchFieldDescription: strings.Join(descriptions, "\n"),
+		SearchFieldAuthor:      mod.Author,
+	})
+	return nil
+}
+
+// Remove 删除 Mod 索引
+func (s *MemoryModSearch) Remove(id uint) error {
+	s.index.Remove(id)
+	return nil
+}
+
+// Reset 清空索引
+func (s *MemoryModSearch) Reset() error {
+	s.index.Reset()
+	return nil
+}
+
+// Len 返回已索引的 Mod 数量
+func (s *MemoryModSearch) Len() int {
+	return s.index.Len()
+}
+
+// Search 检索
+func (s *MemoryModSearch) Search(keyword string) ([]ModSearchHit, error) {
+	results := s.index.Search(keyword, s.maxHits)
+	hits := make([]ModSearchHit, len(results))
+	for i, r := range results {
+		hits[i] = ModSearchHit{ModID: r.ID, Score: r.Score}
+	}
+	return hits, nil
+}
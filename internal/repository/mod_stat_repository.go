@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"time"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// ModMembership Mod 所属的游戏与分类（未分类时 CategoryID 为 0）
+type ModMembership struct {
+	ModID      uint
+	GameID     uint
+	CategoryID uint
+}
+
+// ModStatRepository Mod 每日计数及热度分仓储接口
+type ModStatRepository interface {
+	// FindSince 查询指定日期（含）之后的所有每日计数
+	FindSince(since time.Time) ([]models.ModDailyStat, error)
+	// UpdateTrendingScores 写入热度分，未出现在 scores 中的 Mod 热度分清零
+	UpdateTrendingScores(scores map[uint]float64) error
+	// FindMemberships 查询已通过审核的 Mod 所属的游戏与分类，每个 (Mod, 分类) 一行
+	FindMemberships(modIDs []uint) ([]ModMembership, error)
+}
+
+type modStatRepository struct {
+	db *gorm.DB
+}
+
+// NewModStatRepository 创建 Mod 每日计数仓储实例
+func NewModStatRepository(db *gorm.DB) ModStatRepository {
+	return &modStatRepository{db: db}
+}
+
+func (r *modStatRepository) FindSince(since time.Time) ([]models.ModDailyStat, error) {
+	var stats []models.ModDailyStat
+	if err := r.db.Where("date >= ?", since).Order("mod_id, date").Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (r *modStatRepository) UpdateTrendingScores(scores map[uint]float64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		ids := make([]uint, 0, len(scores))
+		for id := range scores {
+			ids = append(ids, id)
+		}
+
+		// 先清零已跌出统计窗口的 Mod
+		reset := tx.Model(&models.Mod{}).Where("trending_score <> 0")
+		if len(ids) > 0 {
+			reset = reset.Where("id NOT IN ?", ids)
+		}
+		if err := reset.UpdateColumn("trending_score", 0).Error; err != nil {
+			return err
+		}
+
+		// UpdateColumn 不触发 updated_at 更新，避免影响按更新时间排序
+		for id, score := range scores {
+			if err := tx.Model(&models.Mod{}).Where("id = ?", id).UpdateColumn("trending_score", score).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *modStatRepository) FindMemberships(modIDs []uint) ([]ModMembership, error) {
+	var rows []ModMembership
+	if len(modIDs) == 0 {
+		return rows, nil
+	}
+	err := r.db.Table("mods").
+		Select("mods.id AS mod_id, mods.game_id AS game_id, COALESCE(mc.category_id, 0) AS category_id").
+		Joins("LEFT JOIN gw_mod_categories mc ON mc.mod_id = mods.id").
+		Where("mods.id IN ? AND mods.status = ?", modIDs, models.ModStatusApproved).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
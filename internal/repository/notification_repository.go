@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"time"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// NotificationRepository 站内通知仓储接口
+type NotificationRepository interface {
+	Create(notification *models.Notification) error
+	// FindByUser 分页查询用户的通知（最新的在前），unreadOnly 为 true 时只返回未读通知
+	FindByUser(userID uint, unreadOnly bool, page, pageSize int) ([]models.Notification, int64, error)
+	CountUnread(userID uint) (int64, error)
+	// MarkRead 将用户的指定通知标记为已读（不属于该用户或已读时忽略）
+	MarkRead(userID, id uint) error
+	MarkAllRead(userID uint) error
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository 创建站内通知仓储实例
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) Create(notification *models.Notification) error {
+	return r.db.Create(notification).Error
+}
+
+func (r *notificationRepository) FindByUser(userID uint, unreadOnly bool, page, pageSize int) ([]models.Notification, int64, error) {
+	db := r.db.Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		db = db.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []models.Notification
+	err := db.Order("id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return notifications, total, nil
+}
+
+func (r *notificationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepository) MarkRead(userID, id uint) error {
+	return r.db.Model(&models.Notification{}).
+		Where("id = ? AND user_id = ? AND read_at IS NULL", id, userID).
+		Update("read_at", time.Now()).Error
+}
+
+func (r *notificationRepository) MarkAllRead(userID uint) error {
+	return r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now()).Error
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"time"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// ReportRepository 内容举报仓储接口
+type ReportRepository interface {
+	Create(report *models.Report) error
+	FindByID(id uint) (*models.Report, error)
+	// ExistsByReporter 用户是否已举报过该对象
+	ExistsByReporter(targetType string, targetID, reporterID uint) (bool, error)
+	// CountPending 对象的待处理举报数
+	CountPending(targetType string, targetID uint) (int64, error)
+	// FindByStatus 按处理状态分页查询（最早提交的在前），targetType 为空时不限对象类型
+	FindByStatus(status, targetType string, page, pageSize int) ([]models.Report, int64, error)
+	// HandlePending 将对象的所有待处理举报标记为指定状态，返回更新的举报数
+	HandlePending(targetType string, targetID uint, status string, handlerID uint, note string) (int64, error)
+}
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository 创建内容举报仓储实例
+func NewReportRepository(db *gorm.DB) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+func (r *reportRepository) Create(report *models.Report) error {
+	return r.db.Create(report).Error
+}
+
+func (r *reportRepository) FindByID(id uint) (*models.Report, error) {
+	var report models.Report
+	if err := r.db.First(&report, id).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func (r *reportRepository) ExistsByReporter(targetType string, targetID, reporterID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND reporter_id = ?", targetType, targetID, reporterID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *reportRepository) CountPending(targetType string, targetID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, models.ReportStatusPending).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *reportRepository) FindByStatus(status, targetType string, page, pageSize int) ([]models.Report, int64, error) {
+	db := r.db.Model(&models.Report{}).Where("status = ?", status)
+	if targetType != "" {
+		db = db.Where("target_type = ?", targetType)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var reports []models.Report
+	err := db.Order("id ASC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&reports).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return reports, total, nil
+}
+
+func (r *reportRepository) HandlePending(targetType string, targetID uint, status string, handlerID uint, note string) (int64, error) {
+	result := r.db.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, models.ReportStatusPending).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"handler_id": handlerID,
+			"note":       note,
+			"handled_at": time.Now(),
+		})
+	return result.RowsAffected, result.Error
+}
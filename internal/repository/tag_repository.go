@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"errors"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagRepository 标签仓储接口
+type TagRepository interface {
+	FindByID(id uint) (*models.Tag, error)
+	FindByModID(modID uint) ([]models.Tag, error)
+	// FirstOrCreate 按名称查找标签，不存在时以 createdBy 为建议人创建
+	FirstOrCreate(name string, createdBy uint) (*models.Tag, error)
+	// Search 按名称模糊搜索，按使用数降序
+	Search(keyword string, page, pageSize int) ([]models.Tag, int64, error)
+	// Autocomplete 按名称前缀补全，按使用数降序
+	Autocomplete(prefix string, limit int) ([]models.Tag, error)
+	// AttachToMod 为 Mod 添加标签，返回是否为新增关联
+	AttachToMod(modID, tagID uint) (bool, error)
+	// DetachFromMod 移除 Mod 的标签，返回是否存在该关联
+	DetachFromMod(modID, tagID uint) (bool, error)
+}
+
+// modTag Mod 与标签的关联表
+type modTag struct {
+	ModID uint
+	TagID uint
+}
+
+func (modTag) TableName() string {
+	return "gw_mod_tags"
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository 创建标签仓储实例
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db: db}
+}
+
+func (r *tagRepository) FindByID(id uint) (*models.Tag, error) {
+	var tag models.Tag
+	if err := r.db.First(&tag, id).Error; err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (r *tagRepository) FindByModID(modID uint) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.Joins("JOIN gw_mod_tags ON gw_mod_tags.tag_id = tags.id").
+		Where("gw_mod_tags.mod_id = ?", modID).
+		Order("tags.name").
+		Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (r *tagRepository) FirstOrCreate(name string, createdBy uint) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.Where("name = ?", name).First(&tag).Error
+	if err == nil {
+		return &tag, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	tag = models.Tag{Name: name, CreatedBy: createdBy}
+	if err := r.db.Create(&tag).Error; err != nil {
+		// 并发创建同名标签时唯一索引冲突，重新查询
+		if findErr := r.db.Where("name = ?", name).First(&tag).Error; findErr != nil {
+			return nil, err
+		}
+	}
+	return &tag, nil
+}
+
+func (r *tagRepository) Search(keyword string, page, pageSize int) ([]models.Tag, int64, error) {
+	db := r.db.Model(&models.Tag{})
+	if keyword != "" {
+		db = db.Where("name LIKE ?", "%"+keyword+"%")
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var tags []models.Tag
+	err := db.Order("mod_count DESC, name").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&tags).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return tags, total, nil
+}
+
+func (r *tagRepository) Autocomplete(prefix string, limit int) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.Where("name LIKE ?", prefix+"%").
+		Order("mod_count DESC, name").
+		Limit(limit).
+		Find(&tags).Error
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (r *tagRepository) AttachToMod(modID, tagID uint) (bool, error) {
+	attached := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&modTag{ModID: modID, TagID: tagID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		attached = true
+		return tx.Model(&models.Tag{ID: tagID}).
+			UpdateColumn("mod_count", gorm.Expr("mod_count + ?", 1)).Error
+	})
+	return attached, err
+}
+
+func (r *tagRepository) DetachFromMod(modID, tagID uint) (bool, error) {
+	detached := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("mod_id = ? AND tag_id = ?", modID, tagID).Delete(&modTag{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		detached = true
+		return tx.Model(&models.Tag{ID: tagID}).
+			UpdateColumn("mod_count", gorm.Expr("mod_count - ?", 1)).Error
+	})
+	return detached, err
+}
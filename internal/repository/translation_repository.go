@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gin-web/app/models"
+	"gorm.io/gorm"
+)
+
+// ErrUnknownTranslationEntity 不支持翻译的实体类型
+var ErrUnknownTranslationEntity = errors.New("unknown translation entity")
+
+// Translation 实体名称与描述的一条翻译
+type Translation struct {
+	EntityID    uint
+	Locale      string
+	Name        string
+	Description string
+	UpdatedAt   time.Time
+}
+
+// translationTables 实体类型 -> 翻译表及外键列
+var translationTables = map[string]struct {
+	table  string
+	column string
+}{
+	models.TranslationEntityGame:     {"game_translations", "game_id"},
+	models.TranslationEntityCategory: {"category_translations", "category_id"},
+	models.TranslationEntityMod:      {"mod_translations", "mod_id"},
+}
+
+// TranslationRepository 游戏、分类、Mod 翻译仓储接口
+// entity 取值见 models.TranslationEntity*
+type TranslationRepository interface {
+	// Find 批量查询实体在指定语言下的翻译，没有翻译的实体不返回
+	Find(entity string, ids []uint, locale string) ([]Translation, error)
+	// List 查询实体的全部翻译（按语言排序）
+	List(entity string, id uint) ([]Translation, error)
+	// Save 新增或覆盖实体在指定语言下的翻译
+	Save(entity string, t *Translation) error
+	// Delete 删除实体在指定语言下的翻译，返回翻译是否存在
+	Delete(entity string, id uint, locale string) (bool, error)
+}
+
+type translationRepository struct {
+	db *gorm.DB
+}
+
+// NewTranslationRepository 创建翻译仓储实例
+func NewTranslationRepository(db *gorm.DB) TranslationRepository {
+	return &translationRepository{db: db}
+}
+
+// query 按实体类型选择翻译表，外键列统一映射为 entity_id
+func (r *translationRepository) query(entity string) (*gorm.DB, string, error) {
+	t, ok := translationTables[entity]
+	if !ok {
+		return nil, "", ErrUnknownTranslationEntity
+	}
+	db := r.db.Table(t.table).
+		Select(fmt.Sprintf("%s AS entity_id, locale, name, description, updated_at", t.column))
+	return db, t.column, nil
+}
+
+func (r *translationRepository) Find(entity string, ids []uint, locale string) ([]Translation, error) {
+	if len(ids) == 0 {
+		return []Translation{}, nil
+	}
+	db, column, err := r.query(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Translation
+	if err := db.Where(column+" IN ? AND locale = ?", ids, locale).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *translationRepository) List(entity string, id uint) ([]Translation, error) {
+	db, column, err := r.query(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Translation
+	if err := db.Where(column+" = ?", id).Order("locale").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *translationRepository) Save(entity string, t *Translation) error {
+	table, ok := translationTables[entity]
+	if !ok {
+		return ErrUnknownTranslationEntity
+	}
+
+	now := time.Now()
+	t.UpdatedAt = now
+	return r.db.Exec(fmt.Sprintf(`INSERT INTO %s (%s, locale, name, description, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE name = VALUES(name), description = VALUES(description), updated_at = VALUES(updated_at)`,
+		table.table, table.column),
+		t.EntityID, t.Locale, t.Name, t.Description, now, now).Error
+}
+
+func (r *translationRepository) Delete(entity string, id uint, locale string) (bool, error) {
+	table, ok := translationTables[entity]
+	if !ok {
+		return false, ErrUnknownTranslationEntity
+	}
+
+	result := r.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND locale = ?", table.table, table.column), id, locale)
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ErrRankingUnavailable 榜单尚未生成（热度任务还未运行过）
+var ErrRankingUnavailable = errors.New("trending ranking unavailable")
+
+// TrendingRanking 热度榜单存储（每个榜单为一个按热度分排序的有序集合）
+type TrendingRanking interface {
+	// Replace 整体替换所有榜单，本次未出现的榜单会被删除
+	Replace(rankings map[string]map[uint]float64) error
+	// Top 按热度从高到低读取榜单，返回 Mod ID 及榜单条目总数
+	Top(key string, offset, limit int) ([]uint, int64, error)
+}
+
+// TrendingRankingKey 榜单名称：全站为 all，按游戏为 game:{id}，按分类为 category:{id}
+func TrendingRankingKey(gameID, categoryID uint) string {
+	switch {
+	case gameID > 0:
+		return "game:" + strconv.FormatUint(uint64(gameID), 10)
+	case categoryID > 0:
+		return "category:" + strconv.FormatUint(uint64(categoryID), 10)
+	default:
+		return "all"
+	}
+}
+
+// Redis 键名
+const (
+	trendingKeyPrefix   = "trending:mods:"
+	trendingRegistryKey = "trending:mods:_keys" // 记录当前存在的榜单，用于清理过期榜单
+)
+
+type redisTrendingRanking struct {
+	client *redis.Client
+}
+
+// NewRedisTrendingRanking 创建基于 Redis 有序集合的热度榜单存储
+func NewRedisTrendingRanking(client *redis.Client) TrendingRanking {
+	return &redisTrendingRanking{client: client}
+}
+
+func (r *redisTrendingRanking) Replace(rankings map[string]map[uint]float64) error {
+	ctx := context.Background()
+
+	previous, err := r.client.SMembers(ctx, trendingRegistryKey).Result()
+	if err != nil {
+		return err
+	}
+
+	// MULTI/EXEC 保证读取方不会看到只替换了一半的榜单
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range previous {
+			if _, ok := rankings[strings.TrimPrefix(key, trendingKeyPrefix)]; !ok {
+				pipe.Del(ctx, key)
+			}
+		}
+
+		keys := make([]interface{}, 0, len(rankings))
+		for name, scores := range rankings {
+			key := trendingKeyPrefix + name
+			pipe.Del(ctx, key)
+			if len(scores) == 0 {
+				continue
+			}
+			members := make([]*redis.Z, 0, len(scores))
+			for id, score := range scores {
+				members = append(members, &redis.Z{Score: score, Member: strconv.FormatUint(uint64(id), 10)})
+			}
+			pipe.ZAdd(ctx, key, members...)
+			keys = append(keys, key)
+		}
+
+		pipe.Del(ctx, trendingRegistryKey)
+		// 注册表为空时也需要存在，以区分“没有热门 Mod”和“尚未生成”
+		keys = append(keys, trendingKeyPrefix+"all")
+		pipe.SAdd(ctx, trendingRegistryKey, keys...)
+		return nil
+	})
+	return err
+}
+
+func (r *redisTrendingRanking) Top(key string, offset, limit int) ([]uint, int64, error) {
+	ctx := context.Background()
+
+	exists, err := r.client.Exists(ctx, trendingRegistryKey).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	if exists == 0 {
+		return nil, 0, ErrRankingUnavailable
+	}
+
+	key = trendingKeyPrefix + key
+	total, err := r.client.ZCard(ctx, key).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	members, err := r.client.ZRevRange(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]uint, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseUint(m, 10, 0)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, total, nil
+}
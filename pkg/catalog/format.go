@@ -0,0 +1,55 @@
+// Package catalog 目录数据（游戏、分类、Mod）批量导入导出的文件编解码
+//
+// 支持 CSV（首行为列名）、JSON（对象数组）与 NDJSON（每行一个对象）三种格式。
+// 读取时每行统一转换为 Record（列名 → 字符串值），列表值在 CSV 中以 | 分隔。
+package catalog
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+)
+
+// Format 文件格式
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatJSON   Format = "json"
+	FormatNDJSON Format = "ndjson"
+)
+
+// ListSeparator CSV 中列表值（如 Mod 的分类）的分隔符
+const ListSeparator = "|"
+
+// ErrUnknownFormat 不支持的文件格式
+var ErrUnknownFormat = errors.New("unknown catalog format")
+
+// ParseFormat 解析格式名称（不区分大小写，jsonl 视为 ndjson）
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "csv":
+		return FormatCSV, nil
+	case "json":
+		return FormatJSON, nil
+	case "ndjson", "jsonl":
+		return FormatNDJSON, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// FormatFromFilename 根据文件扩展名推断格式
+func FormatFromFilename(name string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(name), "."))
+}
+
+// ContentType 格式对应的 MIME 类型
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	}
+	return "application/json"
+}
@@ -0,0 +1,239 @@
+package catalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxLineSize NDJSON 单行最大长度
+const maxLineSize = 1 << 20
+
+// utf8BOM Excel 导出的 CSV 常带有 BOM
+const utf8BOM = "\ufeff"
+
+// Record 文件中的一行数据
+type Record struct {
+	Row    int               // 行号（CSV / NDJSON 为文件行号，JSON 为数组中的序号，从 1 开始）
+	Fields map[string]string // 列名 → 值，文件未提供的列不在其中
+}
+
+// Has 是否提供了该列
+func (r Record) Has(name string) bool {
+	_, ok := r.Fields[name]
+	return ok
+}
+
+// Get 获取去除首尾空白后的列值
+func (r Record) Get(name string) string {
+	return strings.TrimSpace(r.Fields[name])
+}
+
+// List 获取列表值（按 | 分隔，忽略空元素）
+func (r Record) List(name string) []string {
+	var items []string
+	for _, item := range strings.Split(r.Fields[name], ListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ParseError 文件格式错误
+type ParseError struct {
+	Row int // 出错的行号，0 表示无法定位
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	if e.Row == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Read 按格式读取全部记录
+func Read(r io.Reader, format Format) ([]Record, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatJSON:
+		return readJSON(r)
+	case FormatNDJSON:
+		return readNDJSON(r)
+	}
+	return nil, ErrUnknownFormat
+}
+
+func readCSV(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []Record{}, nil
+	}
+	if err != nil {
+		return nil, csvError(err)
+	}
+	header[0] = strings.TrimPrefix(header[0], utf8BOM)
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			return nil, &ParseError{Row: 1, Err: fmt.Errorf("empty or duplicate column %q", name)}
+		}
+		seen[name] = true
+		header[i] = name
+	}
+
+	records := []Record{}
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, csvError(err)
+		}
+		line, _ := reader.FieldPos(0)
+		fields := make(map[string]string, len(header))
+		for i, name := range header {
+			fields[name] = values[i]
+		}
+		records = append(records, Record{Row: line, Fields: fields})
+	}
+}
+
+// csvError 转换 encoding/csv 的错误，保留行号
+func csvError(err error) error {
+	var pe *csv.ParseError
+	if errors.As(err, &pe) {
+		return &ParseError{Row: pe.Line, Err: pe.Err}
+	}
+	return &ParseError{Err: err}
+}
+
+func readJSON(r io.Reader) ([]Record, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err == io.EOF {
+		return []Record{}, nil
+	}
+	if delim, ok := token.(json.Delim); err != nil || !ok || delim != '[' {
+		return nil, &ParseError{Err: errors.New("expected a JSON array of objects")}
+	}
+
+	records := []Record{}
+	for row := 1; decoder.More(); row++ {
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, &ParseError{Row: row, Err: err}
+		}
+		record, err := toRecord(row, value)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, &ParseError{Err: err}
+	}
+	return records, nil
+}
+
+func readNDJSON(r io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	records := []Record{}
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if line == 1 {
+			data = bytes.TrimPrefix(data, []byte(utf8BOM))
+		}
+		if len(data) == 0 {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, &ParseError{Row: line, Err: err}
+		}
+		record, err := toRecord(line, value)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, &ParseError{Err: err}
+	}
+	return records, nil
+}
+
+// toRecord 将 JSON 对象转换为记录，数组转换为以 | 分隔的列表值
+func toRecord(row int, value interface{}) (Record, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return Record{}, &ParseError{Row: row, Err: errors.New("expected a JSON object")}
+	}
+
+	fields := make(map[string]string, len(object))
+	for name, v := range object {
+		if items, ok := v.([]interface{}); ok {
+			parts := make([]string, len(items))
+			for i, item := range items {
+				s, err := scalarString(item)
+				if err == nil && strings.Contains(s, ListSeparator) {
+					err = fmt.Errorf("list items must not contain %q", ListSeparator)
+				}
+				if err != nil {
+					return Record{}, &ParseError{Row: row, Err: fmt.Errorf("%s: %w", name, err)}
+				}
+				parts[i] = s
+			}
+			fields[name] = strings.Join(parts, ListSeparator)
+			continue
+		}
+
+		s, err := scalarString(v)
+		if err != nil {
+			return Record{}, &ParseError{Row: row, Err: fmt.Errorf("%s: %w", name, err)}
+		}
+		fields[name] = s
+	}
+	return Record{Row: row, Fields: fields}, nil
+}
+
+// scalarString 将 JSON 标量转换为字符串，null 视为空字符串
+func scalarString(v interface{}) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case json.Number:
+		return val.String(), nil
+	case bool:
+		if val {
+			return "true", nil
+		}
+		return "false", nil
+	}
+	return "", errors.New("nested values are not supported")
+}
@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Writer 按格式流式写出记录，字段顺序与列名一致
+// 值支持字符串、整数、浮点数与 []string（CSV 中以 | 连接，JSON 中为数组）
+type Writer struct {
+	format  Format
+	columns []string
+	buf     *bufio.Writer
+	csv     *csv.Writer
+	count   int
+}
+
+// NewWriter 创建写出器，写完后必须调用 Close
+func NewWriter(w io.Writer, format Format, columns []string) (*Writer, error) {
+	writer := &Writer{format: format, columns: columns}
+	switch format {
+	case FormatCSV:
+		writer.csv = csv.NewWriter(w)
+		if err := writer.csv.Write(columns); err != nil {
+			return nil, err
+		}
+	case FormatJSON, FormatNDJSON:
+		writer.buf = bufio.NewWriter(w)
+		if format == FormatJSON {
+			if _, err := writer.buf.WriteString("["); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, ErrUnknownFormat
+	}
+	return writer, nil
+}
+
+// Write 写出一行，values 与列名一一对应
+func (w *Writer) Write(values ...interface{}) error {
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("catalog: got %d values for %d columns", len(values), len(w.columns))
+	}
+	w.count++
+
+	if w.csv != nil {
+		row := make([]string, len(values))
+		for i, v := range values {
+			row[i] = csvValue(v)
+		}
+		return w.csv.Write(row)
+	}
+
+	var sb strings.Builder
+	if w.format == FormatJSON {
+		if w.count > 1 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("\n  ")
+	}
+	sb.WriteString("{")
+	for i, name := range w.columns {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		key, _ := json.Marshal(name)
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		sb.Write(key)
+		sb.WriteString(":")
+		sb.Write(value)
+	}
+	sb.WriteString("}")
+	if w.format == FormatNDJSON {
+		sb.WriteString("\n")
+	}
+	_, err := w.buf.WriteString(sb.String())
+	return err
+}
+
+// Close 结束输出（JSON 补全数组）并刷新缓冲
+func (w *Writer) Close() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	if w.format == FormatJSON {
+		end := "]\n"
+		if w.count > 0 {
+			end = "\n]\n"
+		}
+		if _, err := w.buf.WriteString(end); err != nil {
+			return err
+		}
+	}
+	return w.buf.Flush()
+}
+
+// csvValue 将值格式化为 CSV 单元格
+func csvValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []string:
+		return strings.Join(val, ListSeparator)
+	case int:
+		return strconv.Itoa(val)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case uint:
+		return strconv.FormatUint(uint64(val), 10)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
@@ -1,14 +1,24 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 // BizError 业务错误
+// Message 为默认语言（简体中文）的错误信息，其他语言按 MessageKey 从消息目录中查找
 type BizError struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+	Key     string `json:"-"` // 消息目录中的键，为空时使用错误码（同一错误码对应多种错误信息时需指定）
+	Params  Params `json:"-"` // 消息参数，替换消息中的 {name} 占位符
 	Err     error  `json:"-"`
 }
 
+// Params 错误信息参数（参数名 -> 值）
+type Params map[string]string
+
 func (e *BizError) Error() string {
 	if e.Err != nil {
 		return fmt.Sprintf("[%d] %s: %v", e.Code, e.Message, e.Err)
@@ -20,6 +30,42 @@ func (e *BizError) Unwrap() error {
 	return e.Err
 }
 
+// MessageKey 错误信息在消息目录中的键
+func (e *BizError) MessageKey() string {
+	if e.Key != "" {
+		return e.Key
+	}
+	return strconv.Itoa(e.Code)
+}
+
+// WithKey 返回指定消息键的副本（如 "40300.mod_owner"）
+func (e *BizError) WithKey(key string) *BizError {
+	clone := *e
+	clone.Key = key
+	return &clone
+}
+
+// WithParams 返回填充消息参数后的副本，如 ErrDependencyCycle.WithParams(Params{"path": "A -> B -> A"})
+// Message 中的占位符立即替换，消息目录中其他语言的消息由 Render 替换
+func (e *BizError) WithParams(params Params) *BizError {
+	clone := *e
+	clone.Params = params
+	clone.Message = clone.Render(e.Message)
+	return &clone
+}
+
+// Render 按 Params 替换消息中的 {name} 占位符
+func (e *BizError) Render(message string) string {
+	if len(e.Params) == 0 {
+		return message
+	}
+	pairs := make([]string, 0, len(e.Params)*2)
+	for name, value := range e.Params {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...).Replace(message)
+}
+
 // New 创建业务错误
 func New(code int, message string) *BizError {
 	return &BizError{Code: code, Message: message}
@@ -37,6 +83,7 @@ const (
 	CodeUnauthorized    = 40100
 	CodeForbidden       = 40300
 	CodeNotFound        = 40400
+	CodeTooManyRequests = 42900
 	CodeBusinessError   = 40000
 	CodeInternalError   = 50000
 
@@ -44,6 +91,73 @@ const (
 	CodeUserNotFound  = 20001
 	CodeUserExists    = 20002
 	CodePasswordError = 20003
+
+	// Mod 相关
+	CodeModNotFound      = 30001
+	CodeGameNotFound     = 30002
+	CodeCategoryNotFound = 30003
+	CodeInvalidCursor    = 30004
+	CodeDownloadNotFound = 30005
+
+	// Mod 依赖相关
+	CodeDependencyNotFound = 30101
+	CodeDependencyExists   = 30102
+	CodeDependencyInvalid  = 30103
+	CodeDependencyCycle    = 30104
+	CodeDependencyConflict = 30105
+
+	// 标签相关
+	CodeTagNotFound = 30201
+	CodeTagInvalid  = 30202
+
+	// 游戏版本 / 发布版本相关
+	CodeGameVersionNotFound   = 30301
+	CodeGameVersionExists     = 30302
+	CodeReleaseNotFound       = 30303
+	CodeReleaseExists         = 30304
+	CodeReleaseVersionInvalid = 30305
+
+	// 审核相关
+	CodeModStatusInvalid     = 30401
+	CodeReviewReasonRequired = 30402
+
+	// 作者相关
+	CodeMaintainerInvalid = 30501
+
+	// 评论相关
+	CodeCommentNotFound = 30601
+	CodeCommentDeleted  = 30602
+	CodeCommentInvalid  = 30603
+
+	// 举报相关
+	CodeReportNotFound      = 30701
+	CodeReportExists        = 30702
+	CodeReportTargetInvalid = 30703
+	CodeReportHandled       = 30704
+
+	// 目录导入导出相关
+	CodeCatalogFormatInvalid = 30801
+	CodeCatalogFileInvalid   = 30802
+	CodeCatalogTooLarge      = 30803
+
+	// 合集相关
+	CodeCollectionNotFound    = 30901
+	CodeCollectionItemInvalid = 30902
+	CodeCollectionTooLarge    = 30903
+
+	// 统计相关
+	CodeStatsRangeInvalid = 31001
+
+	// 多语言相关
+	CodeLocaleNotSupported  = 31101
+	CodeTranslationNotFound = 31102
+
+	// 图片相关
+	CodeImageInvalid         = 31201
+	CodeImageTooLarge        = 31202
+	CodeModImageLimit        = 31203
+	CodeModImageNotFound     = 31204
+	CodeModImageOrderInvalid = 31205
 )
 
 // 预定义错误
@@ -57,4 +171,84 @@ var (
 	ErrUserNotFound = New(CodeUserNotFound, "用户不存在")
 	ErrUserExists   = New(CodeUserExists, "用户已存在")
 	ErrPassword     = New(CodePasswordError, "密码错误")
+
+	ErrModNotFound      = New(CodeModNotFound, "Mod 不存在")
+	ErrGameNotFound     = New(CodeGameNotFound, "游戏不存在")
+	ErrCategoryNotFound = New(CodeCategoryNotFound, "分类不存在")
+	ErrInvalidCursor    = New(CodeInvalidCursor, "分页游标无效或已过期")
+	ErrDownloadNotFound = New(CodeDownloadNotFound, "该 Mod 暂无可用的下载链接")
+
+	ErrGameIDInvalid           = New(CodeValidationError, "游戏ID格式错误").WithKey("42200.game_id")
+	ErrCategoryIDInvalid       = New(CodeValidationError, "分类ID格式错误").WithKey("42200.category_id")
+	ErrGameVersionIDInvalid    = New(CodeValidationError, "游戏版本ID格式错误").WithKey("42200.game_version_id")
+	ErrGameVersionGameRequired = New(CodeValidationError, "按游戏版本约束筛选时需指定游戏").WithKey("42200.game_version_game")
+	ErrGameVersionConstraint   = New(CodeValidationError, "游戏版本约束格式错误").WithKey("42200.game_version_constraint")
+
+	ErrDependencyNotFound = New(CodeDependencyNotFound, "依赖关系不存在")
+	ErrDependencyExists   = New(CodeDependencyExists, "依赖关系已存在")
+	ErrDependencySelf     = New(CodeDependencyInvalid, "Mod 不能依赖自身").WithKey("30103.self")
+	ErrVersionConstraint  = New(CodeDependencyInvalid, "版本约束格式错误").WithKey("30103.version_constraint")
+	ErrDependencyTarget   = New(CodeModNotFound, "被依赖的 Mod 不存在").WithKey("30001.dependency_target")
+	// 以下错误需通过 WithParams 填充参数
+	ErrDependencyCycle      = New(CodeDependencyCycle, "存在循环依赖：{path}").WithKey("30104.cycle")
+	ErrDependencyWouldCycle = New(CodeDependencyCycle, "添加该依赖会形成循环：{path}").WithKey("30104.would_cycle")
+	ErrDependencyConflict   = New(CodeDependencyConflict, "依赖冲突：{conflicts}").WithKey("30105.conflicts")
+
+	ErrTagNotFound = New(CodeTagNotFound, "标签不存在")
+	ErrTagInvalid  = New(CodeTagInvalid, "标签名称不能为空且不能包含逗号")
+
+	ErrGameVersionNotFound   = New(CodeGameVersionNotFound, "游戏版本不存在或不属于该游戏")
+	ErrGameVersionExists     = New(CodeGameVersionExists, "游戏版本已存在")
+	ErrReleaseNotFound       = New(CodeReleaseNotFound, "发布版本不存在")
+	ErrReleaseExists         = New(CodeReleaseExists, "该版本号已发布")
+	ErrReleaseVersionInvalid = New(CodeReleaseVersionInvalid, "版本号需以数字开头，如 1.2.3、5.2SE、2.0-beta1")
+
+	ErrModStatusInvalid     = New(CodeModStatusInvalid, "当前审核状态不允许该操作")
+	ErrReviewReasonRequired = New(CodeReviewReasonRequired, "驳回或下架必须填写原因")
+
+	ErrNotModOwner        = New(CodeForbidden, "只有 Mod 作者可以执行该操作").WithKey("40300.mod_owner")
+	ErrNotModEditor       = New(CodeForbidden, "只有 Mod 作者或共同维护者可以执行该操作").WithKey("40300.mod_editor")
+	ErrMaintainerIsOwner  = New(CodeMaintainerInvalid, "作者无需添加为共同维护者").WithKey("30501.owner")
+	ErrMaintainerNotFound = New(CodeMaintainerInvalid, "该用户不是 Mod 的共同维护者").WithKey("30501.not_maintainer")
+
+	ErrCommentNotFound    = New(CodeCommentNotFound, "评论不存在")
+	ErrCommentDeleted     = New(CodeCommentDeleted, "评论已删除")
+	ErrCommentEmpty       = New(CodeCommentInvalid, "评论内容不能为空")
+	ErrNotCommentOwner    = New(CodeForbidden, "只能修改或删除自己的评论").WithKey("40300.comment_owner")
+	ErrCommentRateLimited = New(CodeTooManyRequests, "评论过于频繁，请稍后再试").WithKey("42900.comment")
+
+	ErrReportNotFound      = New(CodeReportNotFound, "举报不存在")
+	ErrReportExists        = New(CodeReportExists, "你已经举报过该内容")
+	ErrReportTargetInvalid = New(CodeReportTargetInvalid, "举报对象不存在或不支持举报")
+	ErrReportHandled       = New(CodeReportHandled, "该举报已处理")
+
+	ErrCatalogEntityInvalid = New(CodeValidationError, "数据类型只能是 games、categories 或 mods").WithKey("42200.catalog_entity")
+	ErrCatalogFormatInvalid = New(CodeCatalogFormatInvalid, "不支持的文件格式，只支持 csv、json、ndjson")
+	ErrCatalogFileRequired  = New(CodeCatalogFileInvalid, "请上传导入文件")
+	ErrCatalogTooLarge      = New(CodeCatalogTooLarge, "导入文件过大")
+
+	ErrCollectionNotFound     = New(CodeCollectionNotFound, "合集不存在")
+	ErrNotCollectionOwner     = New(CodeForbidden, "只有合集创建者可以执行该操作").WithKey("40300.collection_owner")
+	ErrCollectionItemNotFound = New(CodeCollectionItemInvalid, "该 Mod 不在合集中")
+	ErrCollectionTooLarge     = New(CodeCollectionTooLarge, "合集中的 Mod 数量超过上限")
+	// 以下错误需通过 WithParams 填充参数
+	ErrCollectionItemDuplicate      = New(CodeCollectionItemInvalid, "Mod {mod_id} 重复添加").WithKey("30902.duplicate")
+	ErrCollectionItemUnavailable    = New(CodeCollectionItemInvalid, "Mod {mod_id} 不存在或未公开").WithKey("30902.unavailable")
+	ErrCollectionItemGameMismatch   = New(CodeCollectionItemInvalid, "{mod} 不属于合集的游戏").WithKey("30902.game_mismatch")
+	ErrCollectionItemReleaseInvalid = New(CodeCollectionItemInvalid, "发布版本 {release_id} 不属于 {mod}").WithKey("30902.release_mismatch")
+
+	ErrStatsRangeInvalid = New(CodeStatsRangeInvalid, "统计开始日期不能晚于结束日期")
+	ErrStatsRangeTooLong = New(CodeStatsRangeInvalid, "统计时间范围不能超过 366 天").WithKey("31001.too_long")
+
+	ErrLocaleNotSupported       = New(CodeLocaleNotSupported, "不支持该语言")
+	ErrDefaultLocaleTranslation = New(CodeLocaleNotSupported, "默认语言的内容请直接修改原数据，无需添加翻译").WithKey("31101.default_locale")
+	ErrTranslationNotFound      = New(CodeTranslationNotFound, "翻译不存在")
+
+	ErrImageRequired        = New(CodeImageInvalid, "请上传图片").WithKey("31201.required")
+	ErrImageFormat          = New(CodeImageInvalid, "只支持 JPEG、PNG、GIF 格式的图片")
+	ErrImageTooLarge        = New(CodeImageTooLarge, "图片文件过大")
+	ErrImageDimensions      = New(CodeImageTooLarge, "图片尺寸过大").WithKey("31202.dimensions")
+	ErrModImageLimit        = New(CodeModImageLimit, "截图数量已达上限")
+	ErrModImageNotFound     = New(CodeModImageNotFound, "截图不存在")
+	ErrModImageOrderInvalid = New(CodeModImageOrderInvalid, "排序列表必须包含该 Mod 的全部截图且不能重复")
 )
@@ -0,0 +1,68 @@
+package errors
+
+import "net/http"
+
+// httpStatusByCode 业务错误码对应的 HTTP 状态码（未列出的业务错误码按 400 处理）
+var httpStatusByCode = map[int]int{
+	CodeUserNotFound:  http.StatusNotFound,
+	CodeUserExists:    http.StatusConflict,
+	CodePasswordError: http.StatusUnauthorized,
+
+	CodeModNotFound:      http.StatusNotFound,
+	CodeGameNotFound:     http.StatusNotFound,
+	CodeCategoryNotFound: http.StatusNotFound,
+	CodeDownloadNotFound: http.StatusNotFound,
+
+	CodeDependencyNotFound: http.StatusNotFound,
+	CodeDependencyExists:   http.StatusConflict,
+	CodeDependencyCycle:    http.StatusConflict,
+	CodeDependencyConflict: http.StatusConflict,
+
+	CodeTagNotFound: http.StatusNotFound,
+
+	CodeGameVersionNotFound: http.StatusNotFound,
+	CodeGameVersionExists:   http.StatusConflict,
+	CodeReleaseNotFound:     http.StatusNotFound,
+	CodeReleaseExists:       http.StatusConflict,
+
+	CodeModStatusInvalid: http.StatusConflict,
+
+	CodeCommentNotFound: http.StatusNotFound,
+	CodeCommentDeleted:  http.StatusNotFound,
+
+	CodeReportNotFound: http.StatusNotFound,
+	CodeReportExists:   http.StatusConflict,
+	CodeReportHandled:  http.StatusConflict,
+
+	CodeCatalogTooLarge: http.StatusRequestEntityTooLarge,
+
+	CodeCollectionNotFound: http.StatusNotFound,
+
+	CodeTranslationNotFound: http.StatusNotFound,
+
+	CodeImageTooLarge:    http.StatusRequestEntityTooLarge,
+	CodeModImageNotFound: http.StatusNotFound,
+}
+
+// HTTPStatus 错误码对应的 HTTP 状态码
+// 通用错误码按前三位映射（如 40400 为 404、50000 为 500），业务错误码按 httpStatusByCode 映射
+func HTTPStatus(code int) int {
+	switch {
+	case code == CodeSuccess:
+		return http.StatusOK
+	case code >= 40000 && code < 60000:
+		if status := code / 100; http.StatusText(status) != "" {
+			return status
+		}
+		return http.StatusBadRequest
+	}
+	if status, ok := httpStatusByCode[code]; ok {
+		return status
+	}
+	return http.StatusBadRequest
+}
+
+// HTTPStatus 错误对应的 HTTP 状态码
+func (e *BizError) HTTPStatus() int {
+	return HTTPStatus(e.Code)
+}
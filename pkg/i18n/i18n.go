@@ -0,0 +1,248 @@
+package i18n
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ja"
+	"github.com/go-playground/locales/zh"
+	"github.com/go-playground/locales/zh_Hant_TW"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	ja_translations "github.com/go-playground/validator/v10/translations/ja"
+	zh_translations "github.com/go-playground/validator/v10/translations/zh"
+	zh_tw_translations "github.com/go-playground/validator/v10/translations/zh_tw"
+	"gopkg.in/yaml.v2"
+
+	"gin-web/pkg/locale"
+)
+
+// validatorTranslation 验证器内置规则的翻译
+type validatorTranslation struct {
+	locale   func() locales.Translator
+	register func(v *validator.Validate, trans ut.Translator) error
+}
+
+// validatorTranslations 支持内置规则翻译的语言（先按完整标签匹配，再按主语言匹配）
+var validatorTranslations = map[string]validatorTranslation{
+	"en":    {en.New, en_translations.RegisterDefaultTranslations},
+	"ja":    {ja.New, ja_translations.RegisterDefaultTranslations},
+	"zh":    {zh.New, zh_translations.RegisterDefaultTranslations},
+	"zh-TW": {zh_Hant_TW.New, zh_tw_translations.RegisterDefaultTranslations},
+}
+
+// Bundle 多语言消息目录
+// 每种语言一个目录（键 -> 消息），键为错误码（如 "30001"）或验证消息键（如 "Mobile.required"）；
+// 代码中的消息使用 source 语言书写，该语言缺少目录项时直接使用代码中的消息
+type Bundle struct {
+	source      string
+	negotiator  *locale.Negotiator
+	catalogs    map[string]map[string]string
+	translators map[string]ut.Translator
+}
+
+// NewBundle 创建消息目录
+// source 为代码中消息使用的语言；defaultLocale、supported 用于协商请求语言，与内容多语言配置一致
+func NewBundle(source, defaultLocale string, supported []string) *Bundle {
+	return &Bundle{
+		source:      locale.Normalize(source),
+		negotiator:  locale.NewNegotiator(defaultLocale, supported),
+		catalogs:    make(map[string]map[string]string),
+		translators: make(map[string]ut.Translator),
+	}
+}
+
+// AddMessages 添加消息（同一语言多次添加时后添加的覆盖先添加的）
+func (b *Bundle) AddMessages(tag string, messages map[string]string) {
+	tag = locale.Normalize(tag)
+	if tag == "" {
+		return
+	}
+	catalog, ok := b.catalogs[tag]
+	if !ok {
+		catalog = make(map[string]string, len(messages))
+		b.catalogs[tag] = catalog
+	}
+	for key, message := range messages {
+		catalog[key] = message
+	}
+}
+
+// LoadFile 加载消息文件，语言取自文件名（如 en.yaml、zh-TW.json），格式按扩展名识别
+// YAML 中的嵌套映射按 "." 展开为扁平的键
+func (b *Bundle) LoadFile(name string, data []byte) error {
+	ext := path.Ext(name)
+	tag := locale.Normalize(strings.TrimSuffix(path.Base(name), ext))
+	if tag == "" {
+		return fmt.Errorf("load messages %s: invalid locale in file name", name)
+	}
+
+	raw := make(map[string]interface{})
+	switch strings.ToLower(ext) {
+	case ".yaml", ".yml":
+		var content map[interface{}]interface{}
+		if err := yaml.Unmarshal(data, &content); err != nil {
+			return fmt.Errorf("load messages %s: %w", name, err)
+		}
+		for key, value := range content {
+			raw[fmt.Sprint(key)] = value
+		}
+	case ".json":
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return fmt.Errorf("load messages %s: %w", name, err)
+		}
+	default:
+		return fmt.Errorf("load messages %s: unsupported format %q", name, ext)
+	}
+
+	messages := make(map[string]string)
+	if err := flatten("", raw, messages); err != nil {
+		return fmt.Errorf("load messages %s: %w", name, err)
+	}
+	b.AddMessages(tag, messages)
+	return nil
+}
+
+// LoadFS 加载 fsys 中 dir 目录下的全部消息文件（.yaml、.yml、.json）
+func (b *Bundle) LoadFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return fmt.Errorf("load messages: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(path.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		name := path.Join(dir, entry.Name())
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return fmt.Errorf("load messages %s: %w", name, err)
+		}
+		if err := b.LoadFile(name, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadDir 加载本地目录下的全部消息文件
+func (b *Bundle) LoadDir(dir string) error {
+	return b.LoadFS(os.DirFS(dir), ".")
+}
+
+func flatten(prefix string, value interface{}, messages map[string]string) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if err := flatten(join(prefix, key), child, messages); err != nil {
+				return err
+			}
+		}
+	case map[interface{}]interface{}:
+		for key, child := range v {
+			if err := flatten(join(prefix, fmt.Sprint(key)), child, messages); err != nil {
+				return err
+			}
+		}
+	case string:
+		messages[prefix] = v
+	default:
+		return fmt.Errorf("message %q must be a string", prefix)
+	}
+	return nil
+}
+
+func join(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// RegisterValidator 为支持的语言注册验证器内置规则的翻译（go-playground/universal-translator）
+// 没有内置翻译的语言跳过，其验证消息只使用消息目录
+func (b *Bundle) RegisterValidator(v *validator.Validate) error {
+	for _, tag := range b.negotiator.Supported() {
+		translation, ok := validatorTranslations[tag]
+		if !ok {
+			translation, ok = validatorTranslations[locale.Base(tag)]
+		}
+		if !ok {
+			continue
+		}
+
+		lt := translation.locale()
+		trans, _ := ut.New(lt, lt).GetTranslator(lt.Locale())
+		if err := translation.register(v, trans); err != nil {
+			return fmt.Errorf("register validator translations for %s: %w", tag, err)
+		}
+		b.translators[tag] = trans
+	}
+	return nil
+}
+
+// Negotiate 协商请求语言：lang 参数优先，其次按 Accept-Language，均不支持时使用默认语言
+func (b *Bundle) Negotiate(acceptLanguage, lang string) string {
+	return b.negotiator.Negotiate(acceptLanguage, lang)
+}
+
+// Localizer 返回指定语言的本地化器
+func (b *Bundle) Localizer(tag string) *Localizer {
+	tag = locale.Normalize(tag)
+	catalog, ok := b.catalogs[tag]
+	if !ok {
+		catalog = b.catalogs[locale.Base(tag)]
+	}
+	return &Localizer{
+		locale:     tag,
+		source:     tag == b.source || locale.Base(tag) == locale.Base(b.source),
+		catalog:    catalog,
+		translator: b.translators[tag],
+	}
+}
+
+// Localizer 单一语言的消息查找
+type Localizer struct {
+	locale     string
+	source     bool
+	catalog    map[string]string
+	translator ut.Translator
+}
+
+// Locale 语言标签
+func (l *Localizer) Locale() string {
+	return l.locale
+}
+
+// IsSource 是否为代码中消息使用的语言（或其同一主语言），此时代码中的消息可直接返回
+func (l *Localizer) IsSource() bool {
+	return l.source
+}
+
+// Lookup 查找消息目录中的消息
+func (l *Localizer) Lookup(key string) (string, bool) {
+	message, ok := l.catalog[key]
+	return message, ok && message != ""
+}
+
+// TranslateField 使用验证器内置规则的翻译生成字段错误信息，规则没有翻译时返回 false
+func (l *Localizer) TranslateField(fe validator.FieldError) (string, bool) {
+	if l.translator == nil {
+		return "", false
+	}
+	// 没有注册翻译的规则（如自定义规则）Translate 返回 fe.Error()
+	message := fe.Translate(l.translator)
+	return message, message != fe.Error()
+}
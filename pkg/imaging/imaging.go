@@ -0,0 +1,196 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+
+	// 注册 GIF、PNG 解码器
+	_ "image/gif"
+	_ "image/png"
+)
+
+// ErrUnsupportedFormat 不支持的图片格式
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+// ErrImageTooLarge 图片像素数超过上限
+var ErrImageTooLarge = errors.New("image dimensions too large")
+
+// 支持上传的图片格式（image.Decode 返回的格式名）
+var supportedFormats = map[string]string{
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"gif":  "image/gif",
+}
+
+// Info 图片基本信息
+type Info struct {
+	Format      string // jpeg / png / gif
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Inspect 只解析图片头部，校验格式与像素数（maxPixels 为 0 时不限制），避免解码超大图片
+func Inspect(data []byte, maxPixels int) (*Info, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	contentType, ok := supportedFormats[format]
+	if !ok {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, ErrUnsupportedFormat
+	}
+	if maxPixels > 0 && cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrImageTooLarge
+	}
+	return &Info{Format: format, ContentType: contentType, Width: cfg.Width, Height: cfg.Height}, nil
+}
+
+// Decode 解码图片为 NRGBA（GIF 只取第一帧）
+func Decode(r io.Reader) (*image.NRGBA, error) {
+	img, format, err := image.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := supportedFormats[format]; !ok {
+		return nil, ErrUnsupportedFormat
+	}
+	return toNRGBA(img), nil
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) {
+		return nrgba
+	}
+	b := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	return dst
+}
+
+// FitSize 计算等比缩放到 maxWidth x maxHeight 以内的尺寸（不放大，0 表示该方向不限制）
+func FitSize(width, height, maxWidth, maxHeight int) (int, int) {
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = float64(maxWidth) / float64(width)
+	}
+	if maxHeight > 0 && height > maxHeight {
+		if s := float64(maxHeight) / float64(height); s < scale {
+			scale = s
+		}
+	}
+	if scale >= 1 {
+		return width, height
+	}
+
+	w := int(float64(width)*scale + 0.5)
+	h := int(float64(height)*scale + 0.5)
+	if w < 1 {
+		w = 1
+	}
+	if h < 1 {
+		h = 1
+	}
+	return w, h
+}
+
+// Fit 等比缩小到 maxWidth x maxHeight 以内（不放大），使用区域平均采样
+func Fit(img *image.NRGBA, maxWidth, maxHeight int) *image.NRGBA {
+	b := img.Bounds()
+	w, h := FitSize(b.Dx(), b.Dy(), maxWidth, maxHeight)
+	if w == b.Dx() && h == b.Dy() {
+		return img
+	}
+	return resize(img, w, h)
+}
+
+// resize 区域平均缩小：目标像素取其覆盖的源像素区域（含部分覆盖）的加权平均，颜色按 alpha 预乘后平均
+func resize(src *image.NRGBA, width, height int) *image.NRGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	xWeights := areaWeights(sw, width)
+	yWeights := areaWeights(sh, height)
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var r, g, b, a, total float64
+			for _, wy := range yWeights[y] {
+				row := src.Pix[wy.index*src.Stride:]
+				for _, wx := range xWeights[x] {
+					p := row[wx.index*4 : wx.index*4+4]
+					weight := wx.weight * wy.weight
+					alpha := float64(p[3]) * weight
+					r += float64(p[0]) * alpha
+					g += float64(p[1]) * alpha
+					b += float64(p[2]) * alpha
+					a += alpha
+					total += weight
+				}
+			}
+
+			i := y*dst.Stride + x*4
+			if a > 0 {
+				dst.Pix[i] = clamp8(r / a)
+				dst.Pix[i+1] = clamp8(g / a)
+				dst.Pix[i+2] = clamp8(b / a)
+			}
+			dst.Pix[i+3] = clamp8(a / total)
+		}
+	}
+	return dst
+}
+
+type sampleWeight struct {
+	index  int
+	weight float64
+}
+
+// areaWeights 计算每个目标坐标覆盖的源坐标及覆盖比例
+func areaWeights(src, dst int) [][]sampleWeight {
+	scale := float64(src) / float64(dst)
+	weights := make([][]sampleWeight, dst)
+	for i := range weights {
+		start, end := float64(i)*scale, float64(i+1)*scale
+		for j := int(start); j < src && float64(j) < end; j++ {
+			lo, hi := float64(j), float64(j+1)
+			if lo < start {
+				lo = start
+			}
+			if hi > end {
+				hi = end
+			}
+			if hi > lo {
+				weights[i] = append(weights[i], sampleWeight{index: j, weight: hi - lo})
+			}
+		}
+	}
+	return weights
+}
+
+func clamp8(v float64) uint8 {
+	v += 0.5
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+// EncodeJPEG 编码为 JPEG（透明区域合成到白色背景上）
+func EncodeJPEG(w io.Writer, img *image.NRGBA, quality int) error {
+	b := img.Bounds()
+	rgba := image.NewRGBA(b)
+	draw.Draw(rgba, b, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(rgba, b, img, b.Min, draw.Over)
+	return jpeg.Encode(w, rgba, &jpeg.Options{Quality: quality})
+}
@@ -0,0 +1,594 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"io"
+	"sort"
+)
+
+// 无损 WebP（VP8L）编码
+// 只使用减绿变换、预测变换（按块选择左 / 上 / 左上平均三种预测模式）与前缀编码，后向引用只用于重复前一像素，不使用颜色缓存，
+// 压缩率低于 libwebp，但输出为标准 WebP 文件，无需 cgo 或外部命令
+
+// maxWebPDimension VP8L 支持的最大宽高
+const maxWebPDimension = 1 << 14
+
+// ErrWebPTooLarge 图片尺寸超过 WebP 上限
+var ErrWebPTooLarge = errors.New("image too large for webp")
+
+const (
+	vp8lSignature = 0x2f
+
+	transformPredictor     = 0
+	transformSubtractGreen = 2
+	predictorBlockBits     = 4 // 预测模式按 16x16 块选择
+	predictorSizeBitsCode  = predictorBlockBits - 2
+
+	numLiteralCodes   = 256
+	numLengthCodes    = 24
+	numDistanceCodes  = 40
+	numCodeLengthSyms = 19
+	maxCodeLength     = 15
+	maxCodeLengthCode = 7
+)
+
+// 预测模式
+const (
+	predictLeft    = 1
+	predictTop     = 2
+	predictAverage = 7 // Average2(L, T)
+)
+
+var predictModes = []int{predictLeft, predictTop, predictAverage}
+
+// codeLengthOrder 码长码的码长写入顺序
+var codeLengthOrder = [numCodeLengthSyms]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// EncodeWebP 编码为无损 WebP
+func EncodeWebP(w io.Writer, img *image.NRGBA) error {
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if width < 1 || height < 1 || width > maxWebPDimension || height > maxWebPDimension {
+		return ErrWebPTooLarge
+	}
+
+	argb := make([]uint32, width*height)
+	hasAlpha := false
+	for y := 0; y < height; y++ {
+		row := img.Pix[y*img.Stride:]
+		for x := 0; x < width; x++ {
+			p := row[x*4 : x*4+4]
+			if p[3] != 0xff {
+				hasAlpha = true
+			}
+			argb[y*width+x] = uint32(p[3])<<24 | uint32(p[0])<<16 | uint32(p[1])<<8 | uint32(p[2])
+		}
+	}
+
+	bw := &bitWriter{}
+	bw.writeBits(vp8lSignature, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	bw.writeBits(boolBit(hasAlpha), 1)
+	bw.writeBits(0, 3) // version
+
+	// 解码器按写入的逆序应用变换：先还原预测，再加回绿色
+	subtractGreen(argb)
+	bw.writeBits(1, 1)
+	bw.writeBits(transformSubtractGreen, 2)
+
+	modes, blocksX := choosePredictors(argb, width, height)
+	residuals := predictResiduals(argb, width, height, modes, blocksX)
+	bw.writeBits(1, 1)
+	bw.writeBits(transformPredictor, 2)
+	bw.writeBits(predictorSizeBitsCode, 3)
+	modeImage := make([]uint32, len(modes))
+	for i, m := range modes {
+		modeImage[i] = 0xff000000 | uint32(m)<<8
+	}
+	writeEntropyImage(bw, modeImage, false)
+
+	bw.writeBits(0, 1) // 没有更多变换
+	writeEntropyImage(bw, residuals, true)
+
+	data := bw.bytes()
+	return writeRIFF(w, data)
+}
+
+func boolBit(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func writeRIFF(w io.Writer, data []byte) error {
+	chunkSize := len(data)
+	padded := chunkSize + chunkSize&1
+	header := make([]byte, 20)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(4+8+padded))
+	copy(header[8:12], "WEBP")
+	copy(header[12:16], "VP8L")
+	binary.LittleEndian.PutUint32(header[16:20], uint32(chunkSize))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if padded != chunkSize {
+		_, err := w.Write([]byte{0})
+		return err
+	}
+	return nil
+}
+
+// subtractGreen 减绿变换：红、蓝分量减去绿色分量
+func subtractGreen(argb []uint32) {
+	for i, p := range argb {
+		g := (p >> 8) & 0xff
+		r := ((p >> 16) - g) & 0xff
+		b := (p - g) & 0xff
+		argb[i] = p&0xff00ff00 | r<<16 | b
+	}
+}
+
+// predict 计算 (x, y) 处的预测值（首行用左侧像素，首列用上方像素，左上角为不透明黑色）
+func predict(argb []uint32, width, x, y, mode int) uint32 {
+	switch {
+	case x == 0 && y == 0:
+		return 0xff000000
+	case y == 0:
+		return argb[y*width+x-1]
+	case x == 0:
+		return argb[(y-1)*width+x]
+	}
+
+	left := argb[y*width+x-1]
+	top := argb[(y-1)*width+x]
+	switch mode {
+	case predictLeft:
+		return left
+	case predictTop:
+		return top
+	default:
+		return average2(left, top)
+	}
+}
+
+// average2 逐分量取平均（向下取整）
+func average2(a, b uint32) uint32 {
+	return (((a ^ b) & 0xfefefefe) >> 1) + (a & b)
+}
+
+// subPixels 逐分量相减（模 256）
+func subPixels(a, b uint32) uint32 {
+	alphaGreen := 0x00ff00ff + (a & 0xff00ff00) - (b & 0xff00ff00)
+	redBlue := 0xff00ff00 + (a & 0x00ff00ff) - (b & 0x00ff00ff)
+	return (alphaGreen & 0xff00ff00) | (redBlue & 0x00ff00ff)
+}
+
+// residualCost 残差的代价估计（各分量按有符号数取绝对值之和）
+func residualCost(r uint32) int {
+	cost := 0
+	for shift := 0; shift < 32; shift += 8 {
+		v := int(int8(r >> shift))
+		if v < 0 {
+			v = -v
+		}
+		cost += v
+	}
+	return cost
+}
+
+// choosePredictors 为每个块选择残差代价最小的预测模式
+func choosePredictors(argb []uint32, width, height int) ([]int, int) {
+	size := 1 << predictorBlockBits
+	blocksX := (width + size - 1) / size
+	blocksY := (height + size - 1) / size
+	modes := make([]int, blocksX*blocksY)
+
+	for by := 0; by < blocksY; by++ {
+		for bx := 0; bx < blocksX; bx++ {
+			best, bestCost := predictModes[0], -1
+			for _, mode := range predictModes {
+				cost := 0
+				for y := by * size; y < (by+1)*size && y < height; y++ {
+					for x := bx * size; x < (bx+1)*size && x < width; x++ {
+						cost += residualCost(subPixels(argb[y*width+x], predict(argb, width, x, y, mode)))
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+			modes[by*blocksX+bx] = best
+		}
+	}
+	return modes, blocksX
+}
+
+// predictResiduals 计算预测残差
+func predictResiduals(argb []uint32, width, height int, modes []int, blocksX int) []uint32 {
+	residuals := make([]uint32, len(argb))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mode := modes[(y>>predictorBlockBits)*blocksX+(x>>predictorBlockBits)]
+			residuals[y*width+x] = subPixels(argb[y*width+x], predict(argb, width, x, y, mode))
+		}
+	}
+	return residuals
+}
+
+// writeEntropyImage 写入前缀编码的 ARGB 图像（不使用颜色缓存；main 为 true 时表示主图像，需写入元前缀码标志）
+func writeEntropyImage(bw *bitWriter, pixels []uint32, main bool) {
+	bw.writeBits(0, 1) // 不使用颜色缓存
+	if main {
+		bw.writeBits(0, 1) // 不使用元前缀码
+	}
+
+	symbols := tokenizePixels(pixels)
+	green := make([]uint32, numLiteralCodes+numLengthCodes)
+	red := make([]uint32, numLiteralCodes)
+	blue := make([]uint32, numLiteralCodes)
+	alpha := make([]uint32, numLiteralCodes)
+	distance := make([]uint32, numDistanceCodes)
+	for _, t := range symbols {
+		if t.length > 0 {
+			code, _, _ := prefixEncode(t.length)
+			green[numLiteralCodes+code]++
+			distance[runDistanceCode]++
+			continue
+		}
+		p := t.pixel
+		green[(p>>8)&0xff]++
+		red[(p>>16)&0xff]++
+		blue[p&0xff]++
+		alpha[p>>24]++
+	}
+
+	codes := [5]*prefixCode{
+		writePrefixCode(bw, green),
+		writePrefixCode(bw, red),
+		writePrefixCode(bw, blue),
+		writePrefixCode(bw, alpha),
+		writePrefixCode(bw, distance),
+	}
+	for _, t := range symbols {
+		if t.length > 0 {
+			code, extra, bits := prefixEncode(t.length)
+			codes[0].write(bw, numLiteralCodes+code)
+			bw.writeBits(extra, bits)
+			// 距离码 runDistanceCode 没有额外位
+			codes[4].write(bw, runDistanceCode)
+			continue
+		}
+		p := t.pixel
+		codes[0].write(bw, int((p>>8)&0xff))
+		codes[1].write(bw, int((p>>16)&0xff))
+		codes[2].write(bw, int(p&0xff))
+		codes[3].write(bw, int(p>>24))
+	}
+}
+
+const (
+	// runDistanceCode 距离码 1 对应距离值 2，即平面码表中的 (1, 0)：复制左侧（前一个）像素
+	runDistanceCode = 1
+	minRunLength    = 3
+	maxRunLength    = 4096
+)
+
+// pixelToken 字面像素（length 为 0）或重复前一像素 length 次的后向引用
+type pixelToken struct {
+	pixel  uint32
+	length int
+}
+
+// tokenizePixels 将连续相同的像素编码为距离为 1 的后向引用
+func tokenizePixels(pixels []uint32) []pixelToken {
+	tokens := make([]pixelToken, 0, len(pixels))
+	for i := 0; i < len(pixels); {
+		run := 0
+		if i > 0 {
+			for i+run < len(pixels) && pixels[i+run] == pixels[i-1] {
+				run++
+			}
+		}
+		if run < minRunLength {
+			tokens = append(tokens, pixelToken{pixel: pixels[i]})
+			i++
+			continue
+		}
+		for run > 0 {
+			n := min(run, maxRunLength)
+			tokens = append(tokens, pixelToken{length: n})
+			i += n
+			run -= n
+		}
+	}
+	return tokens
+}
+
+// prefixEncode 长度或距离值的前缀编码，返回前缀码、额外位的值与位数
+func prefixEncode(value int) (int, uint32, uint) {
+	d := value - 1
+	if d < 4 {
+		return d, 0, 0
+	}
+	highest := 0
+	for v := d; v > 1; v >>= 1 {
+		highest++
+	}
+	second := (d >> (highest - 1)) & 1
+	bits := uint(highest - 1)
+	return 2*highest + second, uint32(d) & (1<<bits - 1), bits
+}
+
+// prefixCode 规范前缀码（codes 已按位反转，可直接按低位优先写入）
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint16
+}
+
+func (c *prefixCode) write(bw *bitWriter, symbol int) {
+	if n := c.lengths[symbol]; n > 0 {
+		bw.writeBits(uint32(c.codes[symbol]), uint(n))
+	}
+}
+
+// writePrefixCode 根据符号频次写入前缀码并返回编码表
+// 使用的符号不超过两个且都小于 256 时使用简单码，否则写入码长（码长本身再用码长码编码）
+func writePrefixCode(bw *bitWriter, freq []uint32) *prefixCode {
+	var used []int
+	for s, f := range freq {
+		if f > 0 {
+			used = append(used, s)
+		}
+	}
+
+	if len(used) <= 2 && (len(used) == 0 || used[len(used)-1] < numLiteralCodes) {
+		if len(used) == 0 {
+			used = []int{0}
+		}
+		bw.writeBits(1, 1)
+		bw.writeBits(uint32(len(used)-1), 1)
+		if used[0] <= 1 {
+			bw.writeBits(0, 1)
+			bw.writeBits(uint32(used[0]), 1)
+		} else {
+			bw.writeBits(1, 1)
+			bw.writeBits(uint32(used[0]), 8)
+		}
+		if len(used) == 2 {
+			bw.writeBits(uint32(used[1]), 8)
+		}
+
+		lengths := make([]uint8, len(freq))
+		if len(used) == 2 {
+			lengths[used[0]], lengths[used[1]] = 1, 1
+			return newPrefixCode(lengths)
+		}
+		// 只有一个符号时编码长度为 0
+		return &prefixCode{lengths: lengths, codes: make([]uint16, len(freq))}
+	}
+
+	lengths := huffmanLengths(freq, maxCodeLength)
+	bw.writeBits(0, 1)
+	writeCodeLengths(bw, lengths)
+	return newPrefixCode(lengths)
+}
+
+// clToken 码长序列的游程编码（16 重复前一个码长，17/18 重复 0）
+type clToken struct {
+	symbol int
+	extra  uint32
+	bits   uint
+}
+
+func tokenizeLengths(lengths []uint8) []clToken {
+	var tokens []clToken
+	for i := 0; i < len(lengths); {
+		if lengths[i] != 0 {
+			tokens = append(tokens, clToken{symbol: int(lengths[i])})
+			i++
+			continue
+		}
+
+		run := 0
+		for i+run < len(lengths) && lengths[i+run] == 0 {
+			run++
+		}
+		i += run
+		for run > 0 {
+			switch {
+			case run >= 11:
+				n := min(run, 138)
+				tokens = append(tokens, clToken{symbol: 18, extra: uint32(n - 11), bits: 7})
+				run -= n
+			case run >= 3:
+				tokens = append(tokens, clToken{symbol: 17, extra: uint32(run - 3), bits: 3})
+				run = 0
+			default:
+				tokens = append(tokens, clToken{symbol: 0})
+				run--
+			}
+		}
+	}
+	return tokens
+}
+
+func writeCodeLengths(bw *bitWriter, lengths []uint8) {
+	tokens := tokenizeLengths(lengths)
+	freq := make([]uint32, numCodeLengthSyms)
+	for _, t := range tokens {
+		freq[t.symbol]++
+	}
+
+	clLengths := huffmanLengths(freq, maxCodeLengthCode)
+	count := numCodeLengthSyms
+	for count > 4 && clLengths[codeLengthOrder[count-1]] == 0 {
+		count--
+	}
+	bw.writeBits(uint32(count-4), 4)
+	for i := 0; i < count; i++ {
+		bw.writeBits(uint32(clLengths[codeLengthOrder[i]]), 3)
+	}
+	bw.writeBits(0, 1) // 码长数量等于字母表大小
+
+	clCode := newPrefixCode(clLengths)
+	single := usedSymbols(clLengths) == 1
+	for _, t := range tokens {
+		// 只有一个码长符号时该符号编码长度为 0
+		if !single {
+			clCode.write(bw, t.symbol)
+		}
+		if t.bits > 0 {
+			bw.writeBits(t.extra, t.bits)
+		}
+	}
+}
+
+func usedSymbols(lengths []uint8) int {
+	n := 0
+	for _, l := range lengths {
+		if l > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// newPrefixCode 按码长生成规范前缀码（与 DEFLATE 相同的分配方式）
+func newPrefixCode(lengths []uint8) *prefixCode {
+	var count [maxCodeLength + 1]int
+	for _, l := range lengths {
+		if l > 0 {
+			count[l]++
+		}
+	}
+	var next [maxCodeLength + 2]int
+	code := 0
+	for bits := 1; bits <= maxCodeLength; bits++ {
+		code = (code + count[bits-1]) << 1
+		next[bits] = code
+	}
+
+	codes := make([]uint16, len(lengths))
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		codes[s] = reverseBits(uint16(next[l]), l)
+		next[l]++
+	}
+	return &prefixCode{lengths: lengths, codes: codes}
+}
+
+func reverseBits(v uint16, n uint8) uint16 {
+	var r uint16
+	for i := uint8(0); i < n; i++ {
+		r = r<<1 | v&1
+		v >>= 1
+	}
+	return r
+}
+
+// huffmanLengths 计算不超过 limit 的霍夫曼码长
+// 超过上限时抬高低频符号的频次后重新计算；只有一个符号时码长为 1
+func huffmanLengths(freq []uint32, limit int) []uint8 {
+	lengths := make([]uint8, len(freq))
+	var symbols []int
+	for s, f := range freq {
+		if f > 0 {
+			symbols = append(symbols, s)
+		}
+	}
+	switch len(symbols) {
+	case 0:
+		return lengths
+	case 1:
+		lengths[symbols[0]] = 1
+		return lengths
+	}
+
+	weights := make([]uint32, len(freq))
+	copy(weights, freq)
+	for floor := uint32(1); ; floor *= 2 {
+		for _, s := range symbols {
+			if weights[s] < floor {
+				weights[s] = floor
+			}
+		}
+		if buildHuffman(weights, symbols, lengths) <= limit {
+			return lengths
+		}
+	}
+}
+
+type huffmanNode struct {
+	weight      uint64
+	symbol      int // 叶子节点的符号，内部节点为 -1
+	left, right int
+}
+
+// buildHuffman 构建霍夫曼树并写入码长，返回最大码长
+func buildHuffman(weights []uint32, symbols []int, lengths []uint8) int {
+	nodes := make([]huffmanNode, 0, 2*len(symbols))
+	queue := make([]int, 0, len(symbols))
+	for _, s := range symbols {
+		nodes = append(nodes, huffmanNode{weight: uint64(weights[s]), symbol: s, left: -1, right: -1})
+		queue = append(queue, len(nodes)-1)
+	}
+
+	for len(queue) > 1 {
+		// 权重相同时按节点创建顺序，保证结果确定
+		sort.SliceStable(queue, func(i, j int) bool {
+			return nodes[queue[i]].weight < nodes[queue[j]].weight
+		})
+		a, b := queue[0], queue[1]
+		nodes = append(nodes, huffmanNode{weight: nodes[a].weight + nodes[b].weight, symbol: -1, left: a, right: b})
+		queue = append(queue[2:], len(nodes)-1)
+	}
+
+	maxDepth := 0
+	var walk func(i, depth int)
+	walk = func(i, depth int) {
+		n := nodes[i]
+		if n.symbol >= 0 {
+			lengths[n.symbol] = uint8(min(depth, 255))
+			maxDepth = max(maxDepth, depth)
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk(queue[0], 0)
+	return maxDepth
+}
+
+// bitWriter 低位优先的位写入器
+type bitWriter struct {
+	buf  []byte
+	acc  uint64
+	bits uint
+}
+
+func (w *bitWriter) writeBits(v uint32, n uint) {
+	w.acc |= uint64(v&(1<<n-1)) << w.bits
+	w.bits += n
+	for w.bits >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.bits -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.bits > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.bits = 0, 0
+	}
+	return w.buf
+}
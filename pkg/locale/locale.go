@@ -0,0 +1,171 @@
+package locale
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Normalize 规范化语言标签：语言小写、地区大写，下划线视为连字符（如 zh_cn -> zh-CN）
+// 无法识别的标签返回空字符串
+func Normalize(tag string) string {
+	tag = strings.TrimSpace(strings.ReplaceAll(tag, "_", "-"))
+	if tag == "" || tag == "*" {
+		return ""
+	}
+
+	parts := strings.Split(tag, "-")
+	for i, part := range parts {
+		if part == "" || !isAlphanumeric(part) {
+			return ""
+		}
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(part)
+		case len(part) == 2:
+			parts[i] = strings.ToUpper(part)
+		case len(part) == 4:
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		default:
+			parts[i] = strings.ToLower(part)
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// Base 返回语言标签的主语言部分（如 zh-CN -> zh）
+func Base(tag string) string {
+	if i := strings.IndexByte(tag, '-'); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Preference Accept-Language 中的一项语言偏好
+type Preference struct {
+	Tag     string
+	Quality float64
+}
+
+// ParseAcceptLanguage 解析 Accept-Language 请求头，按权重降序返回（权重相同保持原有顺序）
+// 忽略通配符、无法识别的标签及权重为 0 的项
+func ParseAcceptLanguage(header string) []Preference {
+	var prefs []Preference
+	for _, item := range strings.Split(header, ",") {
+		fields := strings.Split(item, ";")
+		tag := Normalize(fields[0])
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			q, err := strconv.ParseFloat(param[2:], 64)
+			if err != nil {
+				q = 0
+			}
+			quality = q
+		}
+		if quality <= 0 {
+			continue
+		}
+		prefs = append(prefs, Preference{Tag: tag, Quality: quality})
+	}
+
+	sort.SliceStable(prefs, func(i, j int) bool {
+		return prefs[i].Quality > prefs[j].Quality
+	})
+	return prefs
+}
+
+// Negotiator 语言协商器：从客户端偏好中选出服务端支持的语言
+type Negotiator struct {
+	defaultLocale string
+	supported     []string
+	index         map[string]string // 规范化标签 -> 支持的语言
+}
+
+// NewNegotiator 创建语言协商器
+// 默认语言始终视为支持的语言；defaultLocale 为空时使用 zh-CN
+func NewNegotiator(defaultLocale string, supported []string) *Negotiator {
+	n := &Negotiator{defaultLocale: Normalize(defaultLocale), index: make(map[string]string)}
+	if n.defaultLocale == "" {
+		n.defaultLocale = "zh-CN"
+	}
+
+	n.add(n.defaultLocale)
+	for _, tag := range supported {
+		n.add(Normalize(tag))
+	}
+	return n
+}
+
+func (n *Negotiator) add(tag string) {
+	if tag == "" {
+		return
+	}
+	if _, ok := n.index[tag]; ok {
+		return
+	}
+	n.index[tag] = tag
+	n.supported = append(n.supported, tag)
+}
+
+// Default 默认语言
+func (n *Negotiator) Default() string {
+	return n.defaultLocale
+}
+
+// Supported 支持的语言列表（默认语言在前）
+func (n *Negotiator) Supported() []string {
+	return append([]string{}, n.supported...)
+}
+
+// Match 返回与标签匹配的支持语言，未匹配时返回空字符串
+// 优先完全匹配，其次按主语言匹配（如 en-GB 匹配 en，zh 匹配 zh-CN）
+func (n *Negotiator) Match(tag string) string {
+	tag = Normalize(tag)
+	if tag == "" {
+		return ""
+	}
+	if matched, ok := n.index[tag]; ok {
+		return matched
+	}
+
+	base := Base(tag)
+	if matched, ok := n.index[base]; ok {
+		return matched
+	}
+	for _, candidate := range n.supported {
+		if Base(candidate) == base {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// Negotiate 协商响应语言：显式指定的 lang 优先，其次按 Accept-Language 的权重顺序，均不支持时使用默认语言
+func (n *Negotiator) Negotiate(acceptLanguage, lang string) string {
+	if matched := n.Match(lang); matched != "" {
+		return matched
+	}
+	for _, pref := range ParseAcceptLanguage(acceptLanguage) {
+		if matched := n.Match(pref.Tag); matched != "" {
+			return matched
+		}
+	}
+	return n.defaultLocale
+}
@@ -11,21 +11,25 @@ import (
 
 // Consumer RabbitMQ 消费者
 type Consumer struct {
-	queueName string
-	handler   consumer.ConsumerHandler
-	conn      *amqp.Connection
-	done      chan struct{}
-	log       *zap.Logger
+	queueName  string
+	exchange   string
+	routingKey string
+	handler    consumer.ConsumerHandler
+	conn       *amqp.Connection
+	done       chan struct{}
+	log        *zap.Logger
 }
 
 // NewConsumer 创建消费者
-func NewConsumer(conn *amqp.Connection, queueName string, handler consumer.ConsumerHandler, log *zap.Logger) *Consumer {
+func NewConsumer(conn *amqp.Connection, cfg ConsumerConfig, handler consumer.ConsumerHandler, log *zap.Logger) *Consumer {
 	return &Consumer{
-		queueName: queueName,
-		handler:   handler,
-		conn:      conn,
-		done:      make(chan struct{}),
-		log:       log,
+		queueName:  cfg.Queue,
+		exchange:   cfg.Exchange,
+		routingKey: cfg.RoutingKey,
+		handler:    handler,
+		conn:       conn,
+		done:       make(chan struct{}),
+		log:        log,
 	}
 }
 
@@ -64,18 +68,38 @@ func (c *Consumer) consume() error {
 		return err
 	}
 
+	// 队列名为空时由服务端生成独占临时队列（每个实例各自接收一份消息，断开后自动删除）
+	temporary := c.queueName == ""
 	q, err := ch.QueueDeclare(
 		c.queueName,
-		true,  // durable
-		false, // autoDelete
-		false, // exclusive
-		false, // noWait
-		nil,   // args
+		!temporary, // durable
+		temporary,  // autoDelete
+		temporary,  // exclusive
+		false,      // noWait
+		nil,        // args
 	)
 	if err != nil {
 		return err
 	}
 
+	// 绑定交换机
+	if c.exchange != "" {
+		if err := ch.ExchangeDeclare(
+			c.exchange,
+			amqp.ExchangeTopic,
+			true,  // durable
+			false, // autoDelete
+			false, // internal
+			false, // noWait
+			nil,   // args
+		); err != nil {
+			return err
+		}
+		if err := ch.QueueBind(q.Name, c.routingKey, c.exchange, false, nil); err != nil {
+			return err
+		}
+	}
+
 	msgs, err := ch.Consume(
 		q.Name,
 		"",    // consumer
@@ -89,7 +113,7 @@ func (c *Consumer) consume() error {
 		return err
 	}
 
-	c.log.Debug("consuming from queue", zap.String("queue", c.queueName))
+	c.log.Debug("consuming from queue", zap.String("queue", q.Name), zap.String("exchange", c.exchange))
 
 	for {
 		select {
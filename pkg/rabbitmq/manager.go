@@ -23,7 +23,9 @@ type Config struct {
 
 // ConsumerConfig 消费者配置
 type ConsumerConfig struct {
-	Queue       string
+	Queue       string // 队列名，为空时使用服务端生成的独占临时队列
+	Exchange    string // 绑定的 topic 交换机，为空时直接消费队列
+	RoutingKey  string // 绑定的 routing key（支持 * / # 通配符）
 	Handler     string
 	Concurrency int
 }
@@ -103,7 +105,7 @@ func (m *Manager) startConsumers() {
 		}
 
 		for i := 0; i < cfg.Concurrency; i++ {
-			c := NewConsumer(m.conn, cfg.Queue, handler, m.log)
+			c := NewConsumer(m.conn, cfg, handler, m.log)
 			m.activeConsumer = append(m.activeConsumer, c)
 			m.wg.Add(1)
 			go func(consumer *Consumer) {
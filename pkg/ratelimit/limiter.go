@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Limiter 固定窗口限流器：每个 key 在一个窗口内最多允许 limit 次操作
+type Limiter interface {
+	// Allow 记录一次操作，超出限额时返回 false
+	Allow(key string) (bool, error)
+}
+
+// ================================
+// Redis 实现（多实例共享计数）
+// ================================
+
+// incrScript 计数 +1，首次计数时设置窗口过期时间（保证 INCR 与 PEXPIRE 原子执行）
+var incrScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
+type redisLimiter struct {
+	client *redis.Client
+	prefix string
+	limit  int
+	window time.Duration
+}
+
+// NewRedisLimiter 创建基于 Redis 计数的限流器，prefix 为键名前缀
+func NewRedisLimiter(client *redis.Client, prefix string, limit int, window time.Duration) Limiter {
+	return &redisLimiter{client: client, prefix: prefix, limit: limit, window: window}
+}
+
+func (l *redisLimiter) Allow(key string) (bool, error) {
+	n, err := incrScript.Run(context.Background(), l.client,
+		[]string{l.prefix + key}, l.window.Milliseconds()).Int64()
+	if err != nil {
+		return false, err
+	}
+	return n <= int64(l.limit), nil
+}
+
+// ================================
+// 内存实现（单实例，未配置 Redis 时使用）
+// ================================
+
+type memoryWindow struct {
+	start time.Time
+	count int
+}
+
+type memoryLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	window  time.Duration
+	windows map[string]*memoryWindow
+	now     func() time.Time
+}
+
+// NewMemoryLimiter 创建进程内限流器（计数不在多个实例间共享）
+func NewMemoryLimiter(limit int, window time.Duration) Limiter {
+	return &memoryLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]*memoryWindow),
+		now:     time.Now,
+	}
+}
+
+func (l *memoryLimiter) Allow(key string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		l.evict(now)
+		w = &memoryWindow{start: now}
+		l.windows[key] = w
+	}
+	w.count++
+	return w.count <= l.limit, nil
+}
+
+// evict 清理已过期的窗口，避免 key 无限增长
+func (l *memoryLimiter) evict(now time.Time) {
+	for key, w := range l.windows {
+		if now.Sub(w.start) >= l.window {
+			delete(l.windows, key)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+)
+
+const (
+	highlightPreTag  = "<em>"
+	highlightPostTag = "</em>"
+	ellipsis         = "..."
+)
+
+// Highlight 生成高亮片段
+// 以第一个命中位置为中心截取 maxLen 个字符（maxLen <= 0 表示不截取），命中词用 <em></em> 包裹，
+// 其余文本做 HTML 转义；未命中任何词时返回空字符串
+func Highlight(text string, terms []string, maxLen int) string {
+	if text == "" || len(terms) == 0 {
+		return ""
+	}
+
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		// 极少数字符大小写转换后长度变化，退化为原文匹配
+		lower = runes
+	}
+
+	ranges := matchRanges(lower, terms)
+	if len(ranges) == 0 {
+		return ""
+	}
+
+	// 计算截取窗口
+	start, end := 0, len(runes)
+	if maxLen > 0 && len(runes) > maxLen {
+		start = ranges[0][0] - maxLen/4
+		if start < 0 {
+			start = 0
+		}
+		end = start + maxLen
+		if end > len(runes) {
+			end = len(runes)
+			start = end - maxLen
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString(ellipsis)
+	}
+	pos := start
+	for _, r := range ranges {
+		if r[1] <= start || r[0] >= end {
+			continue
+		}
+		from, to := max(r[0], start), min(r[1], end)
+		b.WriteString(html.EscapeString(string(runes[pos:from])))
+		b.WriteString(highlightPreTag)
+		b.WriteString(html.EscapeString(string(runes[from:to])))
+		b.WriteString(highlightPostTag)
+		pos = to
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString(ellipsis)
+	}
+
+	return b.String()
+}
+
+// matchRanges 查找所有词项的命中区间（按字符下标），并合并重叠或相邻的区间
+func matchRanges(text []rune, terms []string) [][2]int {
+	var ranges [][2]int
+	for _, term := range terms {
+		t := []rune(term)
+		if len(t) == 0 || len(t) > len(text) {
+			continue
+		}
+		for i := 0; i+len(t) <= len(text); i++ {
+			if string(text[i:i+len(t)]) == term {
+				ranges = append(ranges, [2]int{i, i + len(t)})
+			}
+		}
+	}
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r[0] <= last[1] {
+			if r[1] > last[1] {
+				last[1] = r[1]
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
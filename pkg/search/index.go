@@ -0,0 +1,153 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Hit 检索命中结果
+type Hit struct {
+	ID    uint
+	Score float64
+}
+
+// Index 内存倒排索引（并发安全）
+// 适用于 SQLite / 测试等没有全文索引能力的环境
+type Index struct {
+	weights  map[string]float64                 // 字段权重
+	postings map[string]map[uint]map[string]int // term -> 文档ID -> 字段 -> 词频
+	docs     map[uint]map[string][]string       // 文档ID -> 字段 -> 词项（用于删除）
+	mu       sync.RWMutex
+}
+
+// NewIndex 创建倒排索引，weights 为各字段的相关度权重，未配置的字段权重为 1
+func NewIndex(weights map[string]float64) *Index {
+	return &Index{
+		weights:  weights,
+		postings: make(map[string]map[uint]map[string]int),
+		docs:     make(map[uint]map[string][]string),
+	}
+}
+
+// Add 添加或替换文档
+func (idx *Index) Add(id uint, fields map[string]string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(id)
+
+	doc := make(map[string][]string, len(fields))
+	for field, text := range fields {
+		tokens := Tokenize(text)
+		if len(tokens) == 0 {
+			continue
+		}
+		doc[field] = tokens
+		for _, token := range tokens {
+			posting, ok := idx.postings[token]
+			if !ok {
+				posting = make(map[uint]map[string]int)
+				idx.postings[token] = posting
+			}
+			tf, ok := posting[id]
+			if !ok {
+				tf = make(map[string]int)
+				posting[id] = tf
+			}
+			tf[field]++
+		}
+	}
+	idx.docs[id] = doc
+}
+
+// Remove 删除文档
+func (idx *Index) Remove(id uint) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+// Reset 清空索引
+func (idx *Index) Reset() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.postings = make(map[string]map[uint]map[string]int)
+	idx.docs = make(map[uint]map[string][]string)
+}
+
+// Len 返回已索引的文档数
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Search 按关键词检索，结果按相关度降序排列（相同分数按 ID 升序），limit <= 0 表示不限制
+// 相关度 = Σ 字段权重 × 饱和词频 × IDF
+func (idx *Index) Search(query string, limit int) []Hit {
+	terms := Terms(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	total := float64(len(idx.docs))
+	scores := make(map[uint]float64)
+	for _, term := range terms {
+		posting := idx.postings[term]
+		if len(posting) == 0 {
+			continue
+		}
+		idf := math.Log(1 + total/float64(len(posting)))
+		for id, fields := range posting {
+			for field, tf := range fields {
+				scores[id] += idx.weight(field) * (float64(tf) / float64(tf+1)) * idf
+			}
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// remove 删除文档（调用方需持有写锁）
+func (idx *Index) remove(id uint) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	for _, tokens := range doc {
+		for _, token := range tokens {
+			posting := idx.postings[token]
+			delete(posting, id)
+			if len(posting) == 0 {
+				delete(idx.postings, token)
+			}
+		}
+	}
+	delete(idx.docs, id)
+}
+
+// weight 获取字段权重
+func (idx *Index) weight(field string) float64 {
+	if w, ok := idx.weights[field]; ok {
+		return w
+	}
+	return 1
+}
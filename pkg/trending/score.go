@@ -0,0 +1,72 @@
+package trending
+
+import (
+	"math"
+	"time"
+)
+
+// Options 热度计算参数
+type Options struct {
+	Window         int     // 统计窗口（天），窗口外的计数不参与计算
+	HalfLife       float64 // 半衰期（天），计数每经过一个半衰期权重减半
+	DownloadWeight float64 // 单次下载的权重
+	ViewWeight     float64 // 单次浏览的权重
+}
+
+// WithDefaults 填充默认值（下载权重远高于浏览）
+func (o Options) WithDefaults() Options {
+	if o.Window <= 0 {
+		o.Window = 14
+	}
+	if o.HalfLife <= 0 {
+		o.HalfLife = 3
+	}
+	if o.DownloadWeight <= 0 {
+		o.DownloadWeight = 1
+	}
+	if o.ViewWeight <= 0 {
+		o.ViewWeight = 0.1
+	}
+	return o
+}
+
+// Bucket 单个 Mod 某一天的计数
+type Bucket struct {
+	Date      time.Time
+	Downloads int64
+	Views     int64
+}
+
+// Since 统计窗口的起始日期（含）
+func (o Options) Since(now time.Time) time.Time {
+	return Day(now).AddDate(0, 0, -(o.Window - 1))
+}
+
+// Score 计算热度分：每日加权计数按距今天数指数衰减后求和
+// 当天的计数权重为 1，窗口外及未来日期的计数被忽略
+func (o Options) Score(buckets []Bucket, now time.Time) float64 {
+	today := Day(now)
+	var score float64
+	for _, b := range buckets {
+		age := daysBetween(Day(b.Date), today)
+		if age < 0 || age >= o.Window {
+			continue
+		}
+		weighted := float64(b.Downloads)*o.DownloadWeight + float64(b.Views)*o.ViewWeight
+		score += weighted * math.Pow(0.5, float64(age)/o.HalfLife)
+	}
+	return score
+}
+
+// Day 截断到当天零点（本地时区）
+func Day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// daysBetween 两个零点之间相差的天数（按日历日计算，不受夏令时影响）
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
@@ -0,0 +1,125 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Rule 自定义验证规则
+// 通过 Field、CrossField、Struct 创建，注册到验证器后即可在 binding 标签中引用
+type Rule interface {
+	Register(v *validator.Validate) error
+}
+
+// ================================
+// 字段规则
+// ================================
+
+type fieldRule struct {
+	tag        string
+	fn         validator.Func
+	callIfNull bool
+}
+
+// Field 字段验证规则，tag 为 binding 标签中的规则名（如 binding:"mobile"）
+func Field(tag string, fn validator.Func) Rule {
+	return fieldRule{tag: tag, fn: fn}
+}
+
+// FieldIfNull 字段为零值时也会执行的字段验证规则（如 required_xxx 类规则）
+func FieldIfNull(tag string, fn validator.Func) Rule {
+	return fieldRule{tag: tag, fn: fn, callIfNull: true}
+}
+
+func (r fieldRule) Register(v *validator.Validate) error {
+	if err := v.RegisterValidation(r.tag, r.fn, r.callIfNull); err != nil {
+		return fmt.Errorf("register validation %q: %w", r.tag, err)
+	}
+	return nil
+}
+
+// CrossField 跨字段验证规则，规则参数为同一结构体中另一个字段的名称（如 binding:"after=From"）
+// fn 接收当前字段与参数字段的值；参数字段不存在时验证失败
+func CrossField(tag string, fn func(field, other reflect.Value) bool) Rule {
+	return fieldRule{tag: tag, fn: func(fl validator.FieldLevel) bool {
+		other, _, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+		if !ok {
+			return false
+		}
+		return fn(fl.Field(), other)
+	}}
+}
+
+// ================================
+// 结构体规则
+// ================================
+
+type structRule struct {
+	fn    validator.StructLevelFunc
+	types []interface{}
+}
+
+// Struct 结构体级验证规则，对 types 中的结构体整体校验（字段间的组合约束）
+// fn 中通过 sl.ReportError 报告未通过验证的字段
+func Struct(fn validator.StructLevelFunc, types ...interface{}) Rule {
+	return structRule{fn: fn, types: types}
+}
+
+func (r structRule) Register(v *validator.Validate) error {
+	if len(r.types) == 0 {
+		return fmt.Errorf("register struct validation: no struct types")
+	}
+	v.RegisterStructValidation(r.fn, r.types...)
+	return nil
+}
+
+// ================================
+// 启动检查
+// ================================
+
+// CheckTags 检查结构体（及其嵌套的结构体）的验证标签是否都已注册
+// 验证器在首次验证时才解析标签，遇到未注册的规则会 panic；启动时提前检查，使用了未知规则时返回错误
+func CheckTags(v *validator.Validate, values ...interface{}) error {
+	seen := make(map[reflect.Type]bool)
+	for _, value := range values {
+		if err := checkType(v, reflect.TypeOf(value), seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkType(v *validator.Validate, t reflect.Type, seen map[reflect.Type]bool) (err error) {
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct || seen[t] {
+		return nil
+	}
+	seen[t] = true
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%s: %v", t, r)
+			}
+		}()
+		// 只关心标签能否解析，零值的验证结果忽略
+		_ = v.Struct(reflect.New(t).Interface())
+	}()
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		if err := checkType(v, t.Field(i).Type, seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package version
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidConstraint 无法解析的版本约束
+var ErrInvalidConstraint = errors.New("invalid version constraint")
+
+// operators 支持的运算符（长的在前，避免 >= 被识别为 >、~> 被识别为 ~）
+var operators = []string{"~>", ">=", "<=", "!=", "==", ">", "<", "=", "~", "^"}
+
+type term struct {
+	op      string
+	version Version
+}
+
+// Constraint 版本约束
+// 以逗号（或空格）分隔的条件需同时满足（如 ">=2.2, <3"），以 || 分隔的多组条件满足任一组即可；
+// 支持 ~1.2（>=1.2, <1.3）、^1.2（>=1.2, <2）、~>1.2（>=1.2, <2）、1.2.x / 1.2.*（>=1.2, <1.3）与 1.2 - 1.4（>=1.2, <1.5）
+type Constraint struct {
+	groups [][]term
+	raw    string
+}
+
+// ParseConstraint 解析版本约束，空字符串或 * 表示不限制版本
+func ParseConstraint(s string) (Constraint, error) {
+	c := Constraint{raw: strings.TrimSpace(s)}
+	if c.raw == "" {
+		return c, nil
+	}
+
+	for _, group := range strings.Split(c.raw, "||") {
+		if strings.TrimSpace(group) == "" {
+			return Constraint{}, ErrInvalidConstraint
+		}
+		var terms []term
+		for _, part := range strings.Split(group, ",") {
+			parsed, err := parseTerms(part)
+			if err != nil {
+				return Constraint{}, err
+			}
+			terms = append(terms, parsed...)
+		}
+		c.groups = append(c.groups, terms)
+	}
+	return c, nil
+}
+
+// parseTerms 解析逗号分隔的一段约束（其中可包含以空格分隔的多个条件或一个 A - B 区间）
+func parseTerms(part string) ([]term, error) {
+	fields := strings.Fields(part)
+	if len(fields) == 0 {
+		return nil, ErrInvalidConstraint
+	}
+	if len(fields) == 3 && fields[1] == "-" {
+		return parseHyphenRange(fields[0], fields[2])
+	}
+
+	var terms []term
+	for i := 0; i < len(fields); i++ {
+		op, rest := splitOperator(fields[i])
+		// 运算符与版本号之间允许空格（如 "> 1"）
+		if rest == "" && op != "" && i+1 < len(fields) {
+			i++
+			rest = fields[i]
+		}
+		expanded, err := expandTerm(op, rest)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, expanded...)
+	}
+	return terms, nil
+}
+
+func splitOperator(s string) (string, string) {
+	for _, candidate := range operators {
+		if strings.HasPrefix(s, candidate) {
+			return candidate, s[len(candidate):]
+		}
+	}
+	return "", s
+}
+
+// expandTerm 将单个条件展开为基本比较
+func expandTerm(op, s string) ([]term, error) {
+	v, n, wildcard, err := parsePartial(s)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "", "=", "==":
+		if wildcard {
+			return wildcardRange(v, n), nil
+		}
+		return []term{{op: "=", version: v}}, nil
+	case "!=":
+		if wildcard {
+			return nil, ErrInvalidConstraint
+		}
+		return []term{{op: op, version: v}}, nil
+	case ">=", "<=", ">", "<":
+		if n == 0 {
+			return nil, ErrInvalidConstraint
+		}
+		if wildcard && (op == ">" || op == "<=") {
+			// >1.2.x 即 >=1.3，<=1.2.x 即 <1.3
+			upper := bump(v, n-1)
+			if op == ">" {
+				return []term{{op: ">=", version: upper}}, nil
+			}
+			return []term{{op: "<", version: upper}}, nil
+		}
+		return []term{{op: op, version: v}}, nil
+	case "~":
+		if n == 0 {
+			return nil, ErrInvalidConstraint
+		}
+		// ~1.2.3 与 ~1.2 只允许修订号变化，~1 只允许次版本号变化
+		return []term{{op: ">=", version: v}, {op: "<", version: bump(v, min(n, 2)-1)}}, nil
+	case "~>":
+		if n == 0 {
+			return nil, ErrInvalidConstraint
+		}
+		// 只允许最后一段变化（~>2.2 即 >=2.2, <3；~>2.2.0 即 >=2.2.0, <2.3）
+		return []term{{op: ">=", version: v}, {op: "<", version: bump(v, max(n-1, 1)-1)}}, nil
+	case "^":
+		if n == 0 {
+			return nil, ErrInvalidConstraint
+		}
+		// 不允许第一个非零段变化（^1.2 即 <2，^0.2.3 即 <0.3）
+		i := 0
+		for i < n-1 && v.segment(i) == 0 {
+			i++
+		}
+		return []term{{op: ">=", version: v}, {op: "<", version: bump(v, i)}}, nil
+	}
+	return nil, ErrInvalidConstraint
+}
+
+// parseHyphenRange 解析 A - B 区间（B 省略的段视为通配，如 1.2 - 1.4 即 >=1.2, <1.5）
+func parseHyphenRange(from, to string) ([]term, error) {
+	lower, n, _, err := parsePartial(from)
+	if err != nil || n == 0 {
+		return nil, ErrInvalidConstraint
+	}
+	upper, m, _, err := parsePartial(to)
+	if err != nil || m == 0 {
+		return nil, ErrInvalidConstraint
+	}
+
+	terms := []term{{op: ">=", version: lower}}
+	if m < 3 {
+		return append(terms, term{op: "<", version: bump(upper, m-1)}), nil
+	}
+	return append(terms, term{op: "<=", version: upper}), nil
+}
+
+// parsePartial 解析约束中的版本号，返回版本、给出的数字段数与是否以通配符（x、X、*）结尾
+// 单独的通配符返回 0 个数字段
+func parsePartial(s string) (Version, int, bool, error) {
+	if s == "" {
+		return Version{}, 0, false, ErrInvalidConstraint
+	}
+
+	parts := strings.Split(s, ".")
+	for i, part := range parts {
+		if part != "x" && part != "X" && part != "*" {
+			continue
+		}
+		for _, rest := range parts[i:] {
+			if rest != "x" && rest != "X" && rest != "*" {
+				return Version{}, 0, false, ErrInvalidConstraint
+			}
+		}
+		if i == 0 {
+			return Version{}, 0, true, nil
+		}
+		v, err := Parse(strings.Join(parts[:i], "."))
+		if err != nil || len(v.segments) != i || v.IsPrerelease() || v.build != "" {
+			return Version{}, 0, false, ErrInvalidConstraint
+		}
+		return v, i, true, nil
+	}
+
+	v, err := Parse(s)
+	if err != nil {
+		return Version{}, 0, false, ErrInvalidConstraint
+	}
+	return v, len(v.segments), false, nil
+}
+
+// wildcardRange 通配版本对应的区间（1.2.x 即 >=1.2, <1.3），单独的通配符不限制版本
+func wildcardRange(v Version, n int) []term {
+	if n == 0 {
+		return nil
+	}
+	return []term{{op: ">=", version: v}, {op: "<", version: bump(v, n-1)}}
+}
+
+// bump 将第 i 段加一并去掉其后的段（如 bump(1.2.3, 1) 为 1.3）
+func bump(v Version, i int) Version {
+	segments := make([]int, i+1)
+	for j := range segments {
+		segments[j] = v.segment(j)
+	}
+	segments[i]++
+	return Version{segments: segments}
+}
+
+// Check 检查版本是否满足约束
+// 预发布版本不满足以对应正式版本为上限的 < 条件（如 3.0-beta 不满足 <3）
+func (c Constraint) Check(v Version) bool {
+	if len(c.groups) == 0 {
+		return true
+	}
+	for _, terms := range c.groups {
+		if checkTerms(terms, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkTerms(terms []term, v Version) bool {
+	for _, t := range terms {
+		cmp := v.Compare(t.version)
+		var ok bool
+		switch t.op {
+		case ">=":
+			ok = cmp >= 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case "<":
+			ok = cmp < 0 && !(v.IsPrerelease() && !t.version.IsPrerelease() && v.compareCore(t.version) == 0)
+		case "!=":
+			ok = cmp != 0
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// String 返回原始约束字符串
+func (c Constraint) String() string {
+	return c.raw
+}
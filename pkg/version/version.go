@@ -0,0 +1,331 @@
+package version
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidVersion 无法解析的版本号
+var ErrInvalidVersion = errors.New("invalid version")
+
+// prereleaseKeywords 识别为预发布版本的关键字及其先后顺序（同一顺序的关键字视为相同阶段）
+var prereleaseKeywords = map[string]int{
+	"dev":      0,
+	"snapshot": 0,
+	"alpha":    1,
+	"beta":     2,
+	"pre":      3,
+	"preview":  3,
+	"rc":       4,
+}
+
+// shortPrerelease 后面紧跟数字时识别为预发布版本的单字母缩写（如 1.0b2）
+var shortPrerelease = map[byte]string{'a': "alpha", 'b': "beta", 'c': "rc"}
+
+// Version 版本号，由若干数字段、可选的预发布标识与附加信息组成
+// 规范化形式与语义化版本一致（如 5.2SE → 5.2.0+SE，2.0-beta2 → 2.0.0-beta.2）
+type Version struct {
+	segments   []int
+	prerelease []string
+	build      string
+	raw        string
+}
+
+// Parse 宽松地解析版本号
+// 允许 v 前缀与任意数量的数字段（如 11.6.0.1018）；数字段之后以 dev、snapshot、alpha、beta、pre、preview、rc
+// （或后跟数字的 a、b、c）开头的后缀视为预发布版本，其余后缀视为附加信息，不参与比较（如 5.2SE）
+func Parse(s string) (Version, error) {
+	raw := strings.TrimSpace(s)
+	str := strings.TrimPrefix(strings.TrimPrefix(raw, "v"), "V")
+
+	var segments []int
+	for len(str) > 0 {
+		end := 0
+		for end < len(str) && isDigit(str[end]) {
+			end++
+		}
+		if end == 0 {
+			break
+		}
+		n, err := strconv.Atoi(str[:end])
+		if err != nil {
+			return Version{}, ErrInvalidVersion
+		}
+		segments = append(segments, n)
+
+		str = str[end:]
+		if len(str) < 2 || str[0] != '.' || !isDigit(str[1]) {
+			break
+		}
+		str = str[1:]
+	}
+
+	if len(segments) == 0 {
+		return Version{}, ErrInvalidVersion
+	}
+
+	v := Version{segments: segments, raw: raw}
+	if i := strings.IndexByte(str, '+'); i >= 0 {
+		v.build = str[i+1:]
+		str = str[:i]
+	}
+	v.prerelease, str = parsePrerelease(str)
+	v.build = sanitizeBuild(strings.TrimSpace(str + " " + v.build))
+	return v, nil
+}
+
+// MustParse 解析版本号，失败时 panic（用于常量版本号）
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// parsePrerelease 解析数字段之后的预发布标识，返回标识与剩余的后缀
+func parsePrerelease(s string) ([]string, string) {
+	body := strings.TrimLeft(s, "-_. ")
+	lower := asciiLower(body)
+
+	keyword := ""
+	for k := range prereleaseKeywords {
+		if strings.HasPrefix(lower, k) && len(k) > len(keyword) {
+			keyword = k
+		}
+	}
+	if keyword == "" && len(lower) >= 2 && isDigit(lower[1]) {
+		if full, ok := shortPrerelease[lower[0]]; ok {
+			keyword = full
+			body = full + body[1:]
+			lower = full + lower[1:]
+		}
+	}
+	if keyword == "" {
+		return nil, s
+	}
+	// 关键字须是完整的单词（如 5.2Beta 是，5.2Betamax 不是）
+	rest := lower[len(keyword):]
+	if rest != "" && !isDigit(rest[0]) && !strings.ContainsRune("-_. ", rune(rest[0])) {
+		return nil, s
+	}
+
+	identifiers := []string{keyword}
+	rest = strings.TrimLeft(rest, "-_. ")
+	end := 0
+	for end < len(rest) && (isDigit(rest[end]) || rest[end] == '.') {
+		end++
+	}
+	for _, part := range strings.Split(rest[:end], ".") {
+		if n, err := strconv.Atoi(part); err == nil {
+			identifiers = append(identifiers, strconv.Itoa(n))
+		}
+	}
+	return identifiers, body[len(body)-len(rest)+end:]
+}
+
+// sanitizeBuild 将附加信息整理为语义化版本允许的字符（字母、数字、点与连字符）
+func sanitizeBuild(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range s {
+		if (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '.' {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.Trim(b.String(), "-.")
+}
+
+// Compare 比较版本号，v < o 返回 -1，相等返回 0，v > o 返回 1
+// 缺失的段视为 0（1.2 == 1.2.0），预发布版本早于对应的正式版本（2.0-rc1 < 2.0），附加信息不参与比较（5.2SE == 5.2）
+func (v Version) Compare(o Version) int {
+	if c := v.compareCore(o); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.prerelease, o.prerelease)
+}
+
+// NewerThan 是否比 o 新
+func (v Version) NewerThan(o Version) bool {
+	return v.Compare(o) > 0
+}
+
+// IsPrerelease 是否为预发布版本
+func (v Version) IsPrerelease() bool {
+	return len(v.prerelease) > 0
+}
+
+// Prerelease 返回规范化的预发布标识（如 beta.2），正式版本返回空字符串
+func (v Version) Prerelease() string {
+	return strings.Join(v.prerelease, ".")
+}
+
+// Build 返回附加信息（如 5.2SE 的 SE）
+func (v Version) Build() string {
+	return v.build
+}
+
+// Major 主版本号
+func (v Version) Major() int {
+	return v.segment(0)
+}
+
+// Minor 次版本号
+func (v Version) Minor() int {
+	return v.segment(1)
+}
+
+// Patch 修订号
+func (v Version) Patch() int {
+	return v.segment(2)
+}
+
+// Normalized 返回规范化的版本字符串：至少三个数字段，预发布标识以 - 连接，附加信息以 + 连接
+func (v Version) Normalized() string {
+	n := max(len(v.segments), 3)
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = strconv.Itoa(v.segment(i))
+	}
+
+	s := strings.Join(parts, ".")
+	if v.IsPrerelease() {
+		s += "-" + v.Prerelease()
+	}
+	if v.build != "" {
+		s += "+" + v.build
+	}
+	return s
+}
+
+// String 返回原始版本字符串
+func (v Version) String() string {
+	return v.raw
+}
+
+// Sort 按版本从旧到新排序（相同版本保持原有顺序）
+func Sort(versions []Version) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].Compare(versions[j]) < 0
+	})
+}
+
+// CompareStrings 比较两个版本字符串，无法解析的版本视为早于任何可解析的版本，两者均无法解析时按字符串比较
+func CompareStrings(a, b string) int {
+	va, errA := Parse(a)
+	vb, errB := Parse(b)
+	switch {
+	case errA == nil && errB == nil:
+		return va.Compare(vb)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func (v Version) compareCore(o Version) int {
+	n := max(len(v.segments), len(o.segments))
+	for i := 0; i < n; i++ {
+		a, b := v.segment(i), o.segment(i)
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+	}
+	return 0
+}
+
+func (v Version) segment(i int) int {
+	if i < len(v.segments) {
+		return v.segments[i]
+	}
+	return 0
+}
+
+// comparePrerelease 比较预发布标识：没有标识的正式版本最新；
+// 逐个比较标识，数字按数值比较且早于非数字，已知关键字按阶段比较，其余按字符串比较，标识较少的更早
+func comparePrerelease(a, b []string) int {
+	if len(a) == 0 || len(b) == 0 {
+		return boolToInt(len(a) == 0) - boolToInt(len(b) == 0)
+	}
+
+	for i := 0; i < min(len(a), len(b)); i++ {
+		if c := compareIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareIdentifier(a, b string) int {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return compareInt(na, nb)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+
+	ra, okA := prereleaseKeywords[a]
+	rb, okB := prereleaseKeywords[b]
+	if okA && okB {
+		return compareInt(ra, rb)
+	}
+	return strings.Compare(a, b)
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// asciiLower 只转换 ASCII 字母的小写，保持字节长度不变
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if c >= 'A' && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,57 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/pkg/cache"
+)
+
+func TestMemoryStore_GetSetDelete(t *testing.T) {
+	store := cache.NewMemoryStore()
+	ctx := context.Background()
+
+	_, err := store.Get(ctx, "k")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+
+	require.NoError(t, store.Set(ctx, "k", []byte("v"), 0))
+	value, err := store.Get(ctx, "k")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v"), value)
+
+	require.NoError(t, store.Delete(ctx, "k", "missing"))
+	_, err = store.Get(ctx, "k")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+}
+
+func TestMemoryStore_Expires(t *testing.T) {
+	store := cache.NewMemoryStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Set(ctx, "k", []byte("v"), 10*time.Millisecond))
+	time.Sleep(20 * time.Millisecond)
+
+	_, err := store.Get(ctx, "k")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+}
+
+func TestMemoryStore_Incr(t *testing.T) {
+	store := cache.NewMemoryStore()
+	ctx := context.Background()
+
+	n, err := store.Incr(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	n, err = store.Incr(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	value, err := store.Get(ctx, "gen")
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(value))
+}
@@ -0,0 +1,104 @@
+package catalog_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gin-web/pkg/catalog"
+)
+
+func TestRead_FormatsProduceSameRecords(t *testing.T) {
+	inputs := map[catalog.Format]string{
+		catalog.FormatCSV: "\ufeffname,file_size,categories\n" +
+			"SkyUI,1024,Interface|Graphics\n",
+		catalog.FormatJSON: `[{"name": "SkyUI", "file_size": 1024, "categories": ["Interface", "Graphics"]}]`,
+		catalog.FormatNDJSON: "\n" +
+			`{"name": "SkyUI", "file_size": 1024, "categories": ["Interface", "Graphics"]}` + "\n",
+	}
+
+	for format, input := range inputs {
+		records, err := catalog.Read(strings.NewReader(input), format)
+		assert.NoError(t, err, format)
+		if assert.Len(t, records, 1, format) {
+			assert.Equal(t, "SkyUI", records[0].Get("name"), format)
+			assert.Equal(t, "1024", records[0].Get("file_size"), format)
+			assert.Equal(t, []string{"Interface", "Graphics"}, records[0].List("categories"), format)
+			assert.False(t, records[0].Has("author"), format)
+		}
+	}
+}
+
+func TestRead_RowNumbers(t *testing.T) {
+	records, err := catalog.Read(strings.NewReader("name,description\nA,\"多行\n描述\"\nB,x\n"), catalog.FormatCSV)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4}, []int{records[0].Row, records[1].Row})
+
+	records, err = catalog.Read(strings.NewReader("{\"name\":\"A\"}\n\n{\"name\":\"B\"}\n"), catalog.FormatNDJSON)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, []int{records[0].Row, records[1].Row})
+}
+
+func TestRead_Errors(t *testing.T) {
+	tests := []struct {
+		format catalog.Format
+		input  string
+		row    int
+	}{
+		{catalog.FormatCSV, "name,name\nA,B\n", 1},
+		{catalog.FormatCSV, "name,author\nA\n", 2},
+		{catalog.FormatJSON, `{"name": "A"}`, 0},
+		{catalog.FormatJSON, `[{"name": {"zh": "A"}}]`, 1},
+		{catalog.FormatNDJSON, "{\"name\":\"A\"}\n{broken\n", 2},
+		{catalog.FormatNDJSON, `{"categories": ["a|b"]}`, 1},
+	}
+
+	for _, tt := range tests {
+		_, err := catalog.Read(strings.NewReader(tt.input), tt.format)
+		var pe *catalog.ParseError
+		if assert.True(t, errors.As(err, &pe), tt.input) {
+			assert.Equal(t, tt.row, pe.Row, tt.input)
+		}
+	}
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	columns := []string{"name", "file_size", "rating", "categories"}
+
+	for _, format := range []catalog.Format{catalog.FormatCSV, catalog.FormatJSON, catalog.FormatNDJSON} {
+		var buf bytes.Buffer
+		writer, err := catalog.NewWriter(&buf, format, columns)
+		assert.NoError(t, err)
+		assert.NoError(t, writer.Write("SkyUI, \"SE\"", int64(1024), 4.5, []string{"Interface", "Graphics"}))
+		assert.NoError(t, writer.Write("JEI", int64(0), 0.0, []string{}))
+		assert.NoError(t, writer.Close())
+
+		records, err := catalog.Read(&buf, format)
+		assert.NoError(t, err, format)
+		if assert.Len(t, records, 2, format) {
+			assert.Equal(t, "SkyUI, \"SE\"", records[0].Get("name"), format)
+			assert.Equal(t, "4.5", records[0].Get("rating"), format)
+			assert.Equal(t, []string{"Interface", "Graphics"}, records[0].List("categories"), format)
+			assert.Empty(t, records[1].List("categories"), format)
+		}
+	}
+}
+
+func TestWriter_EmptyJSON(t *testing.T) {
+	var buf bytes.Buffer
+	writer, _ := catalog.NewWriter(&buf, catalog.FormatJSON, []string{"name"})
+	assert.NoError(t, writer.Close())
+	assert.JSONEq(t, "[]", buf.String())
+}
+
+func TestFormatFromFilename(t *testing.T) {
+	format, err := catalog.FormatFromFilename("mods.JSONL")
+	assert.NoError(t, err)
+	assert.Equal(t, catalog.FormatNDJSON, format)
+
+	_, err = catalog.FormatFromFilename("mods.xlsx")
+	assert.ErrorIs(t, err, catalog.ErrUnknownFormat)
+}
@@ -0,0 +1,147 @@
+package dto_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/app/dto"
+	"gin-web/app/messages"
+	"gin-web/app/middleware"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/i18n"
+)
+
+func newMessageBundle(t *testing.T) *i18n.Bundle {
+	t.Helper()
+	bundle := i18n.NewBundle(messages.Source, "zh-CN", []string{"zh-CN", "en"})
+	require.NoError(t, bundle.LoadFS(messages.FS, "."))
+	bundle.AddMessages("en", map[string]string{
+		"saveRequest.Title.required": "Title is required",
+	})
+	require.NoError(t, bundle.RegisterValidator(binding.Validator.Engine().(*validator.Validate)))
+	return bundle
+}
+
+// serve 经过 I18n 中间件处理请求
+func serve(t *testing.T, bundle *i18n.Bundle, acceptLanguage string, handler gin.HandlerFunc) dto.Response {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.I18n(bundle))
+	r.POST("/", handler)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Accept-Language", acceptLanguage)
+	r.ServeHTTP(w, req)
+
+	var resp dto.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestFailByError_Localized(t *testing.T) {
+	bundle := newMessageBundle(t)
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		err            error
+		want           string
+	}{
+		{"默认语言使用代码中的消息", "zh-CN", bizErr.ErrModNotFound, "Mod 不存在"},
+		{"按错误码查找", "en-US,en;q=0.9", bizErr.ErrModNotFound, "Mod not found"},
+		{"同一错误码按消息键区分", "en", bizErr.ErrNotModOwner, "Only the mod author can perform this action"},
+		{"自定义信息回退到错误码", "en", bizErr.New(bizErr.CodeValidationError, "游戏名称格式错误"), "Validation failed"},
+		{"默认语言不回退到错误码", "zh-CN", bizErr.New(bizErr.CodeValidationError, "游戏名称格式错误"), "游戏名称格式错误"},
+		{"预定义错误按消息键查找", "en", bizErr.ErrGameIDInvalid, "Invalid game ID"},
+		{"消息参数", "en", bizErr.ErrDependencyCycle.WithParams(bizErr.Params{"path": "A -> B -> A"}), "Circular dependency detected: A -> B -> A"},
+		{"默认语言的消息参数", "zh-CN", bizErr.ErrDependencyCycle.WithParams(bizErr.Params{"path": "A -> B -> A"}), "存在循环依赖：A -> B -> A"},
+		{"不支持的语言使用默认语言", "fr", bizErr.ErrModNotFound, "Mod 不存在"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := serve(t, bundle, tt.acceptLanguage, func(c *gin.Context) {
+				dto.FailByError(c, tt.err)
+			})
+			assert.Equal(t, tt.want, resp.Message)
+		})
+	}
+}
+
+func validateSaveRequest(body string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req saveRequest
+		dto.ValidateFailByError(c, req, binding.JSON.BindBody([]byte(body), &req))
+	}
+}
+
+func fieldMessages(t *testing.T, resp dto.Response) map[string]string {
+	t.Helper()
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	var validation dto.ValidationErrorResponse
+	require.NoError(t, json.Unmarshal(data, &validation))
+
+	messages := make(map[string]string)
+	for _, fe := range validation.Errors {
+		messages[fe.Field] = fe.Message
+	}
+	return messages
+}
+
+func TestValidateFailByError_Localized(t *testing.T) {
+	bundle := newMessageBundle(t)
+	body := `{"count": 0, "items": [{}]}`
+
+	zh := fieldMessages(t, serve(t, bundle, "zh-CN", validateSaveRequest(body)))
+	// GetMessages 中的消息
+	assert.Equal(t, "标题不能为空", zh["title"])
+	// 没有自定义消息时使用内置规则的中文翻译
+	assert.Equal(t, "name为必填字段", zh["items[0].name"])
+
+	en := fieldMessages(t, serve(t, bundle, "en", validateSaveRequest(body)))
+	// 带请求结构体名前缀的消息目录项
+	assert.Equal(t, "Title is required", en["title"])
+	// GetMessages 中的中文消息不用于其他语言，使用内置规则的英文翻译
+	assert.Equal(t, "count must be 1 or greater", en["count"])
+	assert.Equal(t, "name is a required field", en["items[0].name"])
+}
+
+type mobileRequest struct {
+	Mobile string `json:"mobile" binding:"mobile"`
+}
+
+func (mobileRequest) GetMessages() dto.ValidatorMessages {
+	return dto.ValidatorMessages{
+		"Mobile.mobile": "手机号码格式不正确",
+	}
+}
+
+func TestValidateFailByError_CustomRuleLocalized(t *testing.T) {
+	bundle := newMessageBundle(t)
+	handler := func(c *gin.Context) {
+		var req mobileRequest
+		dto.ValidateFailByError(c, req, binding.JSON.BindBody([]byte(`{"mobile": "abc"}`), &req))
+	}
+
+	assert.Equal(t, "手机号码格式不正确", serve(t, bundle, "zh-CN", handler).Message)
+	// 自定义规则按 "字段名.规则名" 从消息目录中查找
+	assert.Equal(t, "Invalid mobile number format", serve(t, bundle, "en", handler).Message)
+}
+
+func TestGetFieldErrors_WithoutLocalizer(t *testing.T) {
+	var req saveRequest
+	err := bindJSON(t, `{"count": 0}`, &req)
+	require.Error(t, err)
+
+	// 没有经过 I18n 中间件时使用 GetMessages 中的消息
+	assert.Equal(t, "标题不能为空", dto.GetFieldErrors(req, err)[0].Message)
+}
@@ -0,0 +1,53 @@
+package dto_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/app/dto"
+)
+
+// TestRequests_CoversAllRequestStructs 所有导出的 *Request 结构体及带 binding 标签的结构体都需要加入 dto.Requests
+// （启动时检查验证标签；没有 binding 标签的请求结构体也需加入，以免之后新增标签时遗漏检查）
+func TestRequests_CoversAllRequestStructs(t *testing.T) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), "../../app/dto", nil, 0)
+	require.NoError(t, err)
+
+	registered := make(map[string]bool)
+	for _, req := range dto.Requests() {
+		registered[reflect.TypeOf(req).Name()] = true
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(node ast.Node) bool {
+				spec, ok := node.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				st, ok := spec.Type.(*ast.StructType)
+				if !ok {
+					return true
+				}
+				if spec.Name.IsExported() && strings.HasSuffix(spec.Name.Name, "Request") {
+					assert.True(t, registered[spec.Name.Name], "%s 未加入 dto.Requests", spec.Name.Name)
+					return true
+				}
+				for _, field := range st.Fields.List {
+					if field.Tag != nil && strings.Contains(field.Tag.Value, `binding:"`) {
+						assert.True(t, registered[spec.Name.Name], "%s 未加入 dto.Requests", spec.Name.Name)
+						break
+					}
+				}
+				return true
+			})
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package dto_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	bizErr "gin-web/pkg/errors"
+)
+
+func failByError(t *testing.T, err error) (*httptest.ResponseRecorder, dto.Response, *gin.Context) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	dto.FailByError(c, err)
+
+	var resp dto.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return w, resp, c
+}
+
+func TestFailByError_BizErrorKeepsCode(t *testing.T) {
+	w, resp, c := failByError(t, bizErr.ErrUserNotFound)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, bizErr.CodeUserNotFound, resp.ErrorCode)
+	assert.Equal(t, "用户不存在", resp.Message)
+	assert.Empty(t, c.Errors)
+}
+
+func TestFailByError_WrappedCauseHidden(t *testing.T) {
+	cause := errors.New("Error 1062: Duplicate entry '13800138000' for key 'mobile'")
+	err := fmt.Errorf("register: %w", bizErr.Wrap(cause, bizErr.CodeInternalError, "创建用户失败"))
+
+	w, resp, c := failByError(t, err)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, bizErr.CodeInternalError, resp.ErrorCode)
+	assert.Equal(t, "创建用户失败", resp.Message)
+	assert.NotContains(t, w.Body.String(), "Duplicate entry")
+	// 内部原因附加到请求上供日志记录
+	require.Len(t, c.Errors, 1)
+	assert.ErrorIs(t, c.Errors[0].Err, cause)
+}
+
+func TestFailByError_RecordNotFound(t *testing.T) {
+	w, resp, _ := failByError(t, gorm.ErrRecordNotFound)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, bizErr.CodeNotFound, resp.ErrorCode)
+}
+
+func TestFailByError_UnknownErrorIsInternal(t *testing.T) {
+	w, resp, c := failByError(t, errors.New("dial tcp 10.0.0.3:3306: connection refused"))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, bizErr.CodeInternalError, resp.ErrorCode)
+	assert.NotContains(t, w.Body.String(), "10.0.0.3")
+	assert.Len(t, c.Errors, 1)
+}
+
+func TestFail_StatusFromCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   int
+		status int
+	}{
+		{"validation", dto.CodeValidateError, http.StatusUnprocessableEntity},
+		{"business", dto.CodeBusinessError, http.StatusBadRequest},
+		{"token", dto.CodeTokenError, http.StatusUnauthorized},
+		{"password", bizErr.CodePasswordError, http.StatusUnauthorized},
+		{"forbidden", dto.CodeForbidden, http.StatusForbidden},
+		{"too many requests", bizErr.CodeTooManyRequests, http.StatusTooManyRequests},
+		{"exists", bizErr.CodeReleaseExists, http.StatusConflict},
+		{"state conflict", bizErr.CodeModStatusInvalid, http.StatusConflict},
+		{"too large", bizErr.CodeImageTooLarge, http.StatusRequestEntityTooLarge},
+		{"download not found", bizErr.CodeDownloadNotFound, http.StatusNotFound},
+		{"unmapped business code", bizErr.CodeInvalidCursor, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			dto.Fail(c, tt.code, "msg")
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
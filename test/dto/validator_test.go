@@ -0,0 +1,142 @@
+package dto_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/app/dto"
+	"gin-web/bootstrap"
+)
+
+type itemRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type saveRequest struct {
+	Title    string        `json:"title" binding:"required,max=10"`
+	Count    int           `json:"count" binding:"min=1"`
+	Email    string        `json:"email" binding:"omitempty,email"`
+	Items    []itemRequest `json:"items" binding:"dive"`
+	Internal string        `json:"-" binding:"required"`
+}
+
+func (saveRequest) GetMessages() dto.ValidatorMessages {
+	return dto.ValidatorMessages{
+		"Title.required": "标题不能为空",
+		"Count.min":      "数量不能小于1",
+	}
+}
+
+type pageQuery struct {
+	PageSize int `form:"page_size" binding:"max=100"`
+}
+
+func init() {
+	if _, err := bootstrap.InitializeValidator(); err != nil {
+		panic(err)
+	}
+}
+
+func bindJSON(t *testing.T, body string, req interface{}) error {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	return c.ShouldBindJSON(req)
+}
+
+func TestGetFieldErrors_AllFields(t *testing.T) {
+	var req saveRequest
+	err := bindJSON(t, `{"count": 0, "email": "invalid", "items": [{"name": "a"}, {}]}`, &req)
+	require.Error(t, err)
+
+	fieldErrors := dto.GetFieldErrors(req, err)
+
+	require.Len(t, fieldErrors, 5)
+	assert.Equal(t, dto.FieldError{Field: "title", Rule: "required", Message: "标题不能为空"}, fieldErrors[0])
+	assert.Equal(t, dto.FieldError{Field: "count", Rule: "min", Message: "数量不能小于1"}, fieldErrors[1])
+	assert.Equal(t, "email", fieldErrors[2].Field)
+	assert.Equal(t, "email", fieldErrors[2].Rule)
+	assert.NotEmpty(t, fieldErrors[2].Message)
+	assert.Equal(t, "items[1].name", fieldErrors[3].Field)
+	assert.Equal(t, "required", fieldErrors[3].Rule)
+	// json:"-" 的字段使用结构体字段名
+	assert.Equal(t, "Internal", fieldErrors[4].Field)
+}
+
+func TestGetFieldErrors_FormTagName(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/?page_size=500", nil)
+	var req pageQuery
+	err := c.ShouldBindQuery(&req)
+	require.Error(t, err)
+
+	fieldErrors := dto.GetFieldErrors(req, err)
+
+	require.Len(t, fieldErrors, 1)
+	assert.Equal(t, "page_size", fieldErrors[0].Field)
+	assert.Equal(t, "max", fieldErrors[0].Rule)
+}
+
+func TestGetFieldErrors_TypeMismatch(t *testing.T) {
+	var req saveRequest
+	err := bindJSON(t, `{"title": "ok", "count": "three"}`, &req)
+	require.Error(t, err)
+
+	fieldErrors := dto.GetFieldErrors(req, err)
+
+	require.Len(t, fieldErrors, 1)
+	assert.Equal(t, "count", fieldErrors[0].Field)
+	assert.Equal(t, "type", fieldErrors[0].Rule)
+}
+
+func TestGetErrorMsg_FirstMessage(t *testing.T) {
+	var req saveRequest
+	err := bindJSON(t, `{"count": 0}`, &req)
+	require.Error(t, err)
+
+	assert.Equal(t, "标题不能为空", dto.GetErrorMsg(req, err))
+}
+
+func TestValidateFailByError_Response(t *testing.T) {
+	var req saveRequest
+	err := bindJSON(t, `{"count": 0}`, &req)
+	require.Error(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	dto.ValidateFailByError(c, req, err)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	var resp struct {
+		ErrorCode int                         `json:"error_code"`
+		Message   string                      `json:"message"`
+		Data      dto.ValidationErrorResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, dto.CodeValidateError, resp.ErrorCode)
+	assert.Equal(t, "标题不能为空", resp.Message)
+	assert.Len(t, resp.Data.Errors, 3)
+}
+
+func TestValidateFailByError_MalformedBody(t *testing.T) {
+	var req saveRequest
+	err := bindJSON(t, `{"title": `, &req)
+	require.Error(t, err)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	dto.ValidateFailByError(c, req, err)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	assert.Contains(t, w.Body.String(), `"data":null`)
+}
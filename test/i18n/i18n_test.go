@@ -0,0 +1,144 @@
+package i18n_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/pkg/i18n"
+)
+
+func newBundle() *i18n.Bundle {
+	return i18n.NewBundle("zh-CN", "zh-CN", []string{"zh-CN", "en", "ja"})
+}
+
+func TestLoadFS_YAMLAndJSON(t *testing.T) {
+	bundle := newBundle()
+	fsys := fstest.MapFS{
+		"locales/en.yaml":   {Data: []byte("\"30001\": Mod not found\nMobile:\n  required: Mobile is required\n")},
+		"locales/ja.json":   {Data: []byte(`{"30001": "Mod が見つかりません"}`)},
+		"locales/README.md": {Data: []byte("ignored")},
+	}
+	require.NoError(t, bundle.LoadFS(fsys, "locales"))
+
+	en := bundle.Localizer("en")
+	message, ok := en.Lookup("30001")
+	assert.True(t, ok)
+	assert.Equal(t, "Mod not found", message)
+	// 嵌套映射按 "." 展开
+	message, ok = en.Lookup("Mobile.required")
+	assert.True(t, ok)
+	assert.Equal(t, "Mobile is required", message)
+
+	message, ok = bundle.Localizer("ja").Lookup("30001")
+	assert.True(t, ok)
+	assert.Equal(t, "Mod が見つかりません", message)
+
+	_, ok = bundle.Localizer("zh-CN").Lookup("30001")
+	assert.False(t, ok)
+}
+
+func TestLoadFile_Invalid(t *testing.T) {
+	bundle := newBundle()
+
+	assert.Error(t, bundle.LoadFile("en.txt", []byte("a: b")))
+	assert.Error(t, bundle.LoadFile("en.yaml", []byte("a: [1, 2]")))
+	assert.Error(t, bundle.LoadFile("!!.json", []byte(`{}`)))
+}
+
+func TestAddMessages_Overrides(t *testing.T) {
+	bundle := newBundle()
+	bundle.AddMessages("en", map[string]string{"40400": "Not found", "40300": "Forbidden"})
+	bundle.AddMessages("en", map[string]string{"40400": "Resource not found"})
+
+	en := bundle.Localizer("en")
+	message, _ := en.Lookup("40400")
+	assert.Equal(t, "Resource not found", message)
+	message, _ = en.Lookup("40300")
+	assert.Equal(t, "Forbidden", message)
+}
+
+func TestLocalizer_BaseLanguageCatalog(t *testing.T) {
+	bundle := newBundle()
+	bundle.AddMessages("en", map[string]string{"40400": "Not found"})
+
+	message, ok := bundle.Localizer("en-GB").Lookup("40400")
+	assert.True(t, ok)
+	assert.Equal(t, "Not found", message)
+}
+
+func TestLocalizer_IsSource(t *testing.T) {
+	bundle := newBundle()
+
+	assert.True(t, bundle.Localizer("zh-CN").IsSource())
+	assert.True(t, bundle.Localizer("zh-TW").IsSource())
+	assert.False(t, bundle.Localizer("en").IsSource())
+}
+
+func TestNegotiate(t *testing.T) {
+	bundle := newBundle()
+
+	assert.Equal(t, "en", bundle.Negotiate("fr-FR, en-US;q=0.8", ""))
+	assert.Equal(t, "ja", bundle.Negotiate("en", "ja"))
+	assert.Equal(t, "zh-CN", bundle.Negotiate("fr", ""))
+}
+
+type signupRequest struct {
+	Name   string `json:"name" binding:"required"`
+	Mobile string `json:"mobile" binding:"omitempty,mobile"`
+}
+
+func newValidate(t *testing.T) *validator.Validate {
+	t.Helper()
+	v := validator.New()
+	v.SetTagName("binding")
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+	require.NoError(t, v.RegisterValidation("mobile", func(fl validator.FieldLevel) bool {
+		return strings.HasPrefix(fl.Field().String(), "1")
+	}))
+	return v
+}
+
+func fieldErrors(t *testing.T, v *validator.Validate, req signupRequest) validator.ValidationErrors {
+	t.Helper()
+	var validationErrors validator.ValidationErrors
+	require.True(t, errors.As(v.Struct(req), &validationErrors))
+	return validationErrors
+}
+
+func TestTranslateField(t *testing.T) {
+	bundle := newBundle()
+	v := newValidate(t)
+	require.NoError(t, bundle.RegisterValidator(v))
+
+	fe := fieldErrors(t, v, signupRequest{})[0]
+
+	message, ok := bundle.Localizer("en").TranslateField(fe)
+	assert.True(t, ok)
+	assert.Equal(t, "name is a required field", message)
+
+	message, ok = bundle.Localizer("zh-CN").TranslateField(fe)
+	assert.True(t, ok)
+	assert.Equal(t, "name为必填字段", message)
+
+	// 自定义规则没有内置翻译
+	fe = fieldErrors(t, v, signupRequest{Name: "a", Mobile: "abc"})[0]
+	_, ok = bundle.Localizer("en").TranslateField(fe)
+	assert.False(t, ok)
+}
+
+func TestTranslateField_NotRegistered(t *testing.T) {
+	bundle := newBundle()
+	v := newValidate(t)
+
+	_, ok := bundle.Localizer("en").TranslateField(fieldErrors(t, v, signupRequest{})[0])
+	assert.False(t, ok)
+}
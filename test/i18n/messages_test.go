@@ -0,0 +1,78 @@
+package i18n_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/app/messages"
+	"gin-web/pkg/i18n"
+)
+
+// TestMessages_CoverAllErrors 内置的每种语言目录都需要包含 pkg/errors 中全部预定义错误的消息键
+func TestMessages_CoverAllErrors(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../../pkg/errors/errors.go", nil, 0)
+	require.NoError(t, err)
+
+	// 错误码常量
+	codes := make(map[string]string)
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || len(spec.Values) != len(spec.Names) {
+			return true
+		}
+		for i, name := range spec.Names {
+			if lit, ok := spec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.INT {
+				codes[name.Name] = lit.Value
+			}
+		}
+		return true
+	})
+
+	// 预定义错误：New(Code, "...") 或 New(Code, "...").WithKey("...")
+	var keys []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		for _, value := range spec.Values {
+			call, ok := value.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "WithKey" {
+				key, err := strconv.Unquote(call.Args[0].(*ast.BasicLit).Value)
+				require.NoError(t, err)
+				keys = append(keys, key)
+				continue
+			}
+			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "New" {
+				code, ok := codes[call.Args[0].(*ast.Ident).Name]
+				require.True(t, ok)
+				keys = append(keys, code)
+			}
+		}
+		return false
+	})
+	require.NotEmpty(t, keys)
+
+	bundle := i18n.NewBundle(messages.Source, messages.Source, []string{"en"})
+	require.NoError(t, bundle.LoadFS(messages.FS, "."))
+
+	entries, err := messages.FS.ReadDir(".")
+	require.NoError(t, err)
+	for _, entry := range entries {
+		tag := entry.Name()[:len(entry.Name())-len(".yaml")]
+		l := bundle.Localizer(tag)
+		for _, key := range keys {
+			_, ok := l.Lookup(key)
+			assert.True(t, ok, "%s 缺少消息 %s", entry.Name(), key)
+		}
+	}
+}
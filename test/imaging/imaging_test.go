@@ -0,0 +1,231 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+
+	"gin-web/pkg/imaging"
+)
+
+func gradient(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 255 / w), G: uint8(y * 255 / h), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestInspect(t *testing.T) {
+	data := encodePNG(t, gradient(40, 30))
+
+	info, err := imaging.Inspect(data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "png", info.Format)
+	assert.Equal(t, "image/png", info.ContentType)
+	assert.Equal(t, 40, info.Width)
+	assert.Equal(t, 30, info.Height)
+
+	_, err = imaging.Inspect(data, 1000)
+	assert.ErrorIs(t, err, imaging.ErrImageTooLarge)
+
+	_, err = imaging.Inspect([]byte("not an image"), 0)
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+}
+
+func TestFitSize(t *testing.T) {
+	tests := []struct {
+		name                 string
+		w, h, maxW, maxH     int
+		expectedW, expectedH int
+	}{
+		{"横图按宽度缩放", 1920, 1080, 400, 300, 400, 225},
+		{"竖图按高度缩放", 1080, 1920, 400, 300, 169, 300},
+		{"小图不放大", 200, 100, 400, 300, 200, 100},
+		{"高度不限制", 1000, 5000, 500, 0, 500, 2500},
+		{"极窄图至少保留 1 像素", 1, 5000, 100, 100, 1, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := imaging.FitSize(tt.w, tt.h, tt.maxW, tt.maxH)
+			assert.Equal(t, tt.expectedW, w)
+			assert.Equal(t, tt.expectedH, h)
+		})
+	}
+}
+
+func TestFit_AveragesPixels(t *testing.T) {
+	// 左半黑、右半白缩小为 2x1，应保持左右分界
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 8; x++ {
+			v := uint8(0)
+			if x >= 4 {
+				v = 255
+			}
+			img.SetNRGBA(x, y, color.NRGBA{R: v, G: v, B: v, A: 255})
+		}
+	}
+
+	out := imaging.Fit(img, 2, 2)
+
+	assert.Equal(t, image.Rect(0, 0, 2, 1), out.Bounds())
+	assert.Equal(t, color.NRGBA{R: 0, G: 0, B: 0, A: 255}, out.NRGBAAt(0, 0))
+	assert.Equal(t, color.NRGBA{R: 255, G: 255, B: 255, A: 255}, out.NRGBAAt(1, 0))
+}
+
+func TestFit_TransparentPixelsDoNotDarken(t *testing.T) {
+	// 全透明像素的颜色不参与平均
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 255})
+	img.SetNRGBA(1, 0, color.NRGBA{})
+
+	out := imaging.Fit(img, 1, 1)
+
+	c := out.NRGBAAt(0, 0)
+	assert.Equal(t, uint8(255), c.R)
+	assert.InDelta(t, 128, int(c.A), 1)
+}
+
+func TestEncodeWebP_Header(t *testing.T) {
+	img := gradient(33, 17)
+	img.SetNRGBA(0, 0, color.NRGBA{A: 10})
+
+	var buf bytes.Buffer
+	require.NoError(t, imaging.EncodeWebP(&buf, img))
+	data := buf.Bytes()
+
+	require.Greater(t, len(data), 25)
+	assert.Equal(t, "RIFF", string(data[0:4]))
+	assert.Equal(t, uint32(len(data)-8), binary.LittleEndian.Uint32(data[4:8]))
+	assert.Equal(t, "WEBPVP8L", string(data[8:16]))
+	assert.Zero(t, len(data)%2, "RIFF 块需要按偶数字节对齐")
+	assert.Equal(t, byte(0x2f), data[20])
+
+	// 14 位宽度 - 1、14 位高度 - 1、1 位 alpha 标志
+	bits := binary.LittleEndian.Uint32(data[21:25])
+	assert.Equal(t, uint32(32), bits&0x3fff)
+	assert.Equal(t, uint32(16), (bits>>14)&0x3fff)
+	assert.Equal(t, uint32(1), (bits>>28)&1)
+}
+
+func TestEncodeWebP_RoundTrip(t *testing.T) {
+	noise := func(w, h int, alpha bool) *image.NRGBA {
+		rng := rand.New(rand.NewSource(int64(w*h + 1)))
+		img := image.NewNRGBA(image.Rect(0, 0, w, h))
+		rng.Read(img.Pix)
+		if !alpha {
+			for i := 3; i < len(img.Pix); i += 4 {
+				img.Pix[i] = 255
+			}
+		}
+		return img
+	}
+	flat := func(w, h int, c color.NRGBA) *image.NRGBA {
+		img := image.NewNRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				img.SetNRGBA(x, y, c)
+			}
+		}
+		return img
+	}
+	translucent := func(w, h int) *image.NRGBA {
+		// 含全透明但颜色不为 0 的像素，无损编码需要原样保留
+		img := gradient(w, h)
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				c := img.NRGBAAt(x, y)
+				c.A = uint8((x + y) * 37)
+				img.SetNRGBA(x, y, c)
+			}
+		}
+		return img
+	}
+
+	tests := []struct {
+		name string
+		img  *image.NRGBA
+	}{
+		{"1x1 不透明", flat(1, 1, color.NRGBA{R: 12, G: 34, B: 56, A: 255})},
+		{"1x1 透明", flat(1, 1, color.NRGBA{R: 12, G: 34, B: 56})},
+		{"单行渐变", gradient(100, 1)},
+		{"单列渐变", gradient(1, 100)},
+		{"非块对齐的渐变", gradient(33, 17)},
+		{"块对齐的渐变", gradient(64, 64)},
+		{"纯色", flat(300, 200, color.NRGBA{R: 200, G: 200, B: 200, A: 255})},
+		{"半透明纯色", flat(47, 31, color.NRGBA{R: 10, G: 250, B: 90, A: 128})},
+		{"半透明渐变", translucent(129, 65)},
+		{"不透明噪声", noise(257, 129, false)},
+		{"带透明度的噪声", noise(200, 150, true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, imaging.EncodeWebP(&buf, tt.img))
+
+			decoded, err := webp.Decode(&buf)
+			require.NoError(t, err)
+			require.Equal(t, tt.img.Bounds(), decoded.Bounds())
+
+			bounds := tt.img.Bounds()
+			for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+				for x := bounds.Min.X; x < bounds.Max.X; x++ {
+					want := tt.img.NRGBAAt(x, y)
+					got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+					if want != got {
+						t.Fatalf("pixel (%d, %d): want %v, got %v", x, y, want, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeWebP_CompressesFlatImages(t *testing.T) {
+	flat := image.NewNRGBA(image.Rect(0, 0, 256, 256))
+	for i := range flat.Pix {
+		flat.Pix[i] = 200
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, imaging.EncodeWebP(&buf, flat))
+
+	assert.Less(t, buf.Len(), 1024)
+}
+
+func TestEncodeWebP_RejectsOversizedImages(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 16385, 1))
+
+	err := imaging.EncodeWebP(&bytes.Buffer{}, img)
+
+	assert.ErrorIs(t, err, imaging.ErrWebPTooLarge)
+}
+
+func TestEncodeJPEG(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, imaging.EncodeJPEG(&buf, gradient(20, 10), 80))
+
+	info, err := imaging.Inspect(buf.Bytes(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", info.Format)
+	assert.Equal(t, 20, info.Width)
+}
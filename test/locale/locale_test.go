@@ -0,0 +1,60 @@
+package locale_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gin-web/pkg/locale"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"zh_cn", "zh-CN"},
+		{"EN", "en"},
+		{"zh-hans", "zh-Hans"},
+		{" en-us ", "en-US"},
+		{"*", ""},
+		{"en--us", ""},
+		{"en;q=1", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, locale.Normalize(tt.input), tt.input)
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	prefs := locale.ParseAcceptLanguage("fr;q=0.5, en-US, *;q=0.1, de;q=0, ja;q=0.8")
+
+	tags := make([]string, len(prefs))
+	for i, p := range prefs {
+		tags[i] = p.Tag
+	}
+	assert.Equal(t, []string{"en-US", "ja", "fr"}, tags)
+}
+
+func TestNegotiator_Negotiate(t *testing.T) {
+	n := locale.NewNegotiator("zh-CN", []string{"en", "ja"})
+
+	// lang 参数优先于 Accept-Language
+	assert.Equal(t, "ja", n.Negotiate("en", "ja"))
+	// 不支持的 lang 参数被忽略
+	assert.Equal(t, "en", n.Negotiate("en", "xx"))
+	// 按权重顺序匹配，地区不同时按主语言匹配
+	assert.Equal(t, "en", n.Negotiate("fr;q=0.9, en-GB;q=0.8", ""))
+	assert.Equal(t, "zh-CN", n.Negotiate("zh-TW", ""))
+	// 均不支持时回退到默认语言
+	assert.Equal(t, "zh-CN", n.Negotiate("fr, de", ""))
+	assert.Equal(t, "zh-CN", n.Negotiate("", ""))
+}
+
+func TestNegotiator_SupportedIncludesDefault(t *testing.T) {
+	n := locale.NewNegotiator("", []string{"en", "EN", "zh-cn"})
+
+	assert.Equal(t, "zh-CN", n.Default())
+	assert.Equal(t, []string{"zh-CN", "en"}, n.Supported())
+}
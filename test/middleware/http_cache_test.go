@@ -0,0 +1,154 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/app/dto"
+	"gin-web/app/middleware"
+)
+
+var lastModified = time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+func newCachedRouter(calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/games", middleware.HTTPCache("public, max-age=300"), func(c *gin.Context) {
+		*calls++
+		dto.Success(c, gin.H{"list": []string{"上古卷轴5"}})
+	})
+	r.GET("/mods/:id", middleware.HTTPCache("public, no-cache"), func(c *gin.Context) {
+		if c.Param("id") != "3" {
+			dto.BusinessFail(c, "Mod not found")
+			return
+		}
+		c.Header("ETag", middleware.WeakETag([]byte("mod-3")))
+		middleware.SetLastModified(c, lastModified)
+		dto.Success(c, gin.H{"id": 3, "view_count": *calls})
+		*calls++
+	})
+	return r
+}
+
+func serve(r *gin.Engine, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestHTTPCache_ContentETag(t *testing.T) {
+	var calls int
+	r := newCachedRouter(&calls)
+
+	first := serve(r, "/games", nil)
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.Equal(t, "public, max-age=300", first.Header().Get("Cache-Control"))
+	assert.Contains(t, first.Body.String(), "上古卷轴5")
+
+	second := serve(r, "/games", nil)
+	assert.Equal(t, etag, second.Header().Get("ETag"))
+}
+
+func TestHTTPCache_IfNoneMatch(t *testing.T) {
+	var calls int
+	r := newCachedRouter(&calls)
+	etag := serve(r, "/games", nil).Header().Get("ETag")
+
+	w := serve(r, "/games", map[string]string{"If-None-Match": `"other", ` + etag})
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, "public, max-age=300", w.Header().Get("Cache-Control"))
+	assert.Equal(t, 2, calls)
+}
+
+func TestHTTPCache_IfNoneMatchMismatch(t *testing.T) {
+	var calls int
+	r := newCachedRouter(&calls)
+
+	w := serve(r, "/games", map[string]string{"If-None-Match": `"stale"`})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "上古卷轴5")
+}
+
+func TestHTTPCache_HandlerETagIgnoresVolatileFields(t *testing.T) {
+	var calls int
+	r := newCachedRouter(&calls)
+	first := serve(r, "/mods/3", nil)
+	require.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, middleware.WeakETag([]byte("mod-3")), first.Header().Get("ETag"))
+
+	// 浏览次数变化后弱 ETag 不变，弱比较忽略 W/ 前缀
+	w := serve(r, "/mods/3", map[string]string{"If-None-Match": first.Header().Get("ETag")[2:]})
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestHTTPCache_IfModifiedSince(t *testing.T) {
+	var calls int
+	r := newCachedRouter(&calls)
+
+	w := serve(r, "/mods/3", map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, lastModified.Format(http.TimeFormat), w.Header().Get("Last-Modified"))
+
+	w = serve(r, "/mods/3", map[string]string{"If-Modified-Since": lastModified.Add(-time.Second).Format(http.TimeFormat)})
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHTTPCache_IfNoneMatchTakesPrecedence(t *testing.T) {
+	var calls int
+	r := newCachedRouter(&calls)
+
+	w := serve(r, "/mods/3", map[string]string{
+		"If-None-Match":     `"stale"`,
+		"If-Modified-Since": lastModified.Format(http.TimeFormat),
+	})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHTTPCache_FailureNotCached(t *testing.T) {
+	var calls int
+	r := newCachedRouter(&calls)
+
+	w := serve(r, "/mods/99", map[string]string{"If-None-Match": "*"})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Mod not found")
+	assert.Empty(t, w.Header().Get("ETag"))
+	assert.Empty(t, w.Header().Get("Cache-Control"))
+}
+
+func TestHTTPCache_PanicRestoresWriter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, err interface{}) {
+		dto.ServerError(c, err)
+	}))
+	r.GET("/panic", middleware.HTTPCache("public, max-age=300"), func(c *gin.Context) {
+		panic("boom")
+	})
+
+	w := serve(r, "/panic", nil)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "error_code")
+	assert.Empty(t, w.Header().Get("Cache-Control"))
+}
@@ -0,0 +1,38 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gin-web/pkg/ratelimit"
+)
+
+func TestMemoryLimiter_LimitPerKey(t *testing.T) {
+	limiter := ratelimit.NewMemoryLimiter(2, time.Minute)
+
+	for i, want := range []bool{true, true, false, false} {
+		allowed, err := limiter.Allow("1")
+		assert.NoError(t, err)
+		assert.Equal(t, want, allowed, "第 %d 次", i+1)
+	}
+
+	// 不同 key 独立计数
+	allowed, _ := limiter.Allow("2")
+	assert.True(t, allowed)
+}
+
+func TestMemoryLimiter_ResetsAfterWindow(t *testing.T) {
+	limiter := ratelimit.NewMemoryLimiter(1, 20*time.Millisecond)
+
+	allowed, _ := limiter.Allow("1")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("1")
+	assert.False(t, allowed)
+
+	time.Sleep(30 * time.Millisecond)
+
+	allowed, _ = limiter.Allow("1")
+	assert.True(t, allowed)
+}
@@ -0,0 +1,307 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"gin-web/app/models"
+	"gin-web/internal/repository"
+	"gin-web/pkg/cache"
+)
+
+// stubModRepository 记录查询次数的 Mod 仓储（未实现的方法调用时 panic）
+type stubModRepository struct {
+	repository.ModRepository
+
+	mu      sync.Mutex
+	calls   map[string]int
+	games   []models.Game
+	mod     *models.Mod
+	release chan struct{} // 非空时 FindPublicByID 等待其关闭后返回
+}
+
+func newStubModRepository() *stubModRepository {
+	return &stubModRepository{
+		calls: make(map[string]int),
+		games: []models.Game{{ID: 1, Name: "上古卷轴5"}},
+		mod: &models.Mod{
+			ID:          3,
+			Name:        "SkyUI",
+			GameID:      1,
+			Game:        models.Game{ID: 1, Name: "上古卷轴5"},
+			Categories:  []models.Category{{ID: 2, Name: "界面"}},
+			Owner:       &models.User{Name: "author", Password: "hash"},
+			Maintainers: []models.User{{Name: "helper", Password: "hash"}},
+			Images:      []models.ModImage{{ID: 9, ModID: 3, ThumbnailURL: "/uploads/thumb.jpg"}},
+			CreatedAt:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+}
+
+func (r *stubModRepository) count(method string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls[method]
+}
+
+func (r *stubModRepository) record(method string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls[method]++
+}
+
+func (r *stubModRepository) FindAllGames() ([]models.Game, error) {
+	r.record("FindAllGames")
+	return append([]models.Game{}, r.games...), nil
+}
+
+func (r *stubModRepository) FindAllCategories() ([]models.Category, error) {
+	r.record("FindAllCategories")
+	return []models.Category{}, nil
+}
+
+func (r *stubModRepository) FindPublicByID(id uint) (*models.Mod, error) {
+	r.record("FindPublicByID")
+	if r.release != nil {
+		<-r.release
+	}
+	if id != r.mod.ID {
+		return nil, errors.New("record not found")
+	}
+	mod := *r.mod
+	owner := *r.mod.Owner
+	mod.Owner = &owner
+	mod.Maintainers = append([]models.User{}, r.mod.Maintainers...)
+	return &mod, nil
+}
+
+func (r *stubModRepository) Update(mod *models.Mod) error {
+	r.record("Update")
+	return nil
+}
+
+// failingStore 始终返回错误的缓存存储（模拟 Redis 不可用）
+type failingStore struct{}
+
+func (failingStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (failingStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return errors.New("connection refused")
+}
+
+func (failingStore) Delete(ctx context.Context, keys ...string) error {
+	return errors.New("connection refused")
+}
+
+func (failingStore) Incr(ctx context.Context, key string) (int64, error) {
+	return 0, errors.New("connection refused")
+}
+
+func newCachedRepository(store cache.Store, opts repository.ModCacheOptions) (repository.ModRepository, *stubModRepository, *repository.ModCache) {
+	stub := newStubModRepository()
+	modCache := repository.NewModCache(store, opts, zap.NewNop())
+	return repository.NewCachedModRepository(stub, modCache), stub, modCache
+}
+
+func TestCachedModRepository_FindAllGames_ReadThrough(t *testing.T) {
+	repo, stub, _ := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+
+	first, err := repo.FindAllGames()
+	require.NoError(t, err)
+	second, err := repo.FindAllGames()
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, stub.count("FindAllGames"))
+}
+
+func TestCachedModRepository_EmptyListStaysNonNil(t *testing.T) {
+	repo, stub, _ := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+
+	_, err := repo.FindAllCategories()
+	require.NoError(t, err)
+	categories, err := repo.FindAllCategories()
+
+	require.NoError(t, err)
+	assert.NotNil(t, categories)
+	assert.Empty(t, categories)
+	assert.Equal(t, 1, stub.count("FindAllCategories"))
+}
+
+func TestCachedModRepository_FindPublicByID_CopiesAndStripsPasswords(t *testing.T) {
+	repo, stub, _ := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+
+	first, err := repo.FindPublicByID(3)
+	require.NoError(t, err)
+	first.Name = "已替换为翻译"
+	second, err := repo.FindPublicByID(3)
+	require.NoError(t, err)
+
+	assert.Equal(t, "SkyUI", second.Name)
+	assert.Equal(t, "author", second.Owner.Name)
+	assert.Empty(t, second.Owner.Password)
+	assert.Empty(t, second.Maintainers[0].Password)
+	assert.Equal(t, "/uploads/thumb.jpg", second.Images[0].ThumbnailURL)
+	assert.True(t, stub.mod.CreatedAt.Equal(second.CreatedAt))
+	assert.Equal(t, 1, stub.count("FindPublicByID"))
+}
+
+func TestCachedModRepository_FindPublicByID_ErrorsNotCached(t *testing.T) {
+	repo, stub, _ := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+
+	_, err := repo.FindPublicByID(99)
+	assert.Error(t, err)
+	_, err = repo.FindPublicByID(99)
+	assert.Error(t, err)
+
+	assert.Equal(t, 2, stub.count("FindPublicByID"))
+}
+
+func TestCachedModRepository_SingleflightOnColdKey(t *testing.T) {
+	repo, stub, _ := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+	stub.release = make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]*models.Mod, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			mod, err := repo.FindPublicByID(3)
+			assert.NoError(t, err)
+			results[i] = mod
+		}(i)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(stub.release)
+	wg.Wait()
+
+	assert.Equal(t, 1, stub.count("FindPublicByID"))
+	for i := 1; i < len(results); i++ {
+		assert.NotSame(t, results[0], results[i])
+	}
+}
+
+func TestCachedModRepository_UpdateInvalidatesMod(t *testing.T) {
+	repo, stub, _ := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+
+	_, err := repo.FindPublicByID(3)
+	require.NoError(t, err)
+	require.NoError(t, repo.Update(&models.Mod{ID: 3}))
+	_, err = repo.FindPublicByID(3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stub.count("FindPublicByID"))
+}
+
+func TestModCache_InvalidateCatalog(t *testing.T) {
+	repo, stub, modCache := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+
+	_, err := repo.FindAllGames()
+	require.NoError(t, err)
+	_, err = repo.FindPublicByID(3)
+	require.NoError(t, err)
+	require.NoError(t, modCache.InvalidateCatalog())
+	_, err = repo.FindAllGames()
+	require.NoError(t, err)
+	_, err = repo.FindPublicByID(3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stub.count("FindAllGames"))
+	assert.Equal(t, 2, stub.count("FindPublicByID"))
+}
+
+func TestCachedModRepository_NegativeTTLDisablesMethod(t *testing.T) {
+	repo, stub, _ := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{GamesTTL: -1})
+
+	_, err := repo.FindAllGames()
+	require.NoError(t, err)
+	_, err = repo.FindAllGames()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stub.count("FindAllGames"))
+}
+
+func TestCachedModRepository_StoreUnavailable(t *testing.T) {
+	repo, stub, _ := newCachedRepository(failingStore{}, repository.ModCacheOptions{})
+
+	games, err := repo.FindAllGames()
+	require.NoError(t, err)
+	assert.Len(t, games, 1)
+	// 清除缓存失败不影响写操作
+	assert.NoError(t, repo.Update(&models.Mod{ID: 3}))
+	_, err = repo.FindAllGames()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stub.count("FindAllGames"))
+	assert.Equal(t, 1, stub.count("Update"))
+}
+
+// stubTagRepository 只实现标签关联写操作的标签仓储
+type stubTagRepository struct {
+	repository.TagRepository
+}
+
+func (stubTagRepository) AttachToMod(modID, tagID uint) (bool, error) {
+	return true, nil
+}
+
+func TestCacheInvalidatingTagRepository_AttachInvalidatesMod(t *testing.T) {
+	repo, stub, modCache := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+	tagRepo := repository.NewCacheInvalidatingTagRepository(stubTagRepository{}, modCache)
+
+	_, err := repo.FindPublicByID(3)
+	require.NoError(t, err)
+	attached, err := tagRepo.AttachToMod(3, 5)
+	require.NoError(t, err)
+	assert.True(t, attached)
+	_, err = repo.FindPublicByID(3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stub.count("FindPublicByID"))
+}
+
+// stubAuthorRepository 只实现共同维护者写操作的作者仓储
+type stubAuthorRepository struct {
+	repository.AuthorRepository
+	err error
+}
+
+func (r stubAuthorRepository) AddMaintainer(modID, userID uint) error {
+	return r.err
+}
+
+func TestCacheInvalidatingAuthorRepository_AddMaintainerInvalidatesMod(t *testing.T) {
+	repo, stub, modCache := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+	authorRepo := repository.NewCacheInvalidatingAuthorRepository(stubAuthorRepository{}, modCache)
+
+	_, err := repo.FindPublicByID(3)
+	require.NoError(t, err)
+	require.NoError(t, authorRepo.AddMaintainer(3, 7))
+	_, err = repo.FindPublicByID(3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, stub.count("FindPublicByID"))
+}
+
+func TestCacheInvalidatingAuthorRepository_FailedWriteKeepsCache(t *testing.T) {
+	repo, stub, modCache := newCachedRepository(cache.NewMemoryStore(), repository.ModCacheOptions{})
+	authorRepo := repository.NewCacheInvalidatingAuthorRepository(stubAuthorRepository{err: errors.New("duplicate")}, modCache)
+
+	_, err := repo.FindPublicByID(3)
+	require.NoError(t, err)
+	require.Error(t, authorRepo.AddMaintainer(3, 7))
+	_, err = repo.FindPublicByID(3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, stub.count("FindPublicByID"))
+}
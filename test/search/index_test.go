@@ -0,0 +1,114 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gin-web/pkg/search"
+)
+
+func TestTokenize_MixedText(t *testing.T) {
+	// Act
+	tokens := search.Tokenize("SkyUI 超级武器包 v5.2")
+
+	// Assert
+	assert.Equal(t, []string{"skyui", "超级", "级武", "武器", "器包", "v5", "2"}, tokens)
+}
+
+func TestIndex_Search_NameWeightedAboveDescription(t *testing.T) {
+	// Arrange
+	idx := search.NewIndex(map[string]float64{"name": 3, "description": 1})
+	idx.Add(1, map[string]string{"name": "Armor Pack", "description": "adds new weapons"})
+	idx.Add(2, map[string]string{"name": "Weapons Overhaul", "description": "rebalances armor"})
+	idx.Add(3, map[string]string{"name": "Biomes", "description": "new worlds"})
+
+	// Act
+	hits := idx.Search("weapons", 0)
+
+	// Assert
+	assert.Len(t, hits, 2)
+	assert.Equal(t, uint(2), hits[0].ID)
+	assert.Equal(t, uint(1), hits[1].ID)
+	assert.Greater(t, hits[0].Score, hits[1].Score)
+}
+
+func TestIndex_Search_CJK(t *testing.T) {
+	// Arrange
+	idx := search.NewIndex(nil)
+	idx.Add(1, map[string]string{"name": "超级武器包"})
+	idx.Add(2, map[string]string{"name": "载具合集"})
+
+	// Act
+	hits := idx.Search("武器", 0)
+
+	// Assert
+	assert.Len(t, hits, 1)
+	assert.Equal(t, uint(1), hits[0].ID)
+}
+
+func TestIndex_AddReplaceAndRemove(t *testing.T) {
+	// Arrange
+	idx := search.NewIndex(nil)
+	idx.Add(1, map[string]string{"name": "old name"})
+
+	// Act
+	idx.Add(1, map[string]string{"name": "new name"})
+
+	// Assert
+	assert.Empty(t, idx.Search("old", 0))
+	assert.Len(t, idx.Search("new", 0), 1)
+
+	idx.Remove(1)
+	assert.Empty(t, idx.Search("new", 0))
+	assert.Equal(t, 0, idx.Len())
+}
+
+func TestIndex_Search_Limit(t *testing.T) {
+	// Arrange
+	idx := search.NewIndex(nil)
+	for i := uint(1); i <= 5; i++ {
+		idx.Add(i, map[string]string{"name": "texture pack"})
+	}
+
+	// Act
+	hits := idx.Search("texture", 3)
+
+	// Assert
+	assert.Len(t, hits, 3)
+	assert.Equal(t, uint(1), hits[0].ID)
+}
+
+func TestHighlight_WrapsAndEscapes(t *testing.T) {
+	// Act
+	result := search.Highlight("<b>Super</b> Weapon pack", []string{"weapon"}, 0)
+
+	// Assert
+	assert.Equal(t, "&lt;b&gt;Super&lt;/b&gt; <em>Weapon</em> pack", result)
+}
+
+func TestHighlight_MergesAdjacentCJKTerms(t *testing.T) {
+	// Act
+	result := search.Highlight("超级武器包", search.Terms("武器包"), 0)
+
+	// Assert
+	assert.Equal(t, "超级<em>武器包</em>", result)
+}
+
+func TestHighlight_Snippet(t *testing.T) {
+	// Arrange
+	text := "aaaaaaaaaa aaaaaaaaaa target bbbbbbbbbb bbbbbbbbbb"
+
+	// Act
+	result := search.Highlight(text, []string{"target"}, 20)
+
+	// Assert
+	assert.Contains(t, result, "<em>target</em>")
+	assert.True(t, len(result) < len(text)+len("<em></em>"))
+	assert.Equal(t, "...", result[:3])
+	assert.Equal(t, "...", result[len(result)-3:])
+}
+
+func TestHighlight_NoMatch(t *testing.T) {
+	assert.Empty(t, search.Highlight("nothing here", []string{"weapon"}, 0))
+}
@@ -0,0 +1,222 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockAuthorRepository 作者仓储 Mock
+type MockAuthorRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuthorRepository) FindUser(id uint) (*models.User, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
+func (m *MockAuthorRepository) Stats(userID uint) (*repository.AuthorStats, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.AuthorStats), args.Error(1)
+}
+
+func (m *MockAuthorRepository) SetOwner(modID, userID uint) error {
+	args := m.Called(modID, userID)
+	return args.Error(0)
+}
+
+func (m *MockAuthorRepository) AddMaintainer(modID, userID uint) error {
+	args := m.Called(modID, userID)
+	return args.Error(0)
+}
+
+func (m *MockAuthorRepository) RemoveMaintainer(modID, userID uint) error {
+	args := m.Called(modID, userID)
+	return args.Error(0)
+}
+
+func (m *MockAuthorRepository) LinkOwnersByAuthorName() (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestAuthorService_GetAuthorDetail(t *testing.T) {
+	// Arrange
+	authorRepo := new(MockAuthorRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	modService := services.NewModService(modRepo, nil, nil, logger)
+	service := services.NewAuthorService(authorRepo, modRepo, modService, logger)
+
+	authorRepo.On("FindUser", uint(7)).Return(&models.User{ID: models.ID{ID: 7}, Name: "schlangster", Mobile: "13800138000"}, nil)
+	authorRepo.On("Stats", uint(7)).Return(&repository.AuthorStats{ModCount: 2, TotalDownloads: 1500, TotalViews: 9000, AverageRating: 4.5}, nil)
+
+	// Act
+	result, err := service.GetAuthorDetail(7)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, dto.AuthorResponse{ID: 7, Name: "schlangster"}, result.AuthorResponse)
+	assert.Equal(t, int64(2), result.Stats.ModCount)
+	assert.Equal(t, int64(1500), result.Stats.TotalDownloads)
+	assert.Equal(t, 4.5, result.Stats.AverageRating)
+}
+
+func TestAuthorService_GetAuthorDetail_NotFound(t *testing.T) {
+	// Arrange
+	authorRepo := new(MockAuthorRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	modService := services.NewModService(modRepo, nil, nil, logger)
+	service := services.NewAuthorService(authorRepo, modRepo, modService, logger)
+
+	authorRepo.On("FindUser", uint(7)).Return(nil, gorm.ErrRecordNotFound)
+
+	// Act
+	result, err := service.GetAuthorDetail(7)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrUserNotFound, err)
+	authorRepo.AssertNotCalled(t, "Stats", mock.Anything)
+}
+
+func TestAuthorService_SearchAuthorMods_FiltersByAuthor(t *testing.T) {
+	// Arrange
+	authorRepo := new(MockAuthorRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	modService := services.NewModService(modRepo, nil, nil, logger)
+	service := services.NewAuthorService(authorRepo, modRepo, modService, logger)
+
+	authorRepo.On("FindUser", uint(7)).Return(&models.User{ID: models.ID{ID: 7}}, nil)
+	modRepo.On("Search", mock.MatchedBy(func(c repository.ModSearchCriteria) bool {
+		return c.AuthorID == 7
+	})).Return(&repository.ModSearchResult{Mods: []models.Mod{{ID: 1, OwnerID: 7}}, Page: 1, PageSize: 20}, nil)
+
+	// Act
+	result, err := service.SearchAuthorMods(7, dto.ModSearchRequest{AuthorID: 99, Page: 1, PageSize: 20}, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.List, 1)
+	assert.Equal(t, uint(7), result.List[0].OwnerID)
+	modRepo.AssertExpectations(t)
+}
+
+func TestAuthorService_AddMaintainer(t *testing.T) {
+	// Arrange
+	authorRepo := new(MockAuthorRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	modService := services.NewModService(modRepo, nil, nil, logger)
+	service := services.NewAuthorService(authorRepo, modRepo, modService, logger)
+
+	before := &models.Mod{ID: 1, OwnerID: 7}
+	after := &models.Mod{
+		ID:          1,
+		OwnerID:     7,
+		Owner:       &models.User{ID: models.ID{ID: 7}, Name: "owner"},
+		Maintainers: []models.User{{ID: models.ID{ID: 8}, Name: "helper"}},
+	}
+	modRepo.On("FindByID", uint(1)).Return(before, nil).Once()
+	modRepo.On("FindByID", uint(1)).Return(after, nil).Once()
+	authorRepo.On("FindUser", uint(8)).Return(&models.User{ID: models.ID{ID: 8}}, nil)
+	authorRepo.On("AddMaintainer", uint(1), uint(8)).Return(nil)
+
+	// Act
+	result, err := service.AddMaintainer(1, 7, dto.ModMaintainerRequest{UserID: 8})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &dto.AuthorResponse{ID: 7, Name: "owner"}, result.Owner)
+	assert.Equal(t, []dto.AuthorResponse{{ID: 8, Name: "helper"}}, result.Maintainers)
+	authorRepo.AssertExpectations(t)
+}
+
+func TestAuthorService_AddMaintainer_Rejected(t *testing.T) {
+	tests := []struct {
+		name       string
+		operatorID uint
+		userID     uint
+		want       error
+	}{
+		{"维护者不能添加维护者", 8, 9, bizErr.ErrNotModOwner},
+		{"作者不能添加自己", 7, 7, bizErr.ErrMaintainerIsOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorRepo := new(MockAuthorRepository)
+			modRepo := new(MockModRepository)
+			logger, _ := zap.NewDevelopment()
+			modService := services.NewModService(modRepo, nil, nil, logger)
+			service := services.NewAuthorService(authorRepo, modRepo, modService, logger)
+
+			modRepo.On("FindByID", uint(1)).
+				Return(&models.Mod{ID: 1, OwnerID: 7, Maintainers: []models.User{{ID: models.ID{ID: 8}}}}, nil)
+
+			result, err := service.AddMaintainer(1, tt.operatorID, dto.ModMaintainerRequest{UserID: tt.userID})
+
+			assert.Nil(t, result)
+			assert.Equal(t, tt.want, err)
+			authorRepo.AssertNotCalled(t, "AddMaintainer", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAuthorService_RemoveMaintainer_SelfLeave(t *testing.T) {
+	// Arrange
+	authorRepo := new(MockAuthorRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	modService := services.NewModService(modRepo, nil, nil, logger)
+	service := services.NewAuthorService(authorRepo, modRepo, modService, logger)
+
+	modRepo.On("FindByID", uint(1)).
+		Return(&models.Mod{ID: 1, OwnerID: 7, Maintainers: []models.User{{ID: models.ID{ID: 8}}}}, nil)
+	authorRepo.On("RemoveMaintainer", uint(1), uint(8)).Return(nil)
+
+	// Act
+	_, err := service.RemoveMaintainer(1, 8, 8)
+
+	// Assert
+	assert.NoError(t, err)
+	authorRepo.AssertExpectations(t)
+}
+
+func TestAuthorService_RemoveMaintainer_OtherMaintainer(t *testing.T) {
+	// Arrange
+	authorRepo := new(MockAuthorRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	modService := services.NewModService(modRepo, nil, nil, logger)
+	service := services.NewAuthorService(authorRepo, modRepo, modService, logger)
+
+	modRepo.On("FindByID", uint(1)).
+		Return(&models.Mod{ID: 1, OwnerID: 7, Maintainers: []models.User{{ID: models.ID{ID: 8}}, {ID: models.ID{ID: 9}}}}, nil)
+
+	// Act
+	result, err := service.RemoveMaintainer(1, 8, 9)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrNotModOwner, err)
+	authorRepo.AssertNotCalled(t, "RemoveMaintainer", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,206 @@
+package services_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/amqp/event"
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	"gin-web/pkg/catalog"
+)
+
+// MockCatalogRepository 目录数据仓储 Mock
+type MockCatalogRepository struct {
+	mock.Mock
+}
+
+func (m *MockCatalogRepository) FindGames() ([]models.Game, error) {
+	args := m.Called()
+	return args.Get(0).([]models.Game), args.Error(1)
+}
+
+func (m *MockCatalogRepository) FindCategories() ([]models.Category, error) {
+	args := m.Called()
+	return args.Get(0).([]models.Category), args.Error(1)
+}
+
+func (m *MockCatalogRepository) FindModKeys() (map[repository.ModKey]uint, error) {
+	args := m.Called()
+	return args.Get(0).(map[repository.ModKey]uint), args.Error(1)
+}
+
+func (m *MockCatalogRepository) EachMod(batchSize int, fn func(mods []models.Mod) error) error {
+	args := m.Called(batchSize)
+	return fn(args.Get(0).([]models.Mod))
+}
+
+func (m *MockCatalogRepository) Transaction(fn func(tx repository.CatalogRepository) error) error {
+	m.Called()
+	return fn(m)
+}
+
+func (m *MockCatalogRepository) SaveGame(game *models.Game, columns []string) error {
+	args := m.Called(game, columns)
+	return args.Error(0)
+}
+
+func (m *MockCatalogRepository) SaveCategory(category *models.Category, columns []string) error {
+	args := m.Called(category, columns)
+	return args.Error(0)
+}
+
+func (m *MockCatalogRepository) SaveMod(mod *models.Mod, columns []string, categories []models.Category) error {
+	args := m.Called(mod, columns, categories)
+	return args.Error(0)
+}
+
+func TestCatalogService_Import_DryRunReportsRowErrors(t *testing.T) {
+	// Arrange
+	repo := new(MockCatalogRepository)
+	events := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCatalogService(repo, events, logger)
+
+	repo.On("FindGames").Return([]models.Game{{ID: 1, Name: "Skyrim"}}, nil)
+	repo.On("FindCategories").Return([]models.Category{{ID: 3, Name: "Interface"}}, nil)
+	repo.On("FindModKeys").Return(map[repository.ModKey]uint{{GameID: 1, Name: "SkyUI"}: 9}, nil)
+	input := "game,name,rating,categories\n" +
+		"Skyrim,SkyUI,4.9,Interface\n" +
+		"Fallout,FOSE,4.5,\n" +
+		"Skyrim,SKSE64,6,Interface|Tools\n" +
+		"Skyrim,New Mod,,\n"
+
+	// Act
+	result, err := service.Import(services.CatalogMods, catalog.FormatCSV, strings.NewReader(input), true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 4, result.Total)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, 2, result.Failed)
+	assert.False(t, result.Applied)
+	assert.Equal(t, []dto.CatalogRowError{
+		{Row: 3, Field: "game", Message: "游戏不存在"},
+		{Row: 4, Field: "rating", Message: "必须是 0 到 5 之间的数字"},
+		{Row: 4, Field: "categories", Message: "分类 Tools 不存在"},
+	}, result.Errors)
+	repo.AssertNotCalled(t, "Transaction")
+}
+
+func TestCatalogService_Import_ErrorsAbortWholeFile(t *testing.T) {
+	// Arrange
+	repo := new(MockCatalogRepository)
+	events := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCatalogService(repo, events, logger)
+
+	repo.On("FindGames").Return([]models.Game{}, nil)
+	input := `[{"name": "Minecraft"}, {"name": "Minecraft"}]`
+
+	// Act
+	result, err := service.Import(services.CatalogGames, catalog.FormatJSON, strings.NewReader(input), false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.CatalogRowError{{Row: 2, Field: "name", Message: "与第 1 行重复"}}, result.Errors)
+	assert.False(t, result.Applied)
+	repo.AssertNotCalled(t, "SaveGame", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_Import_CategoriesResolveParentsInFile(t *testing.T) {
+	// Arrange
+	repo := new(MockCatalogRepository)
+	events := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCatalogService(repo, events, logger)
+
+	parentID := uint(1)
+	repo.On("FindCategories").Return([]models.Category{
+		{ID: 1, Name: "Gameplay"},
+		{ID: 2, Name: "Interface", ParentID: &parentID},
+	}, nil)
+	repo.On("Transaction").Return()
+	repo.On("SaveCategory", mock.MatchedBy(func(c *models.Category) bool { return c.Name == "Tools" }), []string{"parent_id"}).
+		Run(func(args mock.Arguments) { args.Get(0).(*models.Category).ID = 10 }).Return(nil)
+	repo.On("SaveCategory", mock.MatchedBy(func(c *models.Category) bool { return c.Name == "Scripts" }), []string{"parent_id"}).Return(nil)
+	input := "{\"name\": \"Tools\", \"parent\": \"\"}\n" +
+		"{\"name\": \"Scripts\", \"parent\": \"Tools\"}\n" +
+		"{\"name\": \"Gameplay\", \"parent\": \"Interface\"}\n"
+
+	// Act
+	result, err := service.Import(services.CatalogCategories, catalog.FormatNDJSON, strings.NewReader(input), false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.CatalogRowError{{Row: 3, Field: "parent", Message: "父分类不能是自身或子孙分类"}}, result.Errors)
+	assert.False(t, result.Applied)
+
+	// 去掉形成循环的行后可以导入，子分类引用同一文件中新建的父分类
+	result, err = service.Import(services.CatalogCategories, catalog.FormatNDJSON,
+		strings.NewReader(strings.Join(strings.Split(input, "\n")[:2], "\n")), false)
+	assert.NoError(t, err)
+	assert.True(t, result.Applied)
+	assert.Equal(t, 2, result.Created)
+	repo.AssertCalled(t, "SaveCategory", mock.MatchedBy(func(c *models.Category) bool {
+		return c.Name == "Scripts" && c.ParentID != nil && *c.ParentID == 10
+	}), []string{"parent_id"})
+	events.AssertNotCalled(t, "PublishModEvent", mock.Anything, mock.Anything)
+}
+
+func TestCatalogService_Import_ModsUpdateProvidedColumnsAndReindex(t *testing.T) {
+	// Arrange
+	repo := new(MockCatalogRepository)
+	events := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCatalogService(repo, events, logger)
+
+	repo.On("FindGames").Return([]models.Game{{ID: 1, Name: "Skyrim"}}, nil)
+	repo.On("FindCategories").Return([]models.Category{{ID: 3, Name: "Interface"}}, nil)
+	repo.On("FindModKeys").Return(map[repository.ModKey]uint{{GameID: 1, Name: "SkyUI"}: 9}, nil)
+	repo.On("Transaction").Return()
+	repo.On("SaveMod", mock.MatchedBy(func(m *models.Mod) bool { return m.ID == 9 && m.Version == "5.2SE" }),
+		[]string{"version"}, []models.Category(nil)).Return(nil)
+	events.On("PublishModEvent", event.ModReindex, uint(0)).Return(nil)
+
+	// Act
+	result, err := service.Import(services.CatalogMods, catalog.FormatCSV,
+		strings.NewReader("game,name,version\nSkyrim,SkyUI,5.2SE\n"), false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.Applied)
+	assert.Equal(t, 1, result.Updated)
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestCatalogService_Export_CategoriesParentFirst(t *testing.T) {
+	// Arrange
+	repo := new(MockCatalogRepository)
+	events := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCatalogService(repo, events, logger)
+
+	parentID := uint(5)
+	repo.On("FindCategories").Return([]models.Category{
+		{ID: 2, Name: "Interface", ParentID: &parentID},
+		{ID: 5, Name: "Gameplay"},
+	}, nil)
+	var buf bytes.Buffer
+
+	// Act
+	err := service.Export(services.CatalogCategories, catalog.FormatCSV, &buf)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "name,parent,description\nGameplay,,\nInterface,Gameplay,\n", buf.String())
+}
@@ -0,0 +1,333 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockCollectionRepository 合集仓储 Mock
+type MockCollectionRepository struct {
+	mock.Mock
+}
+
+func (m *MockCollectionRepository) Create(collection *models.Collection) error {
+	args := m.Called(collection)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) FindByID(id uint) (*models.Collection, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Collection), args.Error(1)
+}
+
+func (m *MockCollectionRepository) Search(criteria repository.CollectionCriteria) ([]models.Collection, int64, error) {
+	args := m.Called(criteria)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.Collection), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockCollectionRepository) Update(collection *models.Collection) error {
+	args := m.Called(collection)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) Delete(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) ReplaceItems(collection *models.Collection, items []models.CollectionItem) error {
+	args := m.Called(collection, items)
+	return args.Error(0)
+}
+
+func (m *MockCollectionRepository) Follow(collectionID, userID uint) (bool, error) {
+	args := m.Called(collectionID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCollectionRepository) Unfollow(collectionID, userID uint) (bool, error) {
+	args := m.Called(collectionID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCollectionRepository) IsFollowing(collectionID, userID uint) (bool, error) {
+	args := m.Called(collectionID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+// collectionItem 构造合集条目（Mod 取自 mods，状态为已通过）
+func collectionItem(mods []models.Mod, modID uint, release *models.ModRelease) models.CollectionItem {
+	item := models.CollectionItem{ModID: modID}
+	for _, mod := range mods {
+		if mod.ID == modID {
+			mod.Status = models.ModStatusApproved
+			item.Mod = &mod
+		}
+	}
+	if release != nil {
+		item.ReleaseID = release.ID
+		item.Release = release
+	}
+	return item
+}
+
+func TestCollectionService_GetCollection_PrivateHiddenFromOthers(t *testing.T) {
+	// Arrange
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	repo.On("FindByID", uint(1)).Return(&models.Collection{ID: 1, OwnerID: 7, IsPublic: false}, nil)
+
+	// Act
+	_, err := service.GetCollection(1, 8)
+	_, anonErr := service.ResolveDependencies(1, 0, false)
+
+	// Assert
+	assert.Equal(t, bizErr.ErrCollectionNotFound, err)
+	assert.Equal(t, bizErr.ErrCollectionNotFound, anonErr)
+}
+
+func TestCollectionService_GetCollection_OwnerSeesPrivate(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	repo.On("FindByID", uint(1)).Return(&models.Collection{
+		ID: 1, OwnerID: 7, IsPublic: false, Title: "私人整合包",
+		Items: []models.CollectionItem{collectionItem(mods, 1, nil)},
+	}, nil)
+	repo.On("IsFollowing", uint(1), uint(7)).Return(false, nil)
+
+	// Act
+	result, err := service.GetCollection(1, 7)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "私人整合包", result.Title)
+	assert.Len(t, result.Items, 1)
+	assert.True(t, result.Items[0].Available)
+}
+
+func TestCollectionService_CreateCollection_RejectsModFromOtherGame(t *testing.T) {
+	// Arrange
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	modRepo.On("FindGameByID", uint(1)).Return(&models.Game{ID: 1, Name: "Skyrim"}, nil)
+	modRepo.On("FindByIDs", []uint{1, 9}).Return([]models.Mod{
+		{ID: 1, Name: "SkyUI", GameID: 1},
+		{ID: 9, Name: "OptiFine", GameID: 2},
+	}, nil)
+
+	// Act
+	_, err := service.CreateCollection(7, dto.CollectionCreateRequest{
+		GameID: 1,
+		Title:  "混搭",
+		Items:  []dto.CollectionItemRequest{{ModID: 1}, {ModID: 9}},
+	})
+
+	// Assert
+	var bizError *bizErr.BizError
+	assert.ErrorAs(t, err, &bizError)
+	assert.Equal(t, bizErr.CodeCollectionItemInvalid, bizError.Code)
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCollectionService_AddItem_RejectsReleaseOfOtherMod(t *testing.T) {
+	// Arrange
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	repo.On("FindByID", uint(1)).Return(&models.Collection{ID: 1, OwnerID: 7, GameID: 1, IsPublic: true}, nil)
+	modRepo.On("FindByIDs", []uint{1}).Return([]models.Mod{{ID: 1, Name: "SkyUI", GameID: 1}}, nil)
+	releaseRepo.On("FindByID", uint(30)).Return(&models.ModRelease{ID: 30, ModID: 2, Version: "2.2.3"}, nil)
+
+	// Act
+	_, err := service.AddItem(1, 7, dto.CollectionItemAddRequest{
+		CollectionItemRequest: dto.CollectionItemRequest{ModID: 1, ReleaseID: 30},
+	})
+
+	// Assert
+	var bizError *bizErr.BizError
+	assert.ErrorAs(t, err, &bizError)
+	assert.Equal(t, bizErr.CodeCollectionItemInvalid, bizError.Code)
+	repo.AssertNotCalled(t, "ReplaceItems", mock.Anything, mock.Anything)
+}
+
+func TestCollectionService_UpdateCollection_NotOwner(t *testing.T) {
+	// Arrange
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	repo.On("FindByID", uint(1)).Return(&models.Collection{ID: 1, OwnerID: 7, IsPublic: true}, nil)
+
+	// Act
+	_, err := service.UpdateCollection(1, 8, dto.CollectionUpdateRequest{Title: "改名"})
+
+	// Assert
+	assert.Equal(t, bizErr.ErrNotCollectionOwner, err)
+	repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCollectionService_ResolveDependencies_MergesAndChecksPinnedVersion(t *testing.T) {
+	// Arrange：SkyUI 与 MCM Helper 都依赖 SKSE64，合集将 SKSE64 锁定在 1.9 版本
+	mods := skyrimMods()
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 2, models.DependencyRequired, ">=2.2, <3"),
+		dependency(mods, 4, 2, models.DependencyRequired, ""),
+		dependency(mods, 2, 3, models.DependencyRequired, ""),
+	})
+	pinned := &models.ModRelease{ID: 20, ModID: 2, Version: "1.9.0"}
+	repo.On("FindByID", uint(1)).Return(&models.Collection{
+		ID: 1, OwnerID: 7, IsPublic: true,
+		Items: []models.CollectionItem{collectionItem(mods, 1, nil), collectionItem(mods, 4, nil), collectionItem(mods, 2, pinned)},
+	}, nil)
+
+	// Act
+	result, err := service.ResolveDependencies(1, 0, false)
+
+	// Assert
+	assert.NoError(t, err)
+	var names []string
+	for _, item := range result.InstallOrder {
+		names = append(names, item.Name)
+	}
+	assert.Equal(t, []string{"Address Library", "SKSE64", "SkyUI", "MCM Helper"}, names)
+	assert.False(t, result.InstallOrder[0].InCollection)
+	assert.True(t, result.InstallOrder[1].Pinned)
+	assert.Equal(t, "1.9.0", result.InstallOrder[1].Version)
+	assert.Equal(t, []string{"SkyUI 需要 SKSE64 >=2.2, <3，当前版本为 1.9.0"}, result.Conflicts)
+}
+
+func TestCollectionService_Manifest_InstallOrderAndUnavailable(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 2, models.DependencyRequired, ">=2.2"),
+		dependency(mods, 2, 3, models.DependencyRequired, ""),
+	})
+	hidden := collectionItem(mods, 5, nil)
+	hidden.Mod.Status = models.ModStatusHidden
+	release := &models.ModRelease{ID: 10, ModID: 1, Version: "5.1", DownloadURL: "https://example.com/skyui-5.1.zip", FileSize: 2048}
+	repo.On("FindByID", uint(1)).Return(&models.Collection{
+		ID: 1, OwnerID: 7, IsPublic: true, Title: "界面增强",
+		Owner: &models.User{Name: "curator"},
+		Game:  &models.Game{ID: 1, Name: "Skyrim"},
+		Items: []models.CollectionItem{collectionItem(mods, 1, release), hidden},
+	}, nil)
+
+	// Act
+	manifest, err := service.Manifest(1, 0, false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, services.CollectionManifestVersion, manifest.FormatVersion)
+	assert.Equal(t, "curator", manifest.Collection.Owner)
+	assert.Equal(t, "Skyrim", manifest.Game.Name)
+	assert.Len(t, manifest.Mods, 3)
+	assert.Equal(t, "Address Library", manifest.Mods[0].Name)
+	assert.Equal(t, services.ManifestSourceDependency, manifest.Mods[0].Source)
+	skyui := manifest.Mods[2]
+	assert.Equal(t, services.ManifestSourceCollection, skyui.Source)
+	assert.True(t, skyui.Pinned)
+	assert.Equal(t, "5.1", skyui.Version)
+	assert.Equal(t, "https://example.com/skyui-5.1.zip", skyui.DownloadURL)
+}
+
+func TestCollectionService_Manifest_ConflictFails(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 5, models.DependencyIncompatible, ""),
+	})
+	repo.On("FindByID", uint(1)).Return(&models.Collection{
+		ID: 1, OwnerID: 7, IsPublic: true,
+		Items: []models.CollectionItem{collectionItem(mods, 1, nil), collectionItem(mods, 5, nil)},
+	}, nil)
+
+	// Act
+	_, err := service.Manifest(1, 0, false)
+
+	// Assert
+	var bizError *bizErr.BizError
+	assert.ErrorAs(t, err, &bizError)
+	assert.Equal(t, bizErr.CodeDependencyConflict, bizError.Code)
+}
+
+func TestCollectionService_Follow_CountsOnlyNewFollow(t *testing.T) {
+	// Arrange
+	repo := new(MockCollectionRepository)
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCollectionService(repo, modRepo, releaseRepo, depRepo, logger)
+
+	repo.On("FindByID", uint(1)).Return(&models.Collection{ID: 1, OwnerID: 7, IsPublic: true, FollowerCount: 3}, nil)
+	repo.On("Follow", uint(1), uint(8)).Return(false, nil)
+
+	// Act
+	result, err := service.Follow(1, 8)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.Following)
+	assert.Equal(t, 3, result.FollowerCount)
+}
@@ -0,0 +1,276 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockCommentRepository 评论仓储 Mock
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+func (m *MockCommentRepository) Create(comment *models.Comment) error {
+	args := m.Called(comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) FindByID(id uint) (*models.Comment, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Comment), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindThreads(modID uint, cursor string, limit int) (*repository.CommentPage, error) {
+	args := m.Called(modID, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.CommentPage), args.Error(1)
+}
+
+func (m *MockCommentRepository) FindReplies(rootID uint, cursor string, limit int) (*repository.CommentPage, error) {
+	args := m.Called(rootID, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.CommentPage), args.Error(1)
+}
+
+func (m *MockCommentRepository) UpdateContent(comment *models.Comment) error {
+	args := m.Called(comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentRepository) SoftDelete(comment *models.Comment) error {
+	args := m.Called(comment)
+	return args.Error(0)
+}
+
+// MockLimiter 限流器 Mock
+type MockLimiter struct {
+	mock.Mock
+}
+
+func (m *MockLimiter) Allow(key string) (bool, error) {
+	args := m.Called(key)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestCommentService_CreateComment_ReplyJoinsRootThread(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	repo.On("FindByID", uint(11)).Return(&models.Comment{ID: 11, ModID: 1, RootID: 10, ParentID: 10}, nil).Once()
+	limiter.On("Allow", "7").Return(true, nil)
+	repo.On("Create", mock.AnythingOfType("*models.Comment")).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.Comment).ID = 12
+	}).Return(nil)
+	repo.On("FindByID", uint(12)).Return(&models.Comment{
+		ID: 12, ModID: 1, RootID: 10, ParentID: 11, UserID: 7, Content: "同问",
+		User: &models.User{ID: models.ID{ID: 7}, Name: "alice"},
+	}, nil)
+
+	// Act
+	result, err := service.CreateComment(1, 7, dto.CommentCreateRequest{Content: "  同问 ", ParentID: 11})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &dto.AuthorResponse{ID: 7, Name: "alice"}, result.Author)
+	repo.AssertCalled(t, "Create", mock.MatchedBy(func(c *models.Comment) bool {
+		return c.RootID == 10 && c.ParentID == 11 && c.Content == "同问" && c.UserID == 7
+	}))
+}
+
+func TestCommentService_CreateComment_RateLimited(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	limiter.On("Allow", "7").Return(false, nil)
+
+	// Act
+	result, err := service.CreateComment(1, 7, dto.CommentCreateRequest{Content: "刷屏"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrCommentRateLimited, err)
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestCommentService_CreateComment_LimiterFailureAllows(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	limiter.On("Allow", "7").Return(false, errors.New("redis down"))
+	repo.On("Create", mock.AnythingOfType("*models.Comment")).Return(nil)
+	repo.On("FindByID", uint(0)).Return(&models.Comment{ModID: 1, UserID: 7, Content: "hi"}, nil)
+
+	// Act
+	_, err := service.CreateComment(1, 7, dto.CommentCreateRequest{Content: "hi"})
+
+	// Assert
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCommentService_CreateComment_ParentOfOtherMod(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	repo.On("FindByID", uint(5)).Return(&models.Comment{ID: 5, ModID: 2}, nil)
+
+	// Act
+	result, err := service.CreateComment(1, 7, dto.CommentCreateRequest{Content: "hi", ParentID: 5})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrCommentNotFound, err)
+	limiter.AssertNotCalled(t, "Allow", mock.Anything)
+}
+
+func TestCommentService_UpdateComment_NotOwner(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	repo.On("FindByID", uint(5)).Return(&models.Comment{ID: 5, UserID: 8, Content: "old"}, nil)
+
+	// Act
+	result, err := service.UpdateComment(5, 7, dto.CommentUpdateRequest{Content: "new"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrNotCommentOwner, err)
+	repo.AssertNotCalled(t, "UpdateContent", mock.Anything)
+}
+
+func TestCommentService_DeleteComment_KeepsThread(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	comment := &models.Comment{ID: 5, UserID: 7, Content: "old", ReplyCount: 2}
+	repo.On("FindByID", uint(5)).Return(comment, nil)
+	repo.On("SoftDelete", comment).Return(nil)
+
+	// Act
+	err := service.DeleteComment(5, 7)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.CommentDeletedByAuthor, comment.DeletedBy)
+	repo.AssertExpectations(t)
+}
+
+func TestCommentService_RemoveComment_NotFound(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	repo.On("FindByID", uint(5)).Return(nil, gorm.ErrRecordNotFound)
+
+	// Act
+	err := service.RemoveComment(5)
+
+	// Assert
+	assert.Equal(t, bizErr.ErrCommentNotFound, err)
+}
+
+func TestCommentService_ListReplies_HidesDeleted(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	deletedAt := time.Now()
+	alice := &models.User{ID: models.ID{ID: 7}, Name: "alice"}
+	bob := &models.User{ID: models.ID{ID: 8}, Name: "bob"}
+	repo.On("FindByID", uint(10)).Return(&models.Comment{ID: 10, ModID: 1}, nil)
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	repo.On("FindReplies", uint(10), "", 20).Return(&repository.CommentPage{
+		Comments: []models.Comment{
+			{ID: 11, ModID: 1, RootID: 10, ParentID: 10, UserID: 8, User: bob, Content: "已删除的内容",
+				DeletedAt: &deletedAt, DeletedBy: models.CommentDeletedByModerator},
+			{ID: 12, ModID: 1, RootID: 10, ParentID: 11, UserID: 7, User: alice, Content: "回复",
+				Parent: &models.Comment{ID: 11, User: bob, DeletedAt: &deletedAt}},
+		},
+		NextCursor: "next",
+	}, nil)
+
+	// Act
+	result, err := service.ListReplies(10, dto.CommentListRequest{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.HasMore)
+	assert.True(t, result.List[0].Deleted)
+	assert.Empty(t, result.List[0].Content)
+	assert.Nil(t, result.List[0].Author)
+	assert.Equal(t, models.CommentDeletedByModerator, result.List[0].DeletedBy)
+	assert.Equal(t, "回复", result.List[1].Content)
+	assert.Nil(t, result.List[1].ReplyTo) // 被回复的评论已删除
+}
+
+func TestCommentService_ListComments_InvalidCursor(t *testing.T) {
+	// Arrange
+	repo := new(MockCommentRepository)
+	modRepo := new(MockModRepository)
+	limiter := new(MockLimiter)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewCommentService(repo, modRepo, limiter, logger)
+
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	repo.On("FindThreads", uint(1), "bad", 20).Return(nil, repository.ErrInvalidCursor)
+
+	// Act
+	result, err := service.ListComments(1, dto.CommentListRequest{Cursor: "bad"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrInvalidCursor, err)
+}
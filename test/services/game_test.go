@@ -0,0 +1,425 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockGameRepository 游戏仓储 Mock
+type MockGameRepository struct {
+	mock.Mock
+}
+
+func (m *MockGameRepository) FindByID(id uint) (*models.Game, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Game), args.Error(1)
+}
+
+func (m *MockGameRepository) UpdateCover(id uint, url string) error {
+	args := m.Called(id, url)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) Stats(gameID uint) (*repository.GameStats, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.GameStats), args.Error(1)
+}
+
+func (m *MockGameRepository) TopCategories(gameID uint, limit int) ([]repository.CategoryCount, error) {
+	args := m.Called(gameID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.CategoryCount), args.Error(1)
+}
+
+func (m *MockGameRepository) NewestMods(gameID uint, limit int) ([]models.Mod, error) {
+	args := m.Called(gameID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Mod), args.Error(1)
+}
+
+func (m *MockGameRepository) FindVersions(gameID uint) ([]models.GameVersion, error) {
+	args := m.Called(gameID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.GameVersion), args.Error(1)
+}
+
+func (m *MockGameRepository) FindVersionByID(id uint) (*models.GameVersion, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.GameVersion), args.Error(1)
+}
+
+func (m *MockGameRepository) CreateVersion(version *models.GameVersion) error {
+	args := m.Called(version)
+	return args.Error(0)
+}
+
+func (m *MockGameRepository) DeleteVersion(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// MockModReleaseRepository 发布版本仓储 Mock
+type MockModReleaseRepository struct {
+	mock.Mock
+}
+
+func (m *MockModReleaseRepository) FindByModID(modID uint) ([]models.ModRelease, error) {
+	args := m.Called(modID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ModRelease), args.Error(1)
+}
+
+func (m *MockModReleaseRepository) FindByID(id uint) (*models.ModRelease, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ModRelease), args.Error(1)
+}
+
+func (m *MockModReleaseRepository) Create(release *models.ModRelease) error {
+	args := m.Called(release)
+	return args.Error(0)
+}
+
+func (m *MockModReleaseRepository) Delete(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func TestGameService_GetGameDetail_Success(t *testing.T) {
+	// Arrange
+	gameRepo := new(MockGameRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewGameService(gameRepo, nil, logger)
+
+	game := &models.Game{ID: 1, Name: "Skyrim", Versions: []models.GameVersion{{ID: 2, GameID: 1, Name: "1.6.1170"}}}
+	gameRepo.On("FindByID", uint(1)).Return(game, nil)
+	gameRepo.On("Stats", uint(1)).Return(&repository.GameStats{ModCount: 3, TotalDownloads: 1500, TotalViews: 9000}, nil)
+	gameRepo.On("TopCategories", uint(1), 5).Return([]repository.CategoryCount{{ID: 4, Name: "UI", ModCount: 2}}, nil)
+	gameRepo.On("NewestMods", uint(1), 5).Return([]models.Mod{{ID: 7, Name: "SkyUI", Game: *game}}, nil)
+
+	// Act
+	result, err := service.GetGameDetail(1, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Skyrim", result.Name)
+	assert.Len(t, result.Versions, 1)
+	assert.Equal(t, int64(3), result.Stats.ModCount)
+	assert.Equal(t, int64(1500), result.Stats.TotalDownloads)
+	assert.Equal(t, "UI", result.TopCategories[0].Name)
+	assert.Equal(t, "SkyUI", result.NewestMods[0].Name)
+	gameRepo.AssertExpectations(t)
+}
+
+func TestGameService_GetGameDetail_NotFound(t *testing.T) {
+	// Arrange
+	gameRepo := new(MockGameRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewGameService(gameRepo, nil, logger)
+
+	gameRepo.On("FindByID", uint(99)).Return(nil, gorm.ErrRecordNotFound)
+
+	// Act
+	result, err := service.GetGameDetail(99, "")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, bizErr.ErrGameNotFound)
+}
+
+func TestGameService_SearchGameMods_ForcesGame(t *testing.T) {
+	// Arrange
+	gameRepo := new(MockGameRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewGameService(gameRepo, services.NewModService(modRepo, nil, nil, logger), logger)
+
+	gameRepo.On("FindByID", uint(1)).Return(&models.Game{ID: 1}, nil)
+	modRepo.On("Search", mock.MatchedBy(func(c repository.ModSearchCriteria) bool {
+		return assert.ObjectsAreEqual([]uint{1}, c.GameIDs) && assert.ObjectsAreEqual([]uint{5}, c.GameVersionIDs)
+	})).Return(&repository.ModSearchResult{Page: 1, PageSize: 20}, nil)
+
+	// Act
+	_, err := service.SearchGameMods(1, dto.ModSearchRequest{GameID: "2,3", GameVersionID: "5", Page: 1, PageSize: 20}, "")
+
+	// Assert
+	assert.NoError(t, err)
+	modRepo.AssertExpectations(t)
+}
+
+func TestGameService_SearchGameMods_GameVersionConstraint(t *testing.T) {
+	// Arrange
+	gameRepo := new(MockGameRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewGameService(gameRepo, services.NewModService(modRepo, nil, nil, logger), logger)
+
+	gameRepo.On("FindByID", uint(1)).Return(&models.Game{ID: 1}, nil)
+	modRepo.On("FindGameVersionsByGames", []uint{1}).Return([]models.GameVersion{
+		{ID: 2, GameID: 1, Name: "1.5.97"},
+		{ID: 3, GameID: 1, Name: "1.6.640"},
+		{ID: 4, GameID: 1, Name: "1.6.1170"},
+		{ID: 5, GameID: 1, Name: "VR"},
+	}, nil)
+	modRepo.On("Search", mock.MatchedBy(func(c repository.ModSearchCriteria) bool {
+		return assert.ObjectsAreEqual([]uint{3, 4}, c.GameVersionIDs)
+	})).Return(&repository.ModSearchResult{Page: 1, PageSize: 20}, nil)
+
+	// Act
+	_, err := service.SearchGameMods(1, dto.ModSearchRequest{GameVersion: ">=1.6, <1.7", Page: 1, PageSize: 20}, "")
+
+	// Assert
+	assert.NoError(t, err)
+	modRepo.AssertExpectations(t)
+}
+
+func TestModService_SearchMods_GameVersionConstraintErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  dto.ModSearchRequest
+	}{
+		{"未指定游戏", dto.ModSearchRequest{GameVersion: ">=1.6"}},
+		{"约束格式错误", dto.ModSearchRequest{GameID: "1", GameVersion: ">=abc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modRepo := new(MockModRepository)
+			logger, _ := zap.NewDevelopment()
+			service := services.NewModService(modRepo, nil, nil, logger)
+
+			result, err := service.SearchMods(tt.req, "")
+
+			assert.Nil(t, result)
+			assert.Error(t, err)
+			modRepo.AssertNotCalled(t, "Search", mock.Anything)
+		})
+	}
+}
+
+func TestModService_SearchMods_NoMatchingGameVersion(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(modRepo, nil, nil, logger)
+
+	modRepo.On("FindGameVersionsByGames", []uint{1}).Return([]models.GameVersion{{ID: 2, GameID: 1, Name: "1.5.97"}}, nil)
+	modRepo.On("Search", mock.MatchedBy(func(c repository.ModSearchCriteria) bool {
+		return assert.ObjectsAreEqual([]uint{0}, c.GameVersionIDs)
+	})).Return(&repository.ModSearchResult{Page: 1, PageSize: 20}, nil)
+
+	// Act
+	result, err := service.SearchMods(dto.ModSearchRequest{GameID: "1", GameVersionID: "2", GameVersion: "^1.6"}, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, result.List)
+	modRepo.AssertExpectations(t)
+}
+
+func TestGameService_GetGameVersions_SortedByVersion(t *testing.T) {
+	// Arrange
+	gameRepo := new(MockGameRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewGameService(gameRepo, nil, logger)
+
+	gameRepo.On("FindByID", uint(1)).Return(&models.Game{ID: 1}, nil)
+	gameRepo.On("FindVersions", uint(1)).Return([]models.GameVersion{
+		{ID: 4, Name: "VR"},
+		{ID: 3, Name: "1.5.97"},
+		{ID: 2, Name: "1.6.1170"},
+		{ID: 1, Name: "1.6.640"},
+	}, nil)
+
+	// Act
+	result, err := service.GetGameVersions(1)
+
+	// Assert
+	assert.NoError(t, err)
+	var names []string
+	for _, v := range result.List {
+		names = append(names, v.Name)
+	}
+	assert.Equal(t, []string{"1.6.1170", "1.6.640", "1.5.97", "VR"}, names)
+}
+
+func TestGameService_CreateGameVersion_Duplicate(t *testing.T) {
+	// Arrange
+	gameRepo := new(MockGameRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewGameService(gameRepo, nil, logger)
+
+	gameRepo.On("FindByID", uint(1)).Return(&models.Game{ID: 1, Versions: []models.GameVersion{{ID: 2, GameID: 1, Name: "1.5.97"}}}, nil)
+
+	// Act
+	result, err := service.CreateGameVersion(1, dto.GameVersionSaveRequest{Name: "1.5.97"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, bizErr.ErrGameVersionExists)
+	gameRepo.AssertNotCalled(t, "CreateVersion", mock.Anything)
+}
+
+func TestGameService_DeleteGameVersion_WrongGame(t *testing.T) {
+	// Arrange
+	gameRepo := new(MockGameRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewGameService(gameRepo, nil, logger)
+
+	gameRepo.On("FindVersionByID", uint(2)).Return(&models.GameVersion{ID: 2, GameID: 3}, nil)
+
+	// Act
+	err := service.DeleteGameVersion(1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, bizErr.ErrGameVersionNotFound)
+	gameRepo.AssertNotCalled(t, "DeleteVersion", mock.Anything)
+}
+
+func TestModReleaseService_CreateRelease_Success(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModReleaseService(modRepo, releaseRepo, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, GameID: 1, OwnerID: 7}, nil)
+	releaseRepo.On("FindByModID", uint(1)).Return([]models.ModRelease{{ID: 1, ModID: 1, Version: "5.1"}}, nil)
+	modRepo.On("FindGameVersionsByIDs", []uint{2}).Return([]models.GameVersion{{ID: 2, GameID: 1, Name: "1.6.1170"}}, nil)
+	releaseRepo.On("Create", mock.AnythingOfType("*models.ModRelease")).Return(nil)
+
+	// Act
+	result, err := service.CreateRelease(1, 7, dto.ModReleaseSaveRequest{Version: "5.2", GameVersionIDs: []uint{2}})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "5.2", result.Version)
+	assert.Len(t, result.GameVersions, 1)
+	releaseRepo.AssertExpectations(t)
+}
+
+func TestModReleaseService_CreateRelease_Duplicate(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModReleaseService(modRepo, releaseRepo, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, GameID: 1, OwnerID: 7}, nil)
+	releaseRepo.On("FindByModID", uint(1)).Return([]models.ModRelease{{ID: 1, ModID: 1, Version: "5.1"}}, nil)
+
+	// Act
+	result, err := service.CreateRelease(1, 7, dto.ModReleaseSaveRequest{Version: "5.1"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, bizErr.ErrReleaseExists)
+}
+
+func TestModReleaseService_CreateRelease_InvalidVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected error
+	}{
+		{"不以数字开头", "latest", bizErr.ErrReleaseVersionInvalid},
+		{"规范化后重复", "5.1.0", bizErr.ErrReleaseExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modRepo := new(MockModRepository)
+			releaseRepo := new(MockModReleaseRepository)
+			logger, _ := zap.NewDevelopment()
+			service := services.NewModReleaseService(modRepo, releaseRepo, logger)
+
+			modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, GameID: 1, OwnerID: 7}, nil)
+			releaseRepo.On("FindByModID", uint(1)).Return([]models.ModRelease{{ID: 1, ModID: 1, Version: "5.1"}}, nil)
+
+			result, err := service.CreateRelease(1, 7, dto.ModReleaseSaveRequest{Version: tt.version})
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.expected)
+			releaseRepo.AssertNotCalled(t, "Create", mock.Anything)
+		})
+	}
+}
+
+func TestModReleaseService_ListReleases_SortedByVersion(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModReleaseService(modRepo, releaseRepo, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	// 仓储按发布时间从新到旧返回
+	releaseRepo.On("FindByModID", uint(1)).Return([]models.ModRelease{
+		{ID: 5, Version: "2.0-beta1"},
+		{ID: 4, Version: "1.4.1"},
+		{ID: 3, Version: "1.10"},
+		{ID: 2, Version: "1.9"},
+		{ID: 1, Version: "1.10-rc2"},
+	}, nil)
+
+	// Act
+	result, err := service.ListReleases(1)
+
+	// Assert
+	assert.NoError(t, err)
+	var versions []string
+	for _, r := range result.List {
+		versions = append(versions, r.Version)
+	}
+	assert.Equal(t, []string{"2.0-beta1", "1.10", "1.10-rc2", "1.9", "1.4.1"}, versions)
+	assert.Equal(t, "1.10", result.Latest)
+}
+
+func TestModReleaseService_CreateRelease_VersionOfOtherGame(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	releaseRepo := new(MockModReleaseRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModReleaseService(modRepo, releaseRepo, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, GameID: 1, OwnerID: 7}, nil)
+	releaseRepo.On("FindByModID", uint(1)).Return([]models.ModRelease{}, nil)
+	modRepo.On("FindGameVersionsByIDs", []uint{9}).Return([]models.GameVersion{{ID: 9, GameID: 2, Name: "1.0"}}, nil)
+
+	// Act
+	result, err := service.CreateRelease(1, 7, dto.ModReleaseSaveRequest{Version: "5.2", GameVersionIDs: []uint{9}})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, bizErr.ErrGameVersionNotFound)
+	releaseRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
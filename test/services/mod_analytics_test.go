@@ -0,0 +1,177 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockModAnalyticsRepository 统计汇总仓储 Mock
+type MockModAnalyticsRepository struct {
+	mock.Mock
+}
+
+func (m *MockModAnalyticsRepository) Rollup(since time.Time) (int64, error) {
+	args := m.Called(since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockModAnalyticsRepository) PurgeEvents(before time.Time) (int64, error) {
+	args := m.Called(before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockModAnalyticsRepository) FindDailyStats(modID uint, from, to time.Time) ([]models.ModDailyStat, error) {
+	args := m.Called(modID, from, to)
+	return args.Get(0).([]models.ModDailyStat), args.Error(1)
+}
+
+func (m *MockModAnalyticsRepository) SumDailyStats(from, to time.Time) ([]models.ModDailyStat, error) {
+	args := m.Called(from, to)
+	return args.Get(0).([]models.ModDailyStat), args.Error(1)
+}
+
+func (m *MockModAnalyticsRepository) FindVersionDownloads(modID uint, from, to time.Time) ([]repository.VersionDownloads, error) {
+	args := m.Called(modID, from, to)
+	return args.Get(0).([]repository.VersionDownloads), args.Error(1)
+}
+
+func (m *MockModAnalyticsRepository) TopMods(from, to time.Time, limit int) ([]repository.ModStatTotal, error) {
+	args := m.Called(from, to, limit)
+	return args.Get(0).([]repository.ModStatTotal), args.Error(1)
+}
+
+func (m *MockModAnalyticsRepository) TopGames(from, to time.Time, limit int) ([]repository.GameStatTotal, error) {
+	args := m.Called(from, to, limit)
+	return args.Get(0).([]repository.GameStatTotal), args.Error(1)
+}
+
+func statsDay(value string) time.Time {
+	day, _ := time.ParseInLocation("2006-01-02", value, time.Local)
+	return day
+}
+
+func TestModAnalyticsService_ModStats_DailySeriesFillsGaps(t *testing.T) {
+	// Arrange
+	repo := new(MockModAnalyticsRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewModAnalyticsService(repo, modRepo, 0, 0, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	from, to := statsDay("2026-10-05"), statsDay("2026-10-07")
+	repo.On("FindDailyStats", uint(1), from, to).Return([]models.ModDailyStat{
+		{ModID: 1, Date: statsDay("2026-10-05"), Downloads: 3, Views: 10, UniqueUsers: 4},
+		{ModID: 1, Date: statsDay("2026-10-07"), Downloads: 5, Views: 2, UniqueUsers: 5},
+	}, nil)
+	repo.On("FindVersionDownloads", uint(1), from, to).Return([]repository.VersionDownloads{
+		{Version: "1.1.0", Downloads: 6}, {Version: "1.0.0", Downloads: 2},
+	}, nil)
+
+	// Act
+	result, err := svc.ModStats(1, 7, dto.StatsRangeRequest{From: "2026-10-05", To: "2026-10-07"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, services.StatsIntervalDay, result.Interval)
+	assert.Len(t, result.Points, 3)
+	assert.Equal(t, "2026-10-06", result.Points[1].Date)
+	assert.Equal(t, int64(0), result.Points[1].Downloads)
+	assert.Equal(t, dto.StatsCountResponse{Downloads: 8, Views: 12, UniqueUsers: 9}, result.Totals)
+	assert.Equal(t, "1.1.0", result.Versions[0].Version)
+}
+
+func TestModAnalyticsService_ModStats_WeeklyBuckets(t *testing.T) {
+	// Arrange：2026-10-07 为周三，区间跨越两周
+	repo := new(MockModAnalyticsRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewModAnalyticsService(repo, modRepo, 0, 0, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	from, to := statsDay("2026-10-07"), statsDay("2026-10-13")
+	repo.On("FindDailyStats", uint(1), from, to).Return([]models.ModDailyStat{
+		{ModID: 1, Date: statsDay("2026-10-07"), Downloads: 1},
+		{ModID: 1, Date: statsDay("2026-10-11"), Downloads: 2},
+		{ModID: 1, Date: statsDay("2026-10-12"), Downloads: 4},
+	}, nil)
+	repo.On("FindVersionDownloads", uint(1), from, to).Return([]repository.VersionDownloads{}, nil)
+
+	// Act
+	result, err := svc.ModStats(1, 7, dto.StatsRangeRequest{From: "2026-10-07", To: "2026-10-13", Interval: "week"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Points, 2)
+	assert.Equal(t, "2026-10-05", result.Points[0].Date)
+	assert.Equal(t, int64(3), result.Points[0].Downloads)
+	assert.Equal(t, "2026-10-12", result.Points[1].Date)
+	assert.Equal(t, int64(4), result.Points[1].Downloads)
+}
+
+func TestModAnalyticsService_ModStats_NotEditor(t *testing.T) {
+	// Arrange
+	repo := new(MockModAnalyticsRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewModAnalyticsService(repo, modRepo, 0, 0, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+
+	// Act
+	_, err := svc.ModStats(1, 8, dto.StatsRangeRequest{})
+
+	// Assert
+	assert.Equal(t, bizErr.ErrNotModEditor, err)
+	repo.AssertNotCalled(t, "FindDailyStats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModAnalyticsService_Dashboard_InvalidRange(t *testing.T) {
+	// Arrange
+	repo := new(MockModAnalyticsRepository)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewModAnalyticsService(repo, nil, 0, 0, logger)
+
+	// Act
+	_, reversed := svc.Dashboard(dto.StatsRangeRequest{From: "2026-10-10", To: "2026-10-01"})
+	_, tooLong := svc.Dashboard(dto.StatsRangeRequest{From: "2025-01-01", To: "2026-10-01"})
+
+	// Assert
+	assert.Equal(t, bizErr.ErrStatsRangeInvalid, reversed)
+	assert.Equal(t, bizErr.ErrStatsRangeTooLong, tooLong)
+}
+
+func TestModAnalyticsService_Rollup_KeepsEventsInsideWindow(t *testing.T) {
+	// Arrange：保留期短于汇总窗口时，不清理窗口内的事件
+	repo := new(MockModAnalyticsRepository)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewModAnalyticsService(repo, nil, 3, 1, logger)
+
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.Local)
+	since := time.Date(2026, 10, 11, 0, 0, 0, 0, time.Local)
+	repo.On("Rollup", since).Return(int64(12), nil)
+	repo.On("PurgeEvents", since).Return(int64(40), nil)
+
+	// Act
+	err := svc.Rollup(now)
+
+	// Assert
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestVisitorKey(t *testing.T) {
+	// 登录用户与设备无关，匿名访客按 IP 与 User-Agent 区分
+	assert.Equal(t, services.VisitorKey(7, "1.1.1.1", "a"), services.VisitorKey(7, "2.2.2.2", "b"))
+	assert.NotEqual(t, services.VisitorKey(0, "1.1.1.1", "a"), services.VisitorKey(0, "1.1.1.1", "b"))
+	assert.Len(t, services.VisitorKey(0, "1.1.1.1", "a"), 64)
+}
@@ -0,0 +1,319 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockModDependencyRepository Mod 依赖关系仓储 Mock
+type MockModDependencyRepository struct {
+	mock.Mock
+}
+
+func (m *MockModDependencyRepository) FindByID(id uint) (*models.ModDependency, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ModDependency), args.Error(1)
+}
+
+func (m *MockModDependencyRepository) FindByModIDs(modIDs []uint) ([]models.ModDependency, error) {
+	args := m.Called(modIDs)
+	if fn, ok := args.Get(0).(func([]uint) []models.ModDependency); ok {
+		return fn(modIDs), args.Error(1)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ModDependency), args.Error(1)
+}
+
+func (m *MockModDependencyRepository) Create(dep *models.ModDependency) error {
+	args := m.Called(dep)
+	return args.Error(0)
+}
+
+func (m *MockModDependencyRepository) Update(dep *models.ModDependency) error {
+	args := m.Called(dep)
+	return args.Error(0)
+}
+
+func (m *MockModDependencyRepository) Delete(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// dependency 构造依赖关系（被依赖的 Mod 取自 mods）
+func dependency(mods []models.Mod, modID, dependsOnID uint, depType, constraint string) models.ModDependency {
+	dep := models.ModDependency{ModID: modID, DependsOnID: dependsOnID, Type: depType, VersionConstraint: constraint}
+	for _, mod := range mods {
+		if mod.ID == dependsOnID {
+			dep.DependsOn = mod
+		}
+	}
+	return dep
+}
+
+// mockModsByID 让 FindByID 返回 mods 中对应的 Mod
+func mockModsByID(modRepo *MockModRepository, mods []models.Mod) {
+	for i := range mods {
+		modRepo.On("FindByID", mods[i].ID).Return(&mods[i], nil).Maybe()
+	}
+}
+
+// mockDependencyGraph 让 FindByModIDs 按内存中的依赖关系返回数据（依赖关系 ID 按顺序从 1 开始）
+func mockDependencyGraph(depRepo *MockModDependencyRepository, deps []models.ModDependency) {
+	for i := range deps {
+		deps[i].ID = uint(i + 1)
+	}
+	depRepo.On("FindByModIDs", mock.Anything).Return(func(ids []uint) []models.ModDependency {
+		var result []models.ModDependency
+		for _, dep := range deps {
+			for _, id := range ids {
+				if dep.ModID == id {
+					result = append(result, dep)
+				}
+			}
+		}
+		return result
+	}, nil).Maybe()
+}
+
+func skyrimMods() []models.Mod {
+	return []models.Mod{
+		{ID: 1, Name: "SkyUI", Version: "5.2SE", OwnerID: 7},
+		{ID: 2, Name: "SKSE64", Version: "2.2.3", OwnerID: 7},
+		{ID: 3, Name: "Address Library", Version: "11", OwnerID: 7},
+		{ID: 4, Name: "MCM Helper", Version: "1.4.0", OwnerID: 7},
+		{ID: 5, Name: "Legacy UI", Version: "1.0", OwnerID: 7},
+	}
+}
+
+func TestModDependencyService_Resolve_TopologicalOrder(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 2, models.DependencyRequired, ">=2.2, <3"),
+		dependency(mods, 1, 4, models.DependencyOptional, ""),
+		dependency(mods, 2, 3, models.DependencyRequired, ""),
+		dependency(mods, 4, 2, models.DependencyRequired, ""),
+	})
+
+	// Act
+	result, err := service.ResolveDependencies(1, false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Dependencies, 2)
+	assert.Equal(t, "SKSE64", result.Dependencies[0].DependsOnName)
+
+	var names []string
+	for _, item := range result.InstallOrder {
+		names = append(names, item.Name)
+	}
+	assert.Equal(t, []string{"Address Library", "SKSE64", "SkyUI"}, names)
+}
+
+func TestModDependencyService_Resolve_IncludeOptional(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 2, models.DependencyRequired, ""),
+		dependency(mods, 1, 4, models.DependencyOptional, ""),
+		dependency(mods, 4, 3, models.DependencyRequired, ""),
+	})
+
+	// Act
+	result, err := service.ResolveDependencies(1, true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []dto.ModInstallItemResponse{
+		{ID: 2, Name: "SKSE64", Version: "2.2.3"},
+		{ID: 3, Name: "Address Library", Version: "11", Optional: true},
+		{ID: 4, Name: "MCM Helper", Version: "1.4.0", Optional: true},
+		{ID: 1, Name: "SkyUI", Version: "5.2SE"},
+	}, result.InstallOrder)
+}
+
+func TestModDependencyService_Resolve_Cycle(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 2, models.DependencyRequired, ""),
+		dependency(mods, 2, 3, models.DependencyRequired, ""),
+		dependency(mods, 3, 2, models.DependencyRequired, ""),
+	})
+
+	// Act
+	result, err := service.ResolveDependencies(1, false)
+
+	// Assert
+	assert.Nil(t, result)
+	var be *bizErr.BizError
+	assert.ErrorAs(t, err, &be)
+	assert.Equal(t, bizErr.CodeDependencyCycle, be.Code)
+	assert.Contains(t, be.Message, "SKSE64 -> Address Library -> SKSE64")
+}
+
+func TestModDependencyService_Resolve_Conflicts(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 2, models.DependencyRequired, ">=3"),
+		dependency(mods, 1, 5, models.DependencyIncompatible, ""),
+		dependency(mods, 2, 5, models.DependencyRequired, ""),
+	})
+
+	// Act
+	result, err := service.ResolveDependencies(1, false)
+
+	// Assert
+	assert.Nil(t, result)
+	var be *bizErr.BizError
+	assert.ErrorAs(t, err, &be)
+	assert.Equal(t, bizErr.CodeDependencyConflict, be.Code)
+	assert.Contains(t, be.Message, "SkyUI 需要 SKSE64 >=3，当前版本为 2.2.3")
+	assert.Contains(t, be.Message, "SkyUI 与 Legacy UI 不兼容")
+}
+
+func TestModDependencyService_Create_Success(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 2, 3, models.DependencyRequired, ""),
+	})
+	depRepo.On("Create", mock.AnythingOfType("*models.ModDependency")).Return(nil)
+
+	// Act
+	result, err := service.CreateDependency(1, 7, dto.ModDependencySaveRequest{
+		DependsOnID:       2,
+		Type:              models.DependencyRequired,
+		VersionConstraint: " >=2.2 ",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "SKSE64", result.DependsOnName)
+	assert.Equal(t, ">=2.2", result.VersionConstraint)
+	depRepo.AssertExpectations(t)
+}
+
+func TestModDependencyService_Create_Invalid(t *testing.T) {
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+	mockDependencyGraph(depRepo, []models.ModDependency{
+		dependency(mods, 1, 2, models.DependencyRequired, ""),
+		dependency(mods, 2, 3, models.DependencyRequired, ""),
+	})
+
+	tests := []struct {
+		name string
+		req  dto.ModDependencySaveRequest
+		code int
+	}{
+		{"自身依赖", dto.ModDependencySaveRequest{DependsOnID: 3, Type: models.DependencyRequired}, bizErr.CodeDependencyInvalid},
+		{"版本约束错误", dto.ModDependencySaveRequest{DependsOnID: 1, Type: models.DependencyRequired, VersionConstraint: ">=abc"}, bizErr.CodeDependencyInvalid},
+		{"形成循环", dto.ModDependencySaveRequest{DependsOnID: 1, Type: models.DependencyOptional}, bizErr.CodeDependencyCycle},
+		{"重复声明", dto.ModDependencySaveRequest{DependsOnID: 2, Type: models.DependencyRequired}, bizErr.CodeDependencyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modID := uint(3)
+			if tt.code == bizErr.CodeDependencyExists {
+				modID = 1
+			}
+
+			result, err := service.CreateDependency(modID, 7, tt.req)
+
+			assert.Nil(t, result)
+			var be *bizErr.BizError
+			assert.ErrorAs(t, err, &be)
+			assert.Equal(t, tt.code, be.Code)
+		})
+	}
+	depRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestModDependencyService_Delete_WrongMod(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+	depRepo.On("FindByID", uint(7)).Return(&models.ModDependency{ID: 7, ModID: 2}, nil)
+
+	// Act
+	err := service.DeleteDependency(1, 7, 7)
+
+	// Assert
+	assert.Equal(t, bizErr.ErrDependencyNotFound, err)
+	depRepo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestModDependencyService_Create_NotEditor(t *testing.T) {
+	// Arrange
+	mods := skyrimMods()
+	modRepo := new(MockModRepository)
+	depRepo := new(MockModDependencyRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModDependencyService(modRepo, depRepo, logger)
+
+	mockModsByID(modRepo, mods)
+
+	// Act
+	result, err := service.CreateDependency(1, 8, dto.ModDependencySaveRequest{DependsOnID: 2, Type: models.DependencyRequired})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrNotModEditor, err)
+	depRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
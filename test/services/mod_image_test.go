@@ -0,0 +1,365 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/imaging"
+	"gin-web/pkg/storage"
+)
+
+// MockModImageRepository 截图仓储 Mock
+type MockModImageRepository struct {
+	mock.Mock
+}
+
+func (m *MockModImageRepository) Create(image *models.ModImage) error {
+	args := m.Called(image)
+	return args.Error(0)
+}
+
+func (m *MockModImageRepository) FindByID(id uint) (*models.ModImage, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.ModImage), args.Error(1)
+}
+
+func (m *MockModImageRepository) ListByMod(modID uint) ([]models.ModImage, error) {
+	args := m.Called(modID)
+	return args.Get(0).([]models.ModImage), args.Error(1)
+}
+
+func (m *MockModImageRepository) CountByMod(modID uint) (int64, error) {
+	args := m.Called(modID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockModImageRepository) Update(image *models.ModImage) error {
+	args := m.Called(image)
+	return args.Error(0)
+}
+
+func (m *MockModImageRepository) UpdateCaption(id uint, caption string) error {
+	args := m.Called(id, caption)
+	return args.Error(0)
+}
+
+func (m *MockModImageRepository) Reorder(modID uint, ids []uint) error {
+	args := m.Called(modID, ids)
+	return args.Error(0)
+}
+
+func (m *MockModImageRepository) Delete(image *models.ModImage) error {
+	args := m.Called(image)
+	return args.Error(0)
+}
+
+func (m *MockModImageRepository) FindStale(before time.Time, limit int) ([]models.ModImage, error) {
+	args := m.Called(before, limit)
+	return args.Get(0).([]models.ModImage), args.Error(1)
+}
+
+func (m *MockModImageRepository) FindOrphans(limit int) ([]models.ModImage, error) {
+	args := m.Called(limit)
+	return args.Get(0).([]models.ModImage), args.Error(1)
+}
+
+// recordingImageQueue 记录投递的截图 ID
+type recordingImageQueue struct {
+	ids []uint
+}
+
+func (q *recordingImageQueue) EnqueueModImage(imageID uint) error {
+	q.ids = append(q.ids, imageID)
+	return nil
+}
+
+func testPNG(t *testing.T, w, h int) []byte {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 64, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func newModImageService(repo *MockModImageRepository, modRepo *MockModRepository, store storage.Storage, queue services.ModImageQueue) *services.ModImageService {
+	logger, _ := zap.NewDevelopment()
+	opts := services.ImageOptions{MaxUploadSize: 1 << 20, MaxPerMod: 3}
+	return services.NewModImageService(repo, modRepo, nil, store, queue, opts, logger)
+}
+
+func TestModImageService_Upload(t *testing.T) {
+	// Arrange
+	repo := new(MockModImageRepository)
+	modRepo := new(MockModRepository)
+	store := storage.NewMemoryStorage("/uploads")
+	queue := &recordingImageQueue{}
+	service := newModImageService(repo, modRepo, store, queue)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	repo.On("CountByMod", uint(1)).Return(int64(0), nil)
+	repo.On("Create", mock.MatchedBy(func(image *models.ModImage) bool {
+		return image.ModID == 1 && image.Status == models.ModImageStatusPending &&
+			image.Width == 64 && image.Height == 48 && image.ContentType == "image/png" &&
+			strings.HasPrefix(image.OriginalKey, "mods/1/images/") && strings.HasSuffix(image.OriginalKey, "/original.png")
+	})).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.ModImage).ID = 5
+	}).Return(nil)
+
+	// Act
+	result, err := service.Upload(1, 7, bytes.NewReader(testPNG(t, 64, 48)), "主菜单")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), result.ID)
+	assert.Equal(t, "主菜单", result.Caption)
+	assert.Equal(t, models.ModImageStatusPending, result.Status)
+	assert.True(t, strings.HasPrefix(result.URL, "/uploads/mods/1/images/"))
+	assert.Empty(t, result.ThumbnailURL)
+	assert.Equal(t, []uint{5}, queue.ids)
+	assert.Len(t, store.Keys(), 1)
+	repo.AssertExpectations(t)
+}
+
+func TestModImageService_Upload_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		owner    uint
+		count    int64
+		data     []byte
+		expected error
+	}{
+		{"不是 Mod 作者", 8, 0, nil, bizErr.ErrNotModEditor},
+		{"截图数量已达上限", 7, 3, nil, bizErr.ErrModImageLimit},
+		{"不支持的格式", 7, 0, []byte("BM not an image"), bizErr.ErrImageFormat},
+		{"文件过大", 7, 0, make([]byte, 1<<20+1), bizErr.ErrImageTooLarge},
+		{"空文件", 7, 0, []byte{}, bizErr.ErrImageRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockModImageRepository)
+			modRepo := new(MockModRepository)
+			store := storage.NewMemoryStorage("/uploads")
+			service := newModImageService(repo, modRepo, store, nil)
+
+			modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: tt.owner}, nil)
+			repo.On("CountByMod", uint(1)).Return(tt.count, nil)
+
+			_, err := service.Upload(1, 7, bytes.NewReader(tt.data), "")
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, store.Keys())
+			repo.AssertNotCalled(t, "Create", mock.Anything)
+		})
+	}
+}
+
+func TestModImageService_Reorder_RequiresAllImages(t *testing.T) {
+	repo := new(MockModImageRepository)
+	modRepo := new(MockModRepository)
+	service := newModImageService(repo, modRepo, storage.NewMemoryStorage(""), nil)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	repo.On("ListByMod", uint(1)).Return([]models.ModImage{{ID: 1, ModID: 1}, {ID: 2, ModID: 1}}, nil)
+
+	for _, ids := range [][]uint{{1}, {1, 1}, {1, 3}} {
+		_, err := service.Reorder(1, 7, ids)
+		assert.ErrorIs(t, err, bizErr.ErrModImageOrderInvalid, ids)
+	}
+	repo.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything)
+}
+
+func TestModImageService_Reorder(t *testing.T) {
+	repo := new(MockModImageRepository)
+	modRepo := new(MockModRepository)
+	service := newModImageService(repo, modRepo, storage.NewMemoryStorage(""), nil)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	repo.On("ListByMod", uint(1)).Return([]models.ModImage{{ID: 1, ModID: 1}, {ID: 2, ModID: 1}}, nil).Once()
+	repo.On("Reorder", uint(1), []uint{2, 1}).Return(nil)
+	repo.On("ListByMod", uint(1)).Return([]models.ModImage{{ID: 2, ModID: 1, Position: 0}, {ID: 1, ModID: 1, Position: 1}}, nil).Once()
+
+	result, err := service.Reorder(1, 7, []uint{2, 1})
+
+	require.NoError(t, err)
+	require.Len(t, result.List, 2)
+	assert.Equal(t, uint(2), result.List[0].ID)
+	repo.AssertExpectations(t)
+}
+
+func TestModImageService_Delete(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockModImageRepository)
+	modRepo := new(MockModRepository)
+	store := storage.NewMemoryStorage("")
+	service := newModImageService(repo, modRepo, store, nil)
+
+	image := &models.ModImage{ID: 5, ModID: 1, StorageKey: "mods/1/images/abc", OriginalKey: "mods/1/images/abc/original.png"}
+	for _, key := range []string{image.OriginalKey, "mods/1/images/abc/thumb.jpg", "mods/1/images/abc/large.webp", "mods/2/keep.png"} {
+		require.NoError(t, store.Put(ctx, key, strings.NewReader("x"), ""))
+	}
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	repo.On("FindByID", uint(5)).Return(image, nil)
+	repo.On("Delete", image).Return(nil)
+
+	err := service.Delete(1, 5, 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mods/2/keep.png"}, store.Keys())
+}
+
+func TestModImageService_Delete_OtherModImage(t *testing.T) {
+	repo := new(MockModImageRepository)
+	modRepo := new(MockModRepository)
+	service := newModImageService(repo, modRepo, storage.NewMemoryStorage(""), nil)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	repo.On("FindByID", uint(5)).Return(&models.ModImage{ID: 5, ModID: 2}, nil)
+	repo.On("FindByID", uint(6)).Return(nil, gorm.ErrRecordNotFound)
+
+	assert.ErrorIs(t, service.Delete(1, 5, 7), bizErr.ErrModImageNotFound)
+	assert.ErrorIs(t, service.Delete(1, 6, 7), bizErr.ErrModImageNotFound)
+	repo.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func TestModImageService_Maintain(t *testing.T) {
+	repo := new(MockModImageRepository)
+	queue := &recordingImageQueue{}
+	store := storage.NewMemoryStorage("")
+	service := newModImageService(repo, new(MockModRepository), store, queue)
+	now := time.Now()
+
+	orphan := models.ModImage{ID: 9, ModID: 4, StorageKey: "mods/4/images/x", OriginalKey: "mods/4/images/x/original.jpg"}
+	require.NoError(t, store.Put(context.Background(), orphan.OriginalKey, strings.NewReader("x"), ""))
+	repo.On("FindStale", now.Add(-10*time.Minute), mock.Anything).Return([]models.ModImage{{ID: 3}, {ID: 4}}, nil)
+	repo.On("FindOrphans", mock.Anything).Return([]models.ModImage{orphan}, nil)
+	repo.On("Delete", mock.MatchedBy(func(image *models.ModImage) bool { return image.ID == 9 })).Return(nil)
+
+	err := service.Maintain(now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uint{3, 4}, queue.ids)
+	assert.Empty(t, store.Keys())
+	repo.AssertExpectations(t)
+}
+
+func newImageProcessor(repo *MockModImageRepository, store storage.Storage) *services.ImageProcessor {
+	logger, _ := zap.NewDevelopment()
+	opts := services.ImageOptions{MaxDimension: 100, ThumbnailWidth: 40, ThumbnailHeight: 30}
+	return services.NewImageProcessor(repo, store, opts, logger)
+}
+
+func readStored(t *testing.T, store storage.Storage, key string) []byte {
+	rc, err := store.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return data
+}
+
+func TestImageProcessor_Process(t *testing.T) {
+	// Arrange
+	repo := new(MockModImageRepository)
+	store := storage.NewMemoryStorage("/uploads")
+	processor := newImageProcessor(repo, store)
+
+	image := &models.ModImage{
+		ID:          5,
+		ModID:       1,
+		Status:      models.ModImageStatusPending,
+		StorageKey:  "mods/1/images/abc",
+		OriginalKey: "mods/1/images/abc/original.png",
+	}
+	require.NoError(t, store.Put(context.Background(), image.OriginalKey, bytes.NewReader(testPNG(t, 200, 100)), "image/png"))
+	repo.On("FindByID", uint(5)).Return(image, nil)
+	repo.On("Update", image).Return(nil)
+
+	// Act
+	err := processor.Process(5)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, models.ModImageStatusReady, image.Status)
+	assert.Equal(t, "/uploads/mods/1/images/abc/thumb.jpg", image.ThumbnailURL)
+	assert.Equal(t, "/uploads/mods/1/images/abc/thumb.webp", image.ThumbnailWebPURL)
+	assert.Equal(t, "/uploads/mods/1/images/abc/large.webp", image.WebPURL)
+
+	thumb, err := imaging.Inspect(readStored(t, store, "mods/1/images/abc/thumb.jpg"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 40, thumb.Width)
+	assert.Equal(t, 20, thumb.Height)
+	assert.Equal(t, "RIFF", string(readStored(t, store, "mods/1/images/abc/large.webp")[:4]))
+}
+
+func TestImageProcessor_Process_SkipsProcessedImages(t *testing.T) {
+	repo := new(MockModImageRepository)
+	processor := newImageProcessor(repo, storage.NewMemoryStorage(""))
+
+	repo.On("FindByID", uint(5)).Return(&models.ModImage{ID: 5, Status: models.ModImageStatusReady}, nil)
+	repo.On("FindByID", uint(6)).Return(nil, gorm.ErrRecordNotFound)
+
+	assert.NoError(t, processor.Process(5))
+	assert.NoError(t, processor.Process(6))
+	repo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestImageProcessor_Process_MarksUndecodableImagesFailed(t *testing.T) {
+	repo := new(MockModImageRepository)
+	store := storage.NewMemoryStorage("")
+	processor := newImageProcessor(repo, store)
+
+	image := &models.ModImage{ID: 5, Status: models.ModImageStatusPending, StorageKey: "k", OriginalKey: "k/original.png"}
+	require.NoError(t, store.Put(context.Background(), image.OriginalKey, strings.NewReader("\x89PNG broken"), ""))
+	repo.On("FindByID", uint(5)).Return(image, nil)
+	repo.On("Update", image).Return(nil)
+
+	err := processor.Process(5)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.ModImageStatusFailed, image.Status)
+	assert.NotEmpty(t, image.Error)
+}
+
+func TestToModItemResponse_ThumbnailFallback(t *testing.T) {
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	mods := []models.Mod{
+		{ID: 1, ImageURL: "https://example.com/cover.png", ThumbnailURL: "/uploads/mods/1/images/a/thumb.jpg"},
+		{ID: 2, ImageURL: "https://example.com/cover.png"},
+	}
+	mockRepo.On("Search", mock.Anything).Return(&repository.ModSearchResult{Mods: mods, Total: 2, HasTotal: true, Page: 1, PageSize: 10}, nil)
+
+	result, err := service.SearchMods(dto.ModSearchRequest{}, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "/uploads/mods/1/images/a/thumb.jpg", result.List[0].ThumbnailURL)
+	assert.Equal(t, "https://example.com/cover.png", result.List[1].ThumbnailURL)
+}
@@ -8,11 +8,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
+	"gin-web/app/amqp/event"
 	"gin-web/app/dto"
 	"gin-web/app/models"
 	"gin-web/app/services"
 	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
 )
 
 // MockModRepository Mod 仓储 Mock
@@ -36,8 +39,50 @@ func (m *MockModRepository) FindByID(id uint) (*models.Mod, error) {
 	return args.Get(0).(*models.Mod), args.Error(1)
 }
 
-func (m *MockModRepository) UpdateDownloadCount(mod *models.Mod) error {
-	args := m.Called(mod)
+func (m *MockModRepository) FindPublicByID(id uint) (*models.Mod, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Mod), args.Error(1)
+}
+
+func (m *MockModRepository) FindByStatus(status string, page, pageSize int) ([]models.Mod, int64, error) {
+	args := m.Called(status, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.Mod), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockModRepository) UpdateStatus(mod *models.Mod, review *models.ModReview) error {
+	args := m.Called(mod, review)
+	return args.Error(0)
+}
+
+func (m *MockModRepository) FindReviews(modID uint) ([]models.ModReview, error) {
+	args := m.Called(modID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ModReview), args.Error(1)
+}
+
+func (m *MockModRepository) FindByIDs(ids []uint) ([]models.Mod, error) {
+	args := m.Called(ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Mod), args.Error(1)
+}
+
+func (m *MockModRepository) UpdateDownloadCount(mod *models.Mod, visitor string) error {
+	args := m.Called(mod, visitor)
+	return args.Error(0)
+}
+
+func (m *MockModRepository) UpdateViewCount(mod *models.Mod, visitor string) error {
+	args := m.Called(mod, visitor)
 	return args.Error(0)
 }
 
@@ -57,11 +102,73 @@ func (m *MockModRepository) FindAllCategories() ([]models.Category, error) {
 	return args.Get(0).([]models.Category), args.Error(1)
 }
 
+func (m *MockModRepository) FindGameByID(id uint) (*models.Game, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Game), args.Error(1)
+}
+
+func (m *MockModRepository) FindCategoriesByIDs(ids []uint) ([]models.Category, error) {
+	args := m.Called(ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Category), args.Error(1)
+}
+
+func (m *MockModRepository) FindGameVersionsByIDs(ids []uint) ([]models.GameVersion, error) {
+	args := m.Called(ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.GameVersion), args.Error(1)
+}
+
+func (m *MockModRepository) FindGameVersionsByGames(gameIDs []uint) ([]models.GameVersion, error) {
+	args := m.Called(gameIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.GameVersion), args.Error(1)
+}
+
+func (m *MockModRepository) FindInBatches(batchSize int, fn func(mods []models.Mod) error) error {
+	args := m.Called(batchSize, fn)
+	return args.Error(0)
+}
+
+func (m *MockModRepository) Create(mod *models.Mod) error {
+	args := m.Called(mod)
+	return args.Error(0)
+}
+
+func (m *MockModRepository) Update(mod *models.Mod) error {
+	args := m.Called(mod)
+	return args.Error(0)
+}
+
+func (m *MockModRepository) Delete(id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// MockModEventPublisher Mod 领域事件发布器 Mock
+type MockModEventPublisher struct {
+	mock.Mock
+}
+
+func (m *MockModEventPublisher) PublishModEvent(evt event.ModEvent) error {
+	args := m.Called(evt.Type, evt.ModID)
+	return args.Error(0)
+}
+
 func TestModService_SearchMods_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockModRepository)
 	logger, _ := zap.NewDevelopment()
-	service := services.NewModService(mockRepo, logger)
+	service := services.NewModService(mockRepo, nil, nil, logger)
 
 	req := dto.ModSearchRequest{
 		Keyword:  "test",
@@ -84,6 +191,7 @@ func TestModService_SearchMods_Success(t *testing.T) {
 			},
 		},
 		Total:      1,
+		HasTotal:   true,
 		Page:       1,
 		PageSize:   10,
 		TotalPages: 1,
@@ -101,7 +209,7 @@ func TestModService_SearchMods_Success(t *testing.T) {
 	mockRepo.On("Search", criteria).Return(expectedResult, nil)
 
 	// Act
-	result, err := service.SearchMods(req)
+	result, err := service.SearchMods(req, "")
 
 	// Assert
 	assert.NoError(t, err)
@@ -110,7 +218,54 @@ func TestModService_SearchMods_Success(t *testing.T) {
 	assert.Equal(t, "Test Mod 1", result.List[0].Name)
 	assert.Equal(t, "Game1", result.List[0].GameName)
 	assert.Equal(t, []string{"Category1"}, result.List[0].Categories)
-	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, int64(1), *result.Total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_SearchMods_Highlight(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	req := dto.ModSearchRequest{
+		Keyword:  "weapon",
+		SortBy:   "relevance",
+		Page:     1,
+		PageSize: 10,
+	}
+
+	expectedResult := &repository.ModSearchResult{
+		Mods: []models.Mod{
+			{Name: "Weapon Pack", Description: "Adds new weapon models"},
+			{Name: "Armor Pack", Description: "Adds new armor"},
+		},
+		Total:      2,
+		HasTotal:   true,
+		Page:       1,
+		PageSize:   10,
+		TotalPages: 1,
+	}
+
+	criteria := repository.ModSearchCriteria{
+		Keyword:  req.Keyword,
+		SortBy:   req.SortBy,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+
+	mockRepo.On("Search", criteria).Return(expectedResult, nil)
+
+	// Act
+	result, err := service.SearchMods(req, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.List, 2)
+	assert.NotNil(t, result.List[0].Highlight)
+	assert.Equal(t, "<em>Weapon</em> Pack", result.List[0].Highlight.Name)
+	assert.Equal(t, "Adds new <em>weapon</em> models", result.List[0].Highlight.Description)
+	assert.Nil(t, result.List[1].Highlight)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -118,7 +273,7 @@ func TestModService_SearchMods_Empty(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockModRepository)
 	logger, _ := zap.NewDevelopment()
-	service := services.NewModService(mockRepo, logger)
+	service := services.NewModService(mockRepo, nil, nil, logger)
 
 	req := dto.ModSearchRequest{
 		Keyword:  "nonexistent",
@@ -129,6 +284,7 @@ func TestModService_SearchMods_Empty(t *testing.T) {
 	expectedResult := &repository.ModSearchResult{
 		Mods:       []models.Mod{},
 		Total:      0,
+		HasTotal:   true,
 		Page:       1,
 		PageSize:   10,
 		TotalPages: 0,
@@ -143,13 +299,13 @@ func TestModService_SearchMods_Empty(t *testing.T) {
 	mockRepo.On("Search", criteria).Return(expectedResult, nil)
 
 	// Act
-	result, err := service.SearchMods(req)
+	result, err := service.SearchMods(req, "")
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Len(t, result.List, 0)
-	assert.Equal(t, int64(0), result.Total)
+	assert.Equal(t, int64(0), *result.Total)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -157,7 +313,7 @@ func TestModService_SearchMods_Error(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockModRepository)
 	logger, _ := zap.NewDevelopment()
-	service := services.NewModService(mockRepo, logger)
+	service := services.NewModService(mockRepo, nil, nil, logger)
 
 	req := dto.ModSearchRequest{
 		Page:     1,
@@ -172,7 +328,7 @@ func TestModService_SearchMods_Error(t *testing.T) {
 	mockRepo.On("Search", criteria).Return(nil, errors.New("database error"))
 
 	// Act
-	result, err := service.SearchMods(req)
+	result, err := service.SearchMods(req, "")
 
 	// Assert
 	assert.Error(t, err)
@@ -180,11 +336,174 @@ func TestModService_SearchMods_Error(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestModService_SearchMods_Cursor(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	req := dto.ModSearchRequest{
+		PageSize: 10,
+		Cursor:   "abc",
+	}
+
+	// 游标分页默认跳过总数统计
+	criteria := repository.ModSearchCriteria{
+		PageSize:  req.PageSize,
+		Cursor:    req.Cursor,
+		SkipTotal: true,
+	}
+
+	mockRepo.On("Search", criteria).Return(&repository.ModSearchResult{
+		Mods:       []models.Mod{{Name: "Test Mod"}},
+		Page:       1,
+		PageSize:   10,
+		NextCursor: "next",
+	}, nil)
+
+	// Act
+	result, err := service.SearchMods(req, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.List, 1)
+	assert.Nil(t, result.Total)
+	assert.Nil(t, result.TotalPages)
+	assert.Equal(t, "next", result.NextCursor)
+	assert.True(t, result.HasMore)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_SearchMods_WithTotalDisabled(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	withTotal := false
+	req := dto.ModSearchRequest{
+		Page:      2,
+		PageSize:  10,
+		WithTotal: &withTotal,
+	}
+
+	criteria := repository.ModSearchCriteria{
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		SkipTotal: true,
+	}
+
+	mockRepo.On("Search", criteria).Return(&repository.ModSearchResult{
+		Mods:     []models.Mod{},
+		Page:     2,
+		PageSize: 10,
+	}, nil)
+
+	// Act
+	result, err := service.SearchMods(req, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, result.Total)
+	assert.False(t, result.HasMore)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_SearchMods_InvalidCursor(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	req := dto.ModSearchRequest{Cursor: "broken"}
+
+	criteria := repository.ModSearchCriteria{
+		Cursor:    req.Cursor,
+		SkipTotal: true,
+	}
+
+	mockRepo.On("Search", criteria).Return(nil, repository.ErrInvalidCursor)
+
+	// Act
+	result, err := service.SearchMods(req, "")
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrInvalidCursor, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_SearchMods_MultiValueFilters(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	req := dto.ModSearchRequest{
+		GameID:     "2",
+		CategoryID: "1, 3,1",
+		Facets:     true,
+		Page:       1,
+		PageSize:   10,
+	}
+
+	criteria := repository.ModSearchCriteria{
+		GameIDs:     []uint{2},
+		CategoryIDs: []uint{1, 3},
+		WithFacets:  true,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	}
+
+	mockRepo.On("Search", criteria).Return(&repository.ModSearchResult{
+		Mods:     []models.Mod{},
+		HasTotal: true,
+		Page:     1,
+		PageSize: 10,
+		Facets: &repository.ModFacets{
+			Games:      []repository.ModFacetBucket{{Value: "2", Label: "Game2", Count: 5}},
+			Categories: []repository.ModFacetBucket{{Value: "1", Label: "Category1", Count: 3}},
+			Ratings:    []repository.ModFacetBucket{{Value: "4", Label: "4-5", Count: 2}},
+		},
+	}, nil)
+
+	// Act
+	result, err := service.SearchMods(req, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result.Facets)
+	assert.Equal(t, []dto.FacetBucketResponse{{Value: "2", Label: "Game2", Count: 5}}, result.Facets.Games)
+	assert.Equal(t, "Category1", result.Facets.Categories[0].Label)
+	assert.Empty(t, result.Facets.Authors)
+	assert.Equal(t, "4-5", result.Facets.Ratings[0].Label)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_SearchMods_InvalidIDList(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	req := dto.ModSearchRequest{CategoryID: "1,abc"}
+
+	// Act
+	result, err := service.SearchMods(req, "")
+
+	// Assert
+	assert.Nil(t, result)
+	var be *bizErr.BizError
+	assert.ErrorAs(t, err, &be)
+	assert.Equal(t, bizErr.CodeValidationError, be.Code)
+	mockRepo.AssertNotCalled(t, "Search", mock.Anything)
+}
+
 func TestModService_GetModDetail_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockModRepository)
 	logger, _ := zap.NewDevelopment()
-	service := services.NewModService(mockRepo, logger)
+	service := services.NewModService(mockRepo, nil, nil, logger)
 
 	now := time.Now()
 	mod := &models.Mod{
@@ -203,17 +522,19 @@ func TestModService_GetModDetail_Success(t *testing.T) {
 	mod.CreatedAt = now
 	mod.UpdatedAt = now
 
-	mockRepo.On("FindByID", uint(1)).Return(mod, nil)
-	mockRepo.On("UpdateDownloadCount", mod).Return(nil)
+	mockRepo.On("FindPublicByID", uint(1)).Return(mod, nil)
+	mockRepo.On("UpdateViewCount", mod, "visitor").Return(nil)
 
 	// Act
-	result, err := service.GetModDetail(1)
+	result, err := service.GetModDetail(1, "visitor", "")
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, "Test Mod", result.Name)
-	assert.Equal(t, 101, result.DownloadCount) // +1
+	assert.Equal(t, 100, result.DownloadCount) // 浏览不计入下载
+	assert.Equal(t, 1, result.ViewCount)       // +1
+	mockRepo.AssertNotCalled(t, "UpdateDownloadCount", mock.Anything, mock.Anything)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -221,24 +542,90 @@ func TestModService_GetModDetail_NotFound(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockModRepository)
 	logger, _ := zap.NewDevelopment()
-	service := services.NewModService(mockRepo, logger)
+	service := services.NewModService(mockRepo, nil, nil, logger)
 
-	mockRepo.On("FindByID", uint(999)).Return(nil, errors.New("not found"))
+	mockRepo.On("FindPublicByID", uint(999)).Return(nil, gorm.ErrRecordNotFound)
 
 	// Act
-	result, err := service.GetModDetail(999)
+	result, err := service.GetModDetail(999, "visitor", "")
 
 	// Assert
-	assert.Error(t, err)
+	assert.Equal(t, bizErr.ErrModNotFound, err)
 	assert.Nil(t, result)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestModService_GetModDetail_RepositoryError(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	dbErr := errors.New("connection refused")
+	mockRepo.On("FindPublicByID", uint(1)).Return(nil, dbErr)
+
+	// Act
+	result, err := service.GetModDetail(1, "visitor", "")
+
+	// Assert
+	assert.ErrorIs(t, err, dbErr)
+	assert.NotEqual(t, bizErr.ErrModNotFound, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_GetDownloadURL_Success(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	mod := &models.Mod{
+		Name:          "Test Mod",
+		DownloadURL:   "https://example.com/download",
+		DownloadCount: 100,
+	}
+	mod.ID = 1
+
+	mockRepo.On("FindPublicByID", uint(1)).Return(mod, nil)
+	mockRepo.On("UpdateDownloadCount", mod, "visitor").Return(nil)
+
+	// Act
+	url, err := service.GetDownloadURL(1, "visitor")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/download", url)
+	mockRepo.AssertNotCalled(t, "UpdateViewCount", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_GetDownloadURL_Empty(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	mod := &models.Mod{Name: "Test Mod"}
+	mod.ID = 1
+
+	mockRepo.On("FindPublicByID", uint(1)).Return(mod, nil)
+
+	// Act
+	url, err := service.GetDownloadURL(1, "visitor")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, url)
+	mockRepo.AssertNotCalled(t, "UpdateDownloadCount", mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestModService_GetGames_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockModRepository)
 	logger, _ := zap.NewDevelopment()
-	service := services.NewModService(mockRepo, logger)
+	service := services.NewModService(mockRepo, nil, nil, logger)
 
 	games := []models.Game{
 		{Name: "Game1"},
@@ -248,7 +635,7 @@ func TestModService_GetGames_Success(t *testing.T) {
 	mockRepo.On("FindAllGames").Return(games, nil)
 
 	// Act
-	result, err := service.GetGames()
+	result, err := service.GetGames("")
 
 	// Assert
 	assert.NoError(t, err)
@@ -261,7 +648,7 @@ func TestModService_GetCategories_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockModRepository)
 	logger, _ := zap.NewDevelopment()
-	service := services.NewModService(mockRepo, logger)
+	service := services.NewModService(mockRepo, nil, nil, logger)
 
 	categories := []models.Category{
 		{Name: "Category1"},
@@ -271,7 +658,7 @@ func TestModService_GetCategories_Success(t *testing.T) {
 	mockRepo.On("FindAllCategories").Return(categories, nil)
 
 	// Act
-	result, err := service.GetCategories()
+	result, err := service.GetCategories("")
 
 	// Assert
 	assert.NoError(t, err)
@@ -279,3 +666,177 @@ func TestModService_GetCategories_Success(t *testing.T) {
 	assert.Len(t, result.List, 2)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestModService_GetCategoryTree(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	parent := func(id uint) *uint { return &id }
+	categories := []models.Category{
+		{ID: 1, Name: "Gameplay"},
+		{ID: 2, Name: "Graphics"},
+		{ID: 3, Name: "Combat", ParentID: parent(1)},
+		{ID: 4, Name: "Magic", ParentID: parent(3)},
+		{ID: 5, Name: "Orphan", ParentID: parent(99)},
+	}
+	mockRepo.On("FindAllCategories").Return(categories, nil)
+
+	// Act
+	result, err := service.GetCategoryTree("")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.List, 3)
+	assert.Equal(t, "Gameplay", result.List[0].Name)
+	assert.Equal(t, "Combat", result.List[0].Children[0].Name)
+	assert.Equal(t, "Magic", result.List[0].Children[0].Children[0].Name)
+	assert.Empty(t, result.List[1].Children)
+	assert.Equal(t, "Orphan", result.List[2].Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_SearchMods_TagFilter(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	req := dto.ModSearchRequest{Tag: " UI, skse ,ui,", Page: 1, PageSize: 10}
+
+	criteria := repository.ModSearchCriteria{
+		Tags:     []string{"ui", "skse"},
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+	mockRepo.On("Search", criteria).Return(&repository.ModSearchResult{
+		Mods:     []models.Mod{{Name: "SkyUI", Tags: []models.Tag{{Name: "ui"}}}},
+		HasTotal: true,
+		Total:    1,
+	}, nil)
+
+	// Act
+	result, err := service.SearchMods(req, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ui"}, result.List[0].Tags)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_CreateMod_Success(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	mockEvents := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, mockEvents, logger)
+
+	req := dto.ModSaveRequest{
+		Name:        "New Mod",
+		GameID:      1,
+		CategoryIDs: []uint{2, 3, 2},
+	}
+
+	mockRepo.On("FindGameByID", uint(1)).Return(&models.Game{ID: 1, Name: "Game1"}, nil)
+	mockRepo.On("FindCategoriesByIDs", []uint{2, 3}).Return([]models.Category{{ID: 2}, {ID: 3}}, nil)
+	mockRepo.On("Create", mock.AnythingOfType("*models.Mod")).Run(func(args mock.Arguments) {
+		args.Get(0).(*models.Mod).ID = 10
+	}).Return(nil)
+	mockEvents.On("PublishModEvent", event.ModCreated, uint(10)).Return(nil)
+
+	// Act
+	result, err := service.CreateMod(req, 7)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(10), result.ID)
+	assert.Equal(t, models.ModStatusPending, result.Status)
+	assert.Equal(t, uint(7), result.OwnerID)
+	assert.Equal(t, "Game1", result.Game.Name)
+	assert.Len(t, result.Categories, 2)
+	mockRepo.AssertExpectations(t)
+	mockEvents.AssertExpectations(t)
+}
+
+func TestModService_CreateMod_CategoryNotFound(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	mockEvents := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, mockEvents, logger)
+
+	req := dto.ModSaveRequest{
+		Name:        "New Mod",
+		GameID:      1,
+		CategoryIDs: []uint{2, 99},
+	}
+
+	mockRepo.On("FindGameByID", uint(1)).Return(&models.Game{ID: 1}, nil)
+	mockRepo.On("FindCategoriesByIDs", []uint{2, 99}).Return([]models.Category{{ID: 2}}, nil)
+
+	// Act
+	result, err := service.CreateMod(req, 7)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrCategoryNotFound, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything)
+	mockEvents.AssertNotCalled(t, "PublishModEvent", mock.Anything, mock.Anything)
+}
+
+func TestModService_UpdateMod_NotFound(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	mockRepo.On("FindByID", uint(999)).Return(nil, gorm.ErrRecordNotFound)
+
+	// Act
+	result, err := service.UpdateMod(999, 7, dto.ModSaveRequest{Name: "x", GameID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrModNotFound, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestModService_UpdateMod_NotEditor(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, nil, logger)
+
+	mod := &models.Mod{ID: 5, OwnerID: 3, Maintainers: []models.User{{ID: models.ID{ID: 4}}}}
+	mockRepo.On("FindByID", uint(5)).Return(mod, nil)
+
+	// Act
+	result, err := service.UpdateMod(5, 7, dto.ModSaveRequest{Name: "x", GameID: 1})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrNotModEditor, err)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestModService_DeleteMod_PublishesEvent(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	mockEvents := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, nil, mockEvents, logger)
+
+	mod := &models.Mod{ID: 5, OwnerID: 3, Maintainers: []models.User{{ID: models.ID{ID: 7}}}}
+	mockRepo.On("FindByID", uint(5)).Return(mod, nil)
+	mockRepo.On("Delete", uint(5)).Return(nil)
+	mockEvents.On("PublishModEvent", event.ModDeleted, uint(5)).Return(errors.New("broker down"))
+
+	// Act
+	err := service.DeleteMod(5, 7) // 共同维护者
+
+	// Assert
+	assert.NoError(t, err) // 事件发布失败不影响删除结果
+	mockRepo.AssertExpectations(t)
+	mockEvents.AssertExpectations(t)
+}
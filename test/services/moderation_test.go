@@ -0,0 +1,191 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/websocket"
+)
+
+// MockNotificationRepository 站内通知仓储 Mock
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) Create(notification *models.Notification) error {
+	args := m.Called(notification)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindByUser(userID uint, unreadOnly bool, page, pageSize int) ([]models.Notification, int64, error) {
+	args := m.Called(userID, unreadOnly, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.Notification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationRepository) CountUnread(userID uint) (int64, error) {
+	args := m.Called(userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationRepository) MarkRead(userID, id uint) error {
+	args := m.Called(userID, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkAllRead(userID uint) error {
+	args := m.Called(userID)
+	return args.Error(0)
+}
+
+// MockNotificationPusher 实时推送 Mock
+type MockNotificationPusher struct {
+	mock.Mock
+}
+
+func (m *MockNotificationPusher) SendToUser(userID string, message *websocket.Message) {
+	m.Called(userID, message)
+}
+
+func TestModerationService_ReviewMod_ApproveNotifiesOwner(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	notifyRepo := new(MockNotificationRepository)
+	pusher := new(MockNotificationPusher)
+	logger, _ := zap.NewDevelopment()
+	notifier := services.NewNotificationService(notifyRepo, pusher, logger)
+	service := services.NewModerationService(modRepo, notifier, logger)
+
+	mod := &models.Mod{ID: 1, Name: "SkyUI", Status: models.ModStatusPending, OwnerID: 7}
+	modRepo.On("FindByID", uint(1)).Return(mod, nil)
+	modRepo.On("UpdateStatus", mod, mock.MatchedBy(func(r *models.ModReview) bool {
+		return r.OperatorID == 3 && r.FromStatus == models.ModStatusPending && r.ToStatus == models.ModStatusApproved
+	})).Return(nil)
+	notifyRepo.On("Create", mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserID == 7 && n.Type == models.NotificationModStatus && n.RelatedID == 1
+	})).Return(nil)
+	pusher.On("SendToUser", "7", mock.AnythingOfType("*websocket.Message")).Return()
+
+	// Act
+	result, err := service.ReviewMod(1, 3, dto.ModReviewRequest{Action: models.ReviewActionApprove})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.ModStatusApproved, result.Status)
+	modRepo.AssertExpectations(t)
+	notifyRepo.AssertExpectations(t)
+	pusher.AssertExpectations(t)
+}
+
+func TestModerationService_ReviewMod_RejectRequiresReason(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	notifyRepo := new(MockNotificationRepository)
+	pusher := new(MockNotificationPusher)
+	logger, _ := zap.NewDevelopment()
+	notifier := services.NewNotificationService(notifyRepo, pusher, logger)
+	service := services.NewModerationService(modRepo, notifier, logger)
+
+	// Act
+	result, err := service.ReviewMod(1, 3, dto.ModReviewRequest{Action: models.ReviewActionReject, Reason: "  "})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, bizErr.ErrReviewReasonRequired)
+	modRepo.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+func TestModerationService_ReviewMod_InvalidTransition(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	notifyRepo := new(MockNotificationRepository)
+	pusher := new(MockNotificationPusher)
+	logger, _ := zap.NewDevelopment()
+	notifier := services.NewNotificationService(notifyRepo, pusher, logger)
+	service := services.NewModerationService(modRepo, notifier, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, Status: models.ModStatusPending, OwnerID: 7}, nil)
+
+	// Act
+	result, err := service.ReviewMod(1, 3, dto.ModReviewRequest{Action: models.ReviewActionHide, Reason: "侵权"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, bizErr.ErrModStatusInvalid)
+	modRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	notifyRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestModerationService_SubmitMod(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	notifyRepo := new(MockNotificationRepository)
+	pusher := new(MockNotificationPusher)
+	logger, _ := zap.NewDevelopment()
+	notifier := services.NewNotificationService(notifyRepo, pusher, logger)
+	service := services.NewModerationService(modRepo, notifier, logger)
+
+	mod := &models.Mod{ID: 1, Status: models.ModStatusRejected, OwnerID: 7}
+	modRepo.On("FindByID", uint(1)).Return(mod, nil)
+	modRepo.On("UpdateStatus", mod, mock.MatchedBy(func(r *models.ModReview) bool {
+		return r.Action == models.ReviewActionSubmit && r.ToStatus == models.ModStatusPending
+	})).Return(nil)
+
+	// Act
+	result, err := service.SubmitMod(1, 7)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.ModStatusPending, result.Status)
+	notifyRepo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestModerationService_SubmitMod_NotOwner(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	notifyRepo := new(MockNotificationRepository)
+	pusher := new(MockNotificationPusher)
+	logger, _ := zap.NewDevelopment()
+	notifier := services.NewNotificationService(notifyRepo, pusher, logger)
+	service := services.NewModerationService(modRepo, notifier, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, Status: models.ModStatusDraft, OwnerID: 7}, nil)
+
+	// Act
+	result, err := service.SubmitMod(1, 8)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, bizErr.ErrNotModEditor)
+}
+
+func TestModerationService_GetQueue_DefaultsToPending(t *testing.T) {
+	// Arrange
+	modRepo := new(MockModRepository)
+	notifyRepo := new(MockNotificationRepository)
+	pusher := new(MockNotificationPusher)
+	logger, _ := zap.NewDevelopment()
+	notifier := services.NewNotificationService(notifyRepo, pusher, logger)
+	service := services.NewModerationService(modRepo, notifier, logger)
+
+	modRepo.On("FindByStatus", models.ModStatusPending, 1, 20).
+		Return([]models.Mod{{ID: 2, Name: "New", Status: models.ModStatusPending, OwnerID: 7}}, int64(1), nil)
+
+	// Act
+	result, err := service.GetQueue(dto.ModerationQueueRequest{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.List, 1)
+	assert.Equal(t, uint(7), result.List[0].OwnerID)
+	assert.Equal(t, 1, result.TotalPages)
+}
@@ -0,0 +1,183 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockReportRepository 内容举报仓储 Mock
+type MockReportRepository struct {
+	mock.Mock
+}
+
+func (m *MockReportRepository) Create(report *models.Report) error {
+	args := m.Called(report)
+	return args.Error(0)
+}
+
+func (m *MockReportRepository) FindByID(id uint) (*models.Report, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Report), args.Error(1)
+}
+
+func (m *MockReportRepository) ExistsByReporter(targetType string, targetID, reporterID uint) (bool, error) {
+	args := m.Called(targetType, targetID, reporterID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockReportRepository) CountPending(targetType string, targetID uint) (int64, error) {
+	args := m.Called(targetType, targetID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockReportRepository) FindByStatus(status, targetType string, page, pageSize int) ([]models.Report, int64, error) {
+	args := m.Called(status, targetType, page, pageSize)
+	return args.Get(0).([]models.Report), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockReportRepository) HandlePending(targetType string, targetID uint, status string, handlerID uint, note string) (int64, error) {
+	args := m.Called(targetType, targetID, status, handlerID, note)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestReportService_SubmitReport_AutoHidesCommentAtThreshold(t *testing.T) {
+	// Arrange
+	repo := new(MockReportRepository)
+	modRepo := new(MockModRepository)
+	commentRepo := new(MockCommentRepository)
+	logger, _ := zap.NewDevelopment()
+	moderation := services.NewModerationService(modRepo, nil, logger)
+
+	service := services.NewReportService(repo, modRepo, commentRepo, moderation, 3, logger)
+
+	comment := &models.Comment{ID: 5, ModID: 1, UserID: 8, Content: "广告"}
+	commentRepo.On("FindByID", uint(5)).Return(comment, nil)
+	commentRepo.On("SoftDelete", comment).Return(nil)
+	repo.On("ExistsByReporter", models.ReportTargetComment, uint(5), uint(7)).Return(false, nil)
+	repo.On("Create", mock.AnythingOfType("*models.Report")).Return(nil)
+	repo.On("CountPending", models.ReportTargetComment, uint(5)).Return(int64(3), nil)
+
+	// Act
+	result, err := service.SubmitReport(7, dto.ReportCreateRequest{
+		TargetType: models.ReportTargetComment, TargetID: 5, Reason: models.ReportReasonSpam,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReportStatusPending, result.Status)
+	assert.Equal(t, models.CommentDeletedByReports, comment.DeletedBy)
+	commentRepo.AssertExpectations(t)
+}
+
+func TestReportService_SubmitReport_BelowThresholdKeepsMod(t *testing.T) {
+	// Arrange
+	repo := new(MockReportRepository)
+	modRepo := new(MockModRepository)
+	commentRepo := new(MockCommentRepository)
+	logger, _ := zap.NewDevelopment()
+	moderation := services.NewModerationService(modRepo, nil, logger)
+
+	service := services.NewReportService(repo, modRepo, commentRepo, moderation, 3, logger)
+
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	repo.On("ExistsByReporter", models.ReportTargetMod, uint(1), uint(7)).Return(false, nil)
+	repo.On("Create", mock.AnythingOfType("*models.Report")).Return(nil)
+	repo.On("CountPending", models.ReportTargetMod, uint(1)).Return(int64(2), nil)
+
+	// Act
+	_, err := service.SubmitReport(7, dto.ReportCreateRequest{
+		TargetType: models.ReportTargetMod, TargetID: 1, Reason: models.ReportReasonBroken,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	modRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+}
+
+func TestReportService_SubmitReport_Duplicate(t *testing.T) {
+	// Arrange
+	repo := new(MockReportRepository)
+	modRepo := new(MockModRepository)
+	commentRepo := new(MockCommentRepository)
+	logger, _ := zap.NewDevelopment()
+	moderation := services.NewModerationService(modRepo, nil, logger)
+
+	service := services.NewReportService(repo, modRepo, commentRepo, moderation, 3, logger)
+
+	modRepo.On("FindPublicByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	repo.On("ExistsByReporter", models.ReportTargetMod, uint(1), uint(7)).Return(true, nil)
+
+	// Act
+	result, err := service.SubmitReport(7, dto.ReportCreateRequest{
+		TargetType: models.ReportTargetMod, TargetID: 1, Reason: models.ReportReasonSpam,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrReportExists, err)
+	repo.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestReportService_ResolveReport_HidesModAndHandlesAll(t *testing.T) {
+	// Arrange
+	repo := new(MockReportRepository)
+	modRepo := new(MockModRepository)
+	commentRepo := new(MockCommentRepository)
+	logger, _ := zap.NewDevelopment()
+	moderation := services.NewModerationService(modRepo, nil, logger)
+
+	service := services.NewReportService(repo, modRepo, commentRepo, moderation, 3, logger)
+
+	repo.On("FindByID", uint(9)).Return(&models.Report{
+		ID: 9, TargetType: models.ReportTargetMod, TargetID: 1, Status: models.ReportStatusPending,
+	}, nil)
+	mod := &models.Mod{ID: 1, Status: models.ModStatusApproved}
+	modRepo.On("FindByID", uint(1)).Return(mod, nil)
+	modRepo.On("UpdateStatus", mod, mock.MatchedBy(func(r *models.ModReview) bool {
+		return r.Action == models.ReviewActionHide && r.OperatorID == 2 && r.Reason == "恶意代码"
+	})).Return(nil)
+	repo.On("HandlePending", models.ReportTargetMod, uint(1), models.ReportStatusResolved, uint(2), "恶意代码").Return(int64(4), nil)
+
+	// Act
+	result, err := service.ResolveReport(9, 2, dto.ReportHandleRequest{Note: " 恶意代码 "})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), result.Handled)
+	assert.Equal(t, models.ModStatusHidden, mod.Status)
+	modRepo.AssertExpectations(t)
+}
+
+func TestReportService_DismissReport_AlreadyHandled(t *testing.T) {
+	// Arrange
+	repo := new(MockReportRepository)
+	modRepo := new(MockModRepository)
+	commentRepo := new(MockCommentRepository)
+	logger, _ := zap.NewDevelopment()
+	moderation := services.NewModerationService(modRepo, nil, logger)
+
+	service := services.NewReportService(repo, modRepo, commentRepo, moderation, 3, logger)
+
+	repo.On("FindByID", uint(9)).Return(&models.Report{
+		ID: 9, TargetType: models.ReportTargetMod, TargetID: 1, Status: models.ReportStatusResolved,
+	}, nil)
+
+	// Act
+	result, err := service.DismissReport(9, 2, dto.ReportHandleRequest{})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrReportHandled, err)
+	repo.AssertNotCalled(t, "HandlePending", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,161 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	bizErr "gin-web/pkg/errors"
+)
+
+// MockTagRepository 标签仓储 Mock
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTagRepository) FindByID(id uint) (*models.Tag, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) FindByModID(modID uint) ([]models.Tag, error) {
+	args := m.Called(modID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) FirstOrCreate(name string, createdBy uint) (*models.Tag, error) {
+	args := m.Called(name, createdBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Search(keyword string, page, pageSize int) ([]models.Tag, int64, error) {
+	args := m.Called(keyword, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]models.Tag), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTagRepository) Autocomplete(prefix string, limit int) ([]models.Tag, error) {
+	args := m.Called(prefix, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) AttachToMod(modID, tagID uint) (bool, error) {
+	args := m.Called(modID, tagID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTagRepository) DetachFromMod(modID, tagID uint) (bool, error) {
+	args := m.Called(modID, tagID)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestTagService_SuggestTag_Success(t *testing.T) {
+	// Arrange
+	tagRepo := new(MockTagRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTagService(tagRepo, modRepo, logger)
+
+	tag := &models.Tag{ID: 3, Name: "quality of life"}
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1}, nil)
+	tagRepo.On("FirstOrCreate", "quality of life", uint(7)).Return(tag, nil)
+	tagRepo.On("AttachToMod", uint(1), uint(3)).Return(true, nil)
+	tagRepo.On("FindByModID", uint(1)).Return([]models.Tag{*tag}, nil)
+
+	// Act
+	result, err := service.SuggestTag(1, 7, dto.TagSuggestRequest{Name: "  Quality   of Life "})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), result.ModID)
+	assert.Equal(t, "quality of life", result.Tags[0].Name)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestTagService_SuggestTag_Invalid(t *testing.T) {
+	// Arrange
+	tagRepo := new(MockTagRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTagService(tagRepo, modRepo, logger)
+
+	// Act
+	result, err := service.SuggestTag(1, 7, dto.TagSuggestRequest{Name: "ui,hud"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrTagInvalid, err)
+	tagRepo.AssertNotCalled(t, "FirstOrCreate", mock.Anything, mock.Anything)
+}
+
+func TestTagService_RemoveTag_NotAttached(t *testing.T) {
+	// Arrange
+	tagRepo := new(MockTagRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTagService(tagRepo, modRepo, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	tagRepo.On("DetachFromMod", uint(1), uint(3)).Return(false, nil)
+
+	// Act
+	result, err := service.RemoveTag(1, 3, 7)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Equal(t, bizErr.ErrTagNotFound, err)
+}
+
+func TestTagService_AutocompleteTags(t *testing.T) {
+	// Arrange
+	tagRepo := new(MockTagRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTagService(tagRepo, new(MockModRepository), logger)
+
+	tags := []models.Tag{{ID: 1, Name: "skse"}, {ID: 2, Name: "skyui"}}
+	tagRepo.On("Autocomplete", "sk", 10).Return(tags, nil)
+
+	// Act
+	result, err := service.AutocompleteTags(dto.TagAutocompleteRequest{Prefix: "SK"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, tags, result.List)
+	tagRepo.AssertExpectations(t)
+}
+
+func TestTagService_SearchTags_Error(t *testing.T) {
+	// Arrange
+	tagRepo := new(MockTagRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTagService(tagRepo, new(MockModRepository), logger)
+
+	tagRepo.On("Search", "ui", 1, 20).Return(nil, int64(0), errors.New("database error"))
+
+	// Act
+	result, err := service.SearchTags(dto.TagSearchRequest{Keyword: "UI"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,196 @@
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/amqp/event"
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	bizErr "gin-web/pkg/errors"
+	"gin-web/pkg/locale"
+)
+
+// MockTranslationRepository 翻译仓储 Mock
+type MockTranslationRepository struct {
+	mock.Mock
+}
+
+func (m *MockTranslationRepository) Find(entity string, ids []uint, loc string) ([]repository.Translation, error) {
+	args := m.Called(entity, ids, loc)
+	return args.Get(0).([]repository.Translation), args.Error(1)
+}
+
+func (m *MockTranslationRepository) List(entity string, id uint) ([]repository.Translation, error) {
+	args := m.Called(entity, id)
+	return args.Get(0).([]repository.Translation), args.Error(1)
+}
+
+func (m *MockTranslationRepository) Save(entity string, t *repository.Translation) error {
+	args := m.Called(entity, t)
+	return args.Error(0)
+}
+
+func (m *MockTranslationRepository) Delete(entity string, id uint, loc string) (bool, error) {
+	args := m.Called(entity, id, loc)
+	return args.Bool(0), args.Error(1)
+}
+
+// newTestLocalizer 默认语言 zh-CN，另支持 en
+func newTestLocalizer(repo repository.TranslationRepository, logger *zap.Logger) *services.Localizer {
+	return services.NewLocalizer(repo, locale.NewNegotiator("zh-CN", []string{"en"}), logger)
+}
+
+func TestModService_SearchMods_Localized(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	translations := new(MockTranslationRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, newTestLocalizer(translations, logger), nil, logger)
+
+	mod := models.Mod{
+		ID:          1,
+		Name:        "武器包",
+		Description: "新增 50 把武器",
+		GameID:      3,
+		Game:        models.Game{ID: 3, Name: "上古卷轴5"},
+		Categories:  []models.Category{{ID: 4, Name: "武器"}, {ID: 5, Name: "界面"}},
+	}
+	mockRepo.On("Search", mock.Anything).Return(&repository.ModSearchResult{Mods: []models.Mod{mod}, Page: 1, PageSize: 20}, nil)
+	translations.On("Find", models.TranslationEntityMod, []uint{1}, "en").
+		Return([]repository.Translation{{EntityID: 1, Locale: "en", Name: "Weapon Pack"}}, nil)
+	translations.On("Find", models.TranslationEntityGame, []uint{3}, "en").
+		Return([]repository.Translation{{EntityID: 3, Locale: "en", Name: "Skyrim"}}, nil)
+	translations.On("Find", models.TranslationEntityCategory, []uint{4, 5}, "en").
+		Return([]repository.Translation{{EntityID: 4, Locale: "en", Name: "Weapons"}}, nil)
+
+	// Act
+	result, err := service.SearchMods(dto.ModSearchRequest{Keyword: "weapon", Page: 1, PageSize: 20}, "en")
+
+	// Assert：未翻译的分类回退到默认语言，高亮基于翻译后的名称
+	assert.NoError(t, err)
+	assert.Equal(t, "Weapon Pack", result.List[0].Name)
+	assert.Equal(t, "Skyrim", result.List[0].GameName)
+	assert.Equal(t, []string{"Weapons", "界面"}, result.List[0].Categories)
+	assert.NotNil(t, result.List[0].Highlight)
+	assert.Contains(t, result.List[0].Highlight.Name, "Weapon")
+}
+
+func TestModService_GetGames_EnglishNameFallback(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	translations := new(MockTranslationRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, newTestLocalizer(translations, logger), nil, logger)
+
+	mockRepo.On("FindAllGames").Return([]models.Game{
+		{ID: 1, Name: "上古卷轴5", EnglishName: "Skyrim"},
+		{ID: 2, Name: "辐射4", EnglishName: "Fallout 4"},
+		{ID: 3, Name: "巫师3"},
+	}, nil)
+	translations.On("Find", models.TranslationEntityGame, []uint{1, 2, 3}, "en").
+		Return([]repository.Translation{{EntityID: 2, Locale: "en", Name: "Fallout 4 GOTY", Description: "Post-apocalyptic RPG"}}, nil)
+
+	// Act
+	result, err := service.GetGames("en")
+
+	// Assert：翻译优先，其次英文名，都没有时使用默认语言
+	assert.NoError(t, err)
+	assert.Equal(t, "Skyrim", result.List[0].Name)
+	assert.Equal(t, "Fallout 4 GOTY", result.List[1].Name)
+	assert.Equal(t, "Post-apocalyptic RPG", result.List[1].Description)
+	assert.Equal(t, "巫师3", result.List[2].Name)
+}
+
+func TestModService_GetCategories_DefaultLocaleSkipsTranslations(t *testing.T) {
+	// Arrange
+	mockRepo := new(MockModRepository)
+	translations := new(MockTranslationRepository)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(mockRepo, newTestLocalizer(translations, logger), nil, logger)
+
+	mockRepo.On("FindAllCategories").Return([]models.Category{{ID: 1, Name: "武器"}}, nil)
+
+	// Act
+	result, err := service.GetCategories("zh-CN")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "武器", result.List[0].Name)
+	translations.AssertNotCalled(t, "Find", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModService_NegotiateLocale(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	service := services.NewModService(new(MockModRepository), newTestLocalizer(nil, logger), nil, logger)
+
+	assert.Equal(t, "en", service.NegotiateLocale("ja, en-US;q=0.8", ""))
+	assert.Equal(t, "zh-CN", service.NegotiateLocale("en", "zh"))
+	assert.Equal(t, "zh-CN", service.NegotiateLocale("fr", ""))
+}
+
+func TestTranslationService_SaveModTranslation_Reindexes(t *testing.T) {
+	// Arrange
+	repo := new(MockTranslationRepository)
+	modRepo := new(MockModRepository)
+	events := new(MockModEventPublisher)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewTranslationService(repo, modRepo, newTestLocalizer(repo, logger), events, logger)
+
+	modRepo.On("FindByID", uint(1)).Return(&models.Mod{ID: 1, OwnerID: 7}, nil)
+	repo.On("Save", models.TranslationEntityMod, mock.MatchedBy(func(t *repository.Translation) bool {
+		return t.EntityID == 1 && t.Locale == "en" && t.Name == "Weapon Pack"
+	})).Return(nil)
+	events.On("PublishModEvent", event.ModUpdated, uint(1)).Return(nil)
+
+	// Act：语言标签按规范化后的形式保存
+	result, err := svc.SaveModTranslation(1, 7, "EN", dto.TranslationSaveRequest{Name: "Weapon Pack"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "en", result.Locale)
+	repo.AssertExpectations(t)
+	events.AssertExpectations(t)
+}
+
+func TestTranslationService_Save_InvalidLocale(t *testing.T) {
+	// Arrange
+	repo := new(MockTranslationRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewTranslationService(repo, modRepo, newTestLocalizer(repo, logger), nil, logger)
+
+	modRepo.On("FindGameByID", uint(1)).Return(&models.Game{ID: 1}, nil)
+	req := dto.TranslationSaveRequest{Name: "Skyrim"}
+
+	// Act
+	_, defaultLocale := svc.Save(models.TranslationEntityGame, 1, "zh-cn", req)
+	_, unsupported := svc.Save(models.TranslationEntityGame, 1, "ja", req)
+
+	// Assert
+	assert.Equal(t, bizErr.ErrDefaultLocaleTranslation, defaultLocale)
+	assert.Equal(t, bizErr.ErrLocaleNotSupported, unsupported)
+	repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestTranslationService_Delete_NotFound(t *testing.T) {
+	// Arrange
+	repo := new(MockTranslationRepository)
+	modRepo := new(MockModRepository)
+	logger, _ := zap.NewDevelopment()
+	svc := services.NewTranslationService(repo, modRepo, newTestLocalizer(repo, logger), nil, logger)
+
+	modRepo.On("FindCategoriesByIDs", []uint{4}).Return([]models.Category{{ID: 4}}, nil)
+	repo.On("Delete", models.TranslationEntityCategory, uint(4), "en").Return(false, nil)
+
+	// Act
+	err := svc.Delete(models.TranslationEntityCategory, 4, "en")
+
+	// Assert
+	assert.Equal(t, bizErr.ErrTranslationNotFound, err)
+}
@@ -0,0 +1,176 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"gin-web/app/dto"
+	"gin-web/app/models"
+	"gin-web/app/services"
+	"gin-web/internal/repository"
+	"gin-web/pkg/trending"
+)
+
+// MockModStatRepository 每日计数仓储 Mock
+type MockModStatRepository struct {
+	mock.Mock
+}
+
+func (m *MockModStatRepository) FindSince(since time.Time) ([]models.ModDailyStat, error) {
+	args := m.Called(since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ModDailyStat), args.Error(1)
+}
+
+func (m *MockModStatRepository) UpdateTrendingScores(scores map[uint]float64) error {
+	args := m.Called(scores)
+	return args.Error(0)
+}
+
+func (m *MockModStatRepository) FindMemberships(modIDs []uint) ([]repository.ModMembership, error) {
+	args := m.Called(modIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]repository.ModMembership), args.Error(1)
+}
+
+// MockTrendingRanking 热度榜单 Mock
+type MockTrendingRanking struct {
+	mock.Mock
+}
+
+func (m *MockTrendingRanking) Replace(rankings map[string]map[uint]float64) error {
+	args := m.Called(rankings)
+	return args.Error(0)
+}
+
+func (m *MockTrendingRanking) Top(key string, offset, limit int) ([]uint, int64, error) {
+	args := m.Called(key, offset, limit)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]uint), args.Get(1).(int64), args.Error(2)
+}
+
+func TestTrendingService_Recompute(t *testing.T) {
+	// Arrange
+	statRepo := new(MockModStatRepository)
+	modRepo := new(MockModRepository)
+	ranking := new(MockTrendingRanking)
+	logger, _ := zap.NewDevelopment()
+	opts := trending.Options{Window: 7, HalfLife: 1, DownloadWeight: 1, ViewWeight: 1}
+	service := services.NewTrendingService(statRepo, modRepo, ranking, opts, 1, logger)
+
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.Local)
+	today := trending.Day(now)
+	parent := uint(1)
+	statRepo.On("FindSince", today.AddDate(0, 0, -6)).Return([]models.ModDailyStat{
+		{ModID: 1, Date: today, Downloads: 10},
+		{ModID: 2, Date: today.AddDate(0, 0, -1), Downloads: 10},
+	}, nil)
+	statRepo.On("UpdateTrendingScores", map[uint]float64{1: 10, 2: 5}).Return(nil)
+	statRepo.On("FindMemberships", mock.Anything).Return([]repository.ModMembership{
+		{ModID: 1, GameID: 1, CategoryID: 2},
+		{ModID: 2, GameID: 2, CategoryID: 0},
+	}, nil)
+	modRepo.On("FindAllCategories").Return([]models.Category{{ID: 1}, {ID: 2, ParentID: &parent}}, nil)
+	ranking.On("Replace", map[string]map[uint]float64{
+		"all":        {1: 10}, // 每个榜单只保留 1 条
+		"game:1":     {1: 10},
+		"game:2":     {2: 5},
+		"category:2": {1: 10},
+		"category:1": {1: 10}, // 计入祖先分类
+	}).Return(nil)
+
+	// Act
+	err := service.Recompute(now)
+
+	// Assert
+	assert.NoError(t, err)
+	statRepo.AssertExpectations(t)
+	ranking.AssertExpectations(t)
+}
+
+func TestTrendingService_GetTrending_FromRanking(t *testing.T) {
+	// Arrange
+	statRepo := new(MockModStatRepository)
+	modRepo := new(MockModRepository)
+	ranking := new(MockTrendingRanking)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTrendingService(statRepo, modRepo, ranking, trending.Options{}, 0, logger)
+
+	ranking.On("Top", "game:1", 10, 10).Return([]uint{5, 3}, int64(12), nil)
+	modRepo.On("FindByIDs", []uint{5, 3}).Return([]models.Mod{{ID: 5, Name: "A"}, {ID: 3, Name: "B"}}, nil)
+
+	// Act
+	result, err := service.GetTrending(dto.TrendingRequest{GameID: 1, Page: 2, PageSize: 10})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.List, 2)
+	assert.Equal(t, uint(5), result.List[0].ID)
+	assert.Equal(t, int64(12), *result.Total)
+	assert.Equal(t, 2, *result.TotalPages)
+	assert.False(t, result.HasMore)
+	modRepo.AssertNotCalled(t, "Search", mock.Anything)
+}
+
+func TestTrendingService_GetTrending_FallbackToDatabase(t *testing.T) {
+	// Arrange
+	statRepo := new(MockModStatRepository)
+	modRepo := new(MockModRepository)
+	ranking := new(MockTrendingRanking)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTrendingService(statRepo, modRepo, ranking, trending.Options{}, 0, logger)
+
+	ranking.On("Top", "all", 0, 20).Return(nil, int64(0), repository.ErrRankingUnavailable)
+	modRepo.On("Search", repository.ModSearchCriteria{
+		SortBy:   repository.SortByTrending,
+		Order:    "desc",
+		Page:     1,
+		PageSize: 20,
+	}).Return(&repository.ModSearchResult{
+		Mods:       []models.Mod{{ID: 7, TrendingScore: 3.5}},
+		Total:      1,
+		Page:       1,
+		PageSize:   20,
+		TotalPages: 1,
+	}, nil)
+
+	// Act
+	result, err := service.GetTrending(dto.TrendingRequest{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3.5, result.List[0].TrendingScore)
+	modRepo.AssertExpectations(t)
+}
+
+func TestTrendingService_GetTrending_GameAndCategory(t *testing.T) {
+	// Arrange
+	statRepo := new(MockModStatRepository)
+	modRepo := new(MockModRepository)
+	ranking := new(MockTrendingRanking)
+	logger, _ := zap.NewDevelopment()
+	service := services.NewTrendingService(statRepo, modRepo, ranking, trending.Options{}, 0, logger)
+
+	modRepo.On("Search", mock.MatchedBy(func(c repository.ModSearchCriteria) bool {
+		return c.SortBy == repository.SortByTrending &&
+			assert.ObjectsAreEqual([]uint{1}, c.GameIDs) &&
+			assert.ObjectsAreEqual([]uint{2}, c.CategoryIDs)
+	})).Return(&repository.ModSearchResult{Page: 1, PageSize: 20}, nil)
+
+	// Act
+	_, err := service.GetTrending(dto.TrendingRequest{GameID: 1, CategoryID: 2})
+
+	// Assert
+	assert.NoError(t, err)
+	ranking.AssertNotCalled(t, "Top", mock.Anything, mock.Anything, mock.Anything)
+}
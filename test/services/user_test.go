@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 
 	"gin-web/app/dto"
 	"gin-web/app/models"
@@ -161,7 +162,7 @@ func TestUserService_Login_UserNotFound(t *testing.T) {
 		Password: "password123",
 	}
 
-	mockRepo.On("FindByMobile", req.Mobile).Return(nil, errors.New("not found"))
+	mockRepo.On("FindByMobile", req.Mobile).Return(nil, gorm.ErrRecordNotFound)
 
 	// Act
 	user, err := service.Login(req)
@@ -218,7 +219,7 @@ func TestUserService_GetUserInfo_NotFound(t *testing.T) {
 	logger := newTestLogger()
 	service := services.NewUserService(mockRepo, logger)
 
-	mockRepo.On("FindByID", uint(999)).Return(nil, errors.New("not found"))
+	mockRepo.On("FindByID", uint(999)).Return(nil, gorm.ErrRecordNotFound)
 
 	// Act
 	user, err := service.GetUserInfo("999")
@@ -0,0 +1,69 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/pkg/storage"
+)
+
+func readAll(t *testing.T, s storage.Storage, key string) string {
+	rc, err := s.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	s := storage.NewLocalStorage(root, "/uploads/")
+
+	require.NoError(t, s.Put(ctx, "mods/1/a.txt", strings.NewReader("hello"), "text/plain"))
+	assert.Equal(t, "hello", readAll(t, s, "mods/1/a.txt"))
+	_, err := os.Stat(filepath.Join(root, "mods", "1", "a.txt"))
+	assert.NoError(t, err)
+
+	// 覆盖写入
+	require.NoError(t, s.Put(ctx, "mods/1/a.txt", strings.NewReader("world"), "text/plain"))
+	assert.Equal(t, "world", readAll(t, s, "mods/1/a.txt"))
+
+	assert.Equal(t, "/uploads/mods/1/a%20b.txt", s.URL("mods/1/a b.txt"))
+
+	require.NoError(t, s.Delete(ctx, "mods/1/a.txt"))
+	_, err = s.Get(ctx, "mods/1/a.txt")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	assert.NoError(t, s.Delete(ctx, "mods/1/a.txt"), "删除不存在的文件不报错")
+}
+
+func TestLocalStorage_RejectsInvalidKeys(t *testing.T) {
+	s := storage.NewLocalStorage(t.TempDir(), "/uploads")
+
+	for _, key := range []string{"", "../escape.txt", "/abs.txt", "a/../../b.txt", `a\b.txt`} {
+		err := s.Put(context.Background(), key, strings.NewReader("x"), "")
+		assert.ErrorIs(t, err, storage.ErrInvalidKey, key)
+	}
+}
+
+func TestMemoryStorage(t *testing.T) {
+	ctx := context.Background()
+	s := storage.NewMemoryStorage("https://cdn.example.com")
+
+	require.NoError(t, s.Put(ctx, "games/1/cover.jpg", strings.NewReader("jpeg"), "image/jpeg"))
+	assert.Equal(t, "jpeg", readAll(t, s, "games/1/cover.jpg"))
+	assert.Equal(t, []string{"games/1/cover.jpg"}, s.Keys())
+	assert.Equal(t, "https://cdn.example.com/games/1/cover.jpg", s.URL("games/1/cover.jpg"))
+
+	require.NoError(t, s.Delete(ctx, "games/1/cover.jpg"))
+	_, err := s.Get(ctx, "games/1/cover.jpg")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
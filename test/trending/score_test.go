@@ -0,0 +1,46 @@
+package trending_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gin-web/pkg/trending"
+)
+
+func TestScore_Decay(t *testing.T) {
+	opts := trending.Options{Window: 7, HalfLife: 1, DownloadWeight: 1, ViewWeight: 0.5}
+	now := time.Date(2024, 5, 10, 15, 30, 0, 0, time.Local)
+
+	score := opts.Score([]trending.Bucket{
+		{Date: time.Date(2024, 5, 10, 0, 0, 0, 0, time.Local), Downloads: 10, Views: 4}, // 今天：10 + 2
+		{Date: time.Date(2024, 5, 9, 0, 0, 0, 0, time.Local), Downloads: 8},             // 昨天：8 / 2
+		{Date: time.Date(2024, 5, 7, 0, 0, 0, 0, time.Local), Downloads: 16},            // 三天前：16 / 8
+	}, now)
+
+	assert.InDelta(t, 18.0, score, 1e-9)
+}
+
+func TestScore_IgnoresOutsideWindow(t *testing.T) {
+	opts := trending.Options{Window: 3}.WithDefaults()
+	now := time.Date(2024, 5, 10, 0, 0, 0, 0, time.Local)
+
+	score := opts.Score([]trending.Bucket{
+		{Date: time.Date(2024, 5, 7, 0, 0, 0, 0, time.Local), Downloads: 100},
+		{Date: time.Date(2024, 5, 11, 0, 0, 0, 0, time.Local), Downloads: 100},
+	}, now)
+
+	assert.Zero(t, score)
+	assert.Equal(t, time.Date(2024, 5, 8, 0, 0, 0, 0, time.Local), opts.Since(now))
+}
+
+func TestScore_RecentBeatsAllTime(t *testing.T) {
+	opts := trending.Options{}.WithDefaults()
+	now := time.Date(2024, 5, 10, 0, 0, 0, 0, time.Local)
+
+	giant := opts.Score([]trending.Bucket{{Date: now.AddDate(0, 0, -12), Downloads: 300}}, now)
+	rising := opts.Score([]trending.Bucket{{Date: now, Downloads: 60}}, now)
+
+	assert.Greater(t, rising, giant)
+}
@@ -0,0 +1,135 @@
+package validation_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gin-web/app/dto"
+	"gin-web/bootstrap"
+	fxmodule "gin-web/internal/fx"
+	"gin-web/pkg/validation"
+)
+
+func newValidate(t *testing.T, rules ...validation.Rule) *validator.Validate {
+	t.Helper()
+	v := validator.New()
+	v.SetTagName("binding")
+	for _, rule := range rules {
+		require.NoError(t, rule.Register(v))
+	}
+	return v
+}
+
+type codeRequest struct {
+	Code string `binding:"upper_code"`
+}
+
+func TestField(t *testing.T) {
+	v := newValidate(t, validation.Field("upper_code", func(fl validator.FieldLevel) bool {
+		return fl.Field().String() == "ABC"
+	}))
+
+	assert.NoError(t, v.Struct(codeRequest{Code: "ABC"}))
+	assert.Error(t, v.Struct(codeRequest{Code: "abc"}))
+}
+
+type rangeRequest struct {
+	From time.Time
+	To   time.Time `binding:"not_before=From"`
+}
+
+func notBefore() validation.Rule {
+	return validation.CrossField("not_before", func(field, other reflect.Value) bool {
+		to, ok1 := field.Interface().(time.Time)
+		from, ok2 := other.Interface().(time.Time)
+		return ok1 && ok2 && !to.Before(from)
+	})
+}
+
+func TestCrossField(t *testing.T) {
+	v := newValidate(t, notBefore())
+	day := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, v.Struct(rangeRequest{From: day, To: day.AddDate(0, 0, 1)}))
+
+	err := v.Struct(rangeRequest{From: day, To: day.AddDate(0, 0, -1)})
+	var validationErrors validator.ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+	assert.Equal(t, "To", validationErrors[0].Field())
+	assert.Equal(t, "not_before", validationErrors[0].Tag())
+}
+
+type badCrossFieldRequest struct {
+	To time.Time `binding:"not_before=Missing"`
+}
+
+func TestCrossField_MissingField(t *testing.T) {
+	v := newValidate(t, notBefore())
+
+	assert.Error(t, v.Struct(badCrossFieldRequest{}))
+}
+
+type contactRequest struct {
+	Email  string
+	Mobile string
+}
+
+func TestStruct(t *testing.T) {
+	v := newValidate(t, validation.Struct(func(sl validator.StructLevel) {
+		req := sl.Current().Interface().(contactRequest)
+		if req.Email == "" && req.Mobile == "" {
+			sl.ReportError(req.Email, "Email", "Email", "email_or_mobile", "")
+		}
+	}, contactRequest{}))
+
+	assert.NoError(t, v.Struct(contactRequest{Mobile: "13800138000"}))
+
+	err := v.Struct(contactRequest{})
+	var validationErrors validator.ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+	assert.Equal(t, "email_or_mobile", validationErrors[0].Tag())
+}
+
+func TestStruct_RequiresTypes(t *testing.T) {
+	v := validator.New()
+
+	assert.Error(t, validation.Struct(func(sl validator.StructLevel) {}).Register(v))
+}
+
+type unknownTagRequest struct {
+	Name string `binding:"required,no_such_rule"`
+}
+
+type nestedUnknownTagRequest struct {
+	Items []unknownTagRequest `binding:"dive"`
+}
+
+func TestCheckTags(t *testing.T) {
+	v := newValidate(t)
+
+	assert.NoError(t, validation.CheckTags(v, contactRequest{}))
+	err := validation.CheckTags(v, unknownTagRequest{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no_such_rule")
+}
+
+func TestCheckTags_Nested(t *testing.T) {
+	v := newValidate(t)
+
+	// 切片为空时验证器不会解析元素类型的标签，需要单独检查
+	err := validation.CheckTags(v, &nestedUnknownTagRequest{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknownTagRequest")
+}
+
+func TestCheckTags_AllRequests(t *testing.T) {
+	v, err := bootstrap.InitializeValidator(fxmodule.ProvideVersionValidationRules()...)
+	require.NoError(t, err)
+
+	assert.NoError(t, validation.CheckTags(v, dto.Requests()...))
+}
@@ -0,0 +1,148 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gin-web/pkg/version"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{"1.2.3", true},
+		{"v2.0", true},
+		{"5.2SE", true},
+		{"11.6.0.1018", true},
+		{"1.0-beta2", true},
+		{"", false},
+		{"SE", false},
+	}
+
+	for _, tt := range tests {
+		_, err := version.Parse(tt.input)
+		assert.Equal(t, tt.valid, err == nil, tt.input)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2", "1.2.0", 0},
+		{"1.10", "1.9", 1},
+		{"2.2.3", "3", -1},
+		{"5.2SE", "5.2", 0},
+		{"2.0-rc1", "2.0", -1},
+		{"2.0-beta.2", "2.0-beta10", -1},
+		{"2.0-alpha", "2.0-beta", -1},
+		{"2.0-dev", "2.0-alpha", -1},
+		{"1.0b2", "1.0-beta.2", 0},
+		{"2.0-rc1", "1.9.9", 1},
+		{"1.0 Final", "1.0", 0},
+	}
+
+	for _, tt := range tests {
+		a, _ := version.Parse(tt.a)
+		b, _ := version.Parse(tt.b)
+		assert.Equal(t, tt.want, a.Compare(b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{"", "1.0", true},
+		{">=2.2, <3", "2.2.3", true},
+		{">=2.2, <3", "3.0", false},
+		{">=2.2, <3", "2.1.9", false},
+		{"1.4", "1.4.0", true},
+		{"!=1.4", "1.4", false},
+		{"> 1", "1.0.1", true},
+		{">=2.2 <3", "2.5", true},
+		{"<3", "3.0-beta", false},
+		{"<3.0-rc1", "3.0-beta", true},
+		{"~1.2", "1.2.9", true},
+		{"~1.2", "1.3", false},
+		{"~1", "1.9", true},
+		{"^1.2", "1.9.3", true},
+		{"^1.2", "2.0", false},
+		{"^0.2.3", "0.3.0", false},
+		{"~>2.2", "2.9", true},
+		{"~>2.2.0", "2.3", false},
+		{"1.2.x", "1.2.7", true},
+		{"1.2.*", "1.3", false},
+		{"*", "0.1", true},
+		{"1.2 - 1.4", "1.4.9", true},
+		{"1.2 - 1.4", "1.5", false},
+		{"<1 || >=2", "1.5", false},
+		{"<1 || >=2", "2.1", true},
+	}
+
+	for _, tt := range tests {
+		c, err := version.ParseConstraint(tt.constraint)
+		assert.NoError(t, err, tt.constraint)
+		v, _ := version.Parse(tt.version)
+		assert.Equal(t, tt.want, c.Check(v), "%s %s", tt.version, tt.constraint)
+	}
+}
+
+func TestParseConstraint_Invalid(t *testing.T) {
+	for _, input := range []string{">=", "1.0,", ">=abc", "~>", "1.0 ||", "!=1.x", "1.x.2", ">=*"} {
+		_, err := version.ParseConstraint(input)
+		assert.ErrorIs(t, err, version.ErrInvalidConstraint, input)
+	}
+}
+
+func TestNormalized(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"v2", "2.0.0"},
+		{"5.2SE", "5.2.0+SE"},
+		{"11.6.0.1018", "11.6.0.1018"},
+		{"2.0-Beta2", "2.0.0-beta.2"},
+		{"1.0 RC 1", "1.0.0-rc.1"},
+		{"1.0.0-beta.1+build.5", "1.0.0-beta.1+build.5"},
+		{"3.1 (hotfix)", "3.1.0+hotfix"},
+		{"1.0-betamax", "1.0.0+betamax"},
+	}
+
+	for _, tt := range tests {
+		v, err := version.Parse(tt.input)
+		assert.NoError(t, err, tt.input)
+		assert.Equal(t, tt.want, v.Normalized(), tt.input)
+	}
+}
+
+func TestSort(t *testing.T) {
+	var versions []version.Version
+	for _, s := range []string{"1.10", "1.2-rc1", "1.2", "1.9", "1.2-beta"} {
+		versions = append(versions, version.MustParse(s))
+	}
+
+	version.Sort(versions)
+
+	var got []string
+	for _, v := range versions {
+		got = append(got, v.String())
+	}
+	assert.Equal(t, []string{"1.2-beta", "1.2-rc1", "1.2", "1.9", "1.10"}, got)
+	assert.True(t, versions[4].NewerThan(versions[3]))
+}
+
+func TestCompareStrings(t *testing.T) {
+	assert.Equal(t, 1, version.CompareStrings("1.0", "latest"))
+	assert.Equal(t, -1, version.CompareStrings("alpha", "0.1"))
+	assert.Equal(t, 0, version.CompareStrings("1.0", "1.0.0"))
+}
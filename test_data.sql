@@ -12,12 +12,49 @@ INSERT INTO categories (name, description, created_at, updated_at) VALUES
 ('Maps', 'New maps and worlds', NOW(), NOW());
 
 -- 插入测试mod数据
-INSERT INTO mods (name, description, author, version, game_id, category_id, download_count, rating, download_url, image_url, created_at, updated_at) VALUES
-('OptiFine', 'A Minecraft optimization mod that allows for HD textures and many configuration options for better graphics and performance.', 'sp614x', '1.19.4', 1, 2, 15000000, 4.8, 'https://optifine.net/downloads', 'https://optifine.net/img/logo.png', NOW(), NOW()),
-('JEI', 'Just Enough Items (JEI) is an item and recipe viewing mod for Minecraft, built from the ground up for stability and performance.', 'mezz', '11.6.0.1018', 1, 1, 8500000, 4.9, 'https://www.curseforge.com/minecraft/mc-mods/jei', '', NOW(), NOW()),
-('Biomes O Plenty', 'Adds over 80 unique biomes to enhance your Minecraft world!', 'Forstride', '17.1.2.545', 1, 4, 12000000, 4.7, 'https://www.curseforge.com/minecraft/mc-mods/biomes-o-plenty', '', NOW(), NOW()),
-('SkyUI', 'Elegant, PC-friendly interface mod with many advanced features.', 'SkyUI Team', '5.2SE', 2, 2, 3200000, 4.9, 'https://www.nexusmods.com/skyrimspecialedition/mods/12604', '', NOW(), NOW()),
-('SKSE64', 'The Skyrim Script Extender (SKSE) is a tool used by many Skyrim mods that expands scripting capabilities.', 'SKSE Team', '2.2.3', 2, 1, 2800000, 4.8, 'https://skse.silverlock.org/', '', NOW(), NOW()),
-('Immersive Armors', 'Adds many new armor sets that have been seamlessly integrated into the world.', 'Hothtrooper44', '8.1', 2, 3, 1900000, 4.6, 'https://www.nexusmods.com/skyrimspecialedition/mods/3479', '', NOW(), NOW()),
-('NaturalVision Evolved', 'The ultimate GTA V graphics enhancement mod.', 'Razed', '2.0', 3, 2, 850000, 4.7, 'https://www.gta5-mods.com/misc/naturalvision-evolved', '', NOW(), NOW()),
-('Script Hook V', 'Library that allows to use GTA V script native functions in custom *.asi plugins.', 'Alexander Blade', '1.0.2845.0', 3, 1, 1200000, 4.5, 'http://www.dev-c.com/gtav/scripthookv/', '', NOW(), NOW()); 
\ No newline at end of file
+INSERT INTO mods (name, description, author, version, game_id, download_count, rating, download_url, image_url, created_at, updated_at) VALUES
+('OptiFine', 'A Minecraft optimization mod that allows for HD textures and many configuration options for better graphics and performance.', 'sp614x', '1.19.4', 1, 15000000, 4.8, 'https://optifine.net/downloads', 'https://optifine.net/img/logo.png', NOW(), NOW()),
+('JEI', 'Just Enough Items (JEI) is an item and recipe viewing mod for Minecraft, built from the ground up for stability and performance.', 'mezz', '11.6.0.1018', 1, 8500000, 4.9, 'https://www.curseforge.com/minecraft/mc-mods/jei', '', NOW(), NOW()),
+('Biomes O Plenty', 'Adds over 80 unique biomes to enhance your Minecraft world!', 'Forstride', '17.1.2.545', 1, 12000000, 4.7, 'https://www.curseforge.com/minecraft/mc-mods/biomes-o-plenty', '', NOW(), NOW()),
+('SkyUI', 'Elegant, PC-friendly interface mod with many advanced features.', 'SkyUI Team', '5.2SE', 2, 3200000, 4.9, 'https://www.nexusmods.com/skyrimspecialedition/mods/12604', '', NOW(), NOW()),
+('SKSE64', 'The Skyrim Script Extender (SKSE) is a tool used by many Skyrim mods that expands scripting capabilities.', 'SKSE Team', '2.2.3', 2, 2800000, 4.8, 'https://skse.silverlock.org/', '', NOW(), NOW()),
+('Immersive Armors', 'Adds many new armor sets that have been seamlessly integrated into the world.', 'Hothtrooper44', '8.1', 2, 1900000, 4.6, 'https://www.nexusmods.com/skyrimspecialedition/mods/3479', '', NOW(), NOW()),
+('NaturalVision Evolved', 'The ultimate GTA V graphics enhancement mod.', 'Razed', '2.0', 3, 850000, 4.7, 'https://www.gta5-mods.com/misc/naturalvision-evolved', '', NOW(), NOW()),
+('Script Hook V', 'Library that allows to use GTA V script native functions in custom *.asi plugins.', 'Alexander Blade', '1.0.2845.0', 3, 1200000, 4.5, 'http://www.dev-c.com/gtav/scripthookv/', '', NOW(), NOW());
+
+-- 插入 Mod 分类关联
+INSERT INTO gw_mod_categories (mod_id, category_id)
+SELECT m.id, c.id FROM mods m JOIN categories c ON
+    (m.name IN ('OptiFine', 'SkyUI', 'NaturalVision Evolved') AND c.name = 'Graphics')
+    OR (m.name IN ('JEI', 'SKSE64', 'Script Hook V') AND c.name = 'Gameplay')
+    OR (m.name = 'Biomes O Plenty' AND c.name = 'Maps')
+    OR (m.name = 'Immersive Armors' AND c.name = 'Weapons');
+
+-- 插入测试依赖关系数据
+INSERT INTO mod_dependencies (mod_id, depends_on_id, type, version_constraint, created_at, updated_at)
+SELECT m.id, d.id, 'required', '>=2.2', NOW(), NOW()
+FROM mods m JOIN mods d ON d.name = 'SKSE64'
+WHERE m.name = 'SkyUI';
+
+-- 插入测试子分类数据
+INSERT INTO categories (name, description, parent_id, created_at, updated_at)
+SELECT 'Interface', 'User interface mods', id, NOW(), NOW() FROM categories WHERE name = 'Gameplay';
+
+-- 插入测试标签数据
+INSERT INTO tags (name, mod_count, created_by, created_at, updated_at) VALUES
+('ui', 1, 0, NOW(), NOW()),
+('skse', 2, 0, NOW(), NOW());
+
+INSERT INTO gw_mod_tags (mod_id, tag_id)
+SELECT m.id, t.id FROM mods m JOIN tags t
+ON (m.name = 'SkyUI' AND t.name IN ('ui', 'skse')) OR (m.name = 'SKSE64' AND t.name = 'skse');
+
+-- 插入测试游戏版本数据
+INSERT INTO game_versions (game_id, name, created_at, updated_at)
+SELECT id, '1.5.97', NOW(), NOW() FROM games WHERE name = 'Skyrim'
+UNION ALL
+SELECT id, '1.6.1170', NOW(), NOW() FROM games WHERE name = 'Skyrim';
+
+INSERT INTO gw_mod_game_versions (mod_id, game_version_id)
+SELECT m.id, v.id FROM mods m JOIN game_versions v ON v.game_id = m.game_id
+WHERE m.name IN ('SkyUI', 'SKSE64');